@@ -0,0 +1,126 @@
+// Package apierror define o formato único das respostas de erro da API: um código estável para uso
+// pelos clientes, o status HTTP correspondente, a mensagem traduzida (pt-BR por padrão, ou en) e o ID da
+// requisição, usado para localizar o erro nos logs do servidor.
+package apierror
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"meu-projeto/backend/validation"
+)
+
+// Códigos de erro da API.
+const (
+	CodeBadRequest          = "bad_request"          // Parâmetro ou ID inválido na requisição
+	CodeInvalidJSON         = "invalid_json"         // Corpo da requisição não é um JSON válido
+	CodeValidation          = "validation_failed"    // Um ou mais campos do corpo são inválidos
+	CodeUnauthorized        = "unauthorized"         // Token ausente, inválido ou expirado, ou credenciais incorretas
+	CodeForbidden           = "forbidden"            // Usuário autenticado sem a permissão exigida pela rota
+	CodeNotFound            = "not_found"            // Recurso não encontrado
+	CodeConflict            = "conflict"             // Operação em conflito com o estado atual (ex: duplicidade)
+	CodeUnprocessable       = "unprocessable"        // Dados válidos, mas que não puderam ser processados (ex: endereço sem coordenadas)
+	CodeMethodNotAllowed    = "method_not_allowed"   // Método HTTP não aceito pela rota
+	CodeUpstreamUnavailable = "upstream_unavailable" // Serviço externo (geocodificação, CEP) indisponível
+	CodeInternal            = "internal_error"       // Falha interna do servidor
+)
+
+// statuses associa cada código de erro ao status HTTP da resposta.
+var statuses = map[string]int{
+	CodeBadRequest:          http.StatusBadRequest,
+	CodeInvalidJSON:         http.StatusBadRequest,
+	CodeValidation:          http.StatusUnprocessableEntity,
+	CodeUnauthorized:        http.StatusUnauthorized,
+	CodeForbidden:           http.StatusForbidden,
+	CodeNotFound:            http.StatusNotFound,
+	CodeConflict:            http.StatusConflict,
+	CodeUnprocessable:       http.StatusUnprocessableEntity,
+	CodeMethodNotAllowed:    http.StatusMethodNotAllowed,
+	CodeUpstreamUnavailable: http.StatusBadGateway,
+	CodeInternal:            http.StatusInternalServerError,
+}
+
+// Status retorna o status HTTP do código de erro (500 para códigos desconhecidos).
+func Status(code string) int {
+	if status, ok := statuses[code]; ok {
+		return status
+	}
+	return http.StatusInternalServerError
+}
+
+// Error é um erro da API. A mensagem é obtida do catálogo pela chave Key, no idioma da requisição.
+type Error struct {
+	Code   string            // Código do erro (ex: not_found)
+	Key    string            // Chave da mensagem no catálogo (ex: delivery_not_found)
+	Args   []any             // Argumentos da mensagem (ex: nome do parâmetro)
+	Fields validation.Errors // Campos inválidos (apenas no código validation_failed)
+	Err    error             // Causa do erro: registrada no log e, nos erros 4xx, enviada como detalhe
+}
+
+// New cria um erro da API com a mensagem da chave informada.
+func New(code, key string, args ...any) *Error {
+	return &Error{Code: code, Key: key, Args: args}
+}
+
+// Wrap cria um erro da API causado por err.
+func Wrap(err error, code, key string, args ...any) *Error {
+	return &Error{Code: code, Key: key, Args: args, Err: err}
+}
+
+// Validation cria um erro validation_failed com os campos inválidos.
+func Validation(fields validation.Errors) *Error {
+	return &Error{Code: CodeValidation, Key: CodeValidation, Fields: fields}
+}
+
+// Error implementa error, com a mensagem em português.
+func (e *Error) Error() string {
+	message := Message(DefaultLanguage, e.Key, e.Args...)
+	if e.Err != nil {
+		return message + ": " + e.Err.Error()
+	}
+	return message
+}
+
+// Unwrap retorna a causa do erro, para uso com errors.Is e errors.As.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Status retorna o status HTTP do erro.
+func (e *Error) Status() int {
+	return Status(e.Code)
+}
+
+// Response é o corpo de todas as respostas de erro da API.
+type Response struct {
+	Error Body `json:"error"` // Dados do erro
+}
+
+// Body descreve o erro na resposta.
+type Body struct {
+	Code      string            `json:"code"`                 // Código do erro (ex: not_found)
+	Message   string            `json:"message"`              // Mensagem no idioma da requisição
+	Detail    string            `json:"detail,omitempty"`     // Detalhe do erro em português (ex: a transição rejeitada); ausente nos erros 5xx
+	RequestID string            `json:"request_id,omitempty"` // ID da requisição (também no cabeçalho X-Request-ID)
+	Fields    validation.Errors `json:"fields,omitempty"`     // Campos inválidos (apenas no código validation_failed)
+}
+
+// Write responde à requisição com o erro. Os erros 5xx são registrados no log com o ID da requisição e a
+// causa, que nunca é enviada ao cliente (ex: mensagens do driver do banco de dados).
+func Write(w http.ResponseWriter, r *http.Request, e *Error) {
+	lang := Language(r)
+	requestID := RequestIDFrom(r.Context())
+	status := e.Status()
+
+	body := Body{Code: e.Code, Message: Message(lang, e.Key, e.Args...), RequestID: requestID, Fields: localizeFields(lang, e.Fields)}
+	if status >= http.StatusInternalServerError {
+		log.Printf("[%s] %s %s: %v", requestID, r.Method, r.URL.Path, e)
+	} else if e.Err != nil {
+		body.Detail = e.Err.Error()
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(Response{Error: body})
+}
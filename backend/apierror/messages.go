@@ -0,0 +1,155 @@
+package apierror
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"meu-projeto/backend/validation"
+)
+
+// Idiomas das mensagens de erro.
+const (
+	LanguagePortuguese = "pt-BR" // Português (padrão)
+	LanguageEnglish    = "en"    // Inglês
+	DefaultLanguage    = LanguagePortuguese
+)
+
+// messages é o catálogo das mensagens de erro, indexado pela chave e pelo idioma.
+var messages = map[string]map[string]string{
+	// Mensagens genéricas de cada código
+	CodeBadRequest:          {LanguagePortuguese: "Requisição inválida", LanguageEnglish: "Invalid request"},
+	CodeInvalidJSON:         {LanguagePortuguese: "Erro ao decodificar o JSON", LanguageEnglish: "Malformed JSON body"},
+	CodeValidation:          {LanguagePortuguese: "Um ou mais campos são inválidos", LanguageEnglish: "One or more fields are invalid"},
+	CodeUnauthorized:        {LanguagePortuguese: "Autenticação necessária", LanguageEnglish: "Authentication required"},
+	CodeForbidden:           {LanguagePortuguese: "Acesso negado", LanguageEnglish: "Access denied"},
+	CodeNotFound:            {LanguagePortuguese: "Recurso não encontrado", LanguageEnglish: "Resource not found"},
+	CodeConflict:            {LanguagePortuguese: "A operação conflita com o estado atual do recurso", LanguageEnglish: "The request conflicts with the current state of the resource"},
+	CodeUnprocessable:       {LanguagePortuguese: "Não foi possível processar a requisição", LanguageEnglish: "The request could not be processed"},
+	CodeMethodNotAllowed:    {LanguagePortuguese: "Método não permitido", LanguageEnglish: "Method not allowed"},
+	CodeUpstreamUnavailable: {LanguagePortuguese: "Serviço externo indisponível", LanguageEnglish: "External service unavailable"},
+	CodeInternal:            {LanguagePortuguese: "Erro interno do servidor", LanguageEnglish: "Internal server error"},
+
+	// Parâmetros da requisição
+	"invalid_id":             {LanguagePortuguese: "ID inválido", LanguageEnglish: "Invalid ID"},
+	"invalid_delivery_id":    {LanguagePortuguese: "ID da entrega inválido", LanguageEnglish: "Invalid delivery ID"},
+	"param_required":         {LanguagePortuguese: "O parâmetro '%s' é obrigatório", LanguageEnglish: "The '%s' parameter is required"},
+	"param_integer":          {LanguagePortuguese: "O parâmetro '%s' deve ser um número inteiro", LanguageEnglish: "The '%s' parameter must be an integer"},
+	"param_positive":         {LanguagePortuguese: "O parâmetro '%s' deve ser um número inteiro maior que zero", LanguageEnglish: "The '%s' parameter must be an integer greater than zero"},
+	"param_range":            {LanguagePortuguese: "O parâmetro '%s' deve ser um número inteiro entre %d e %d", LanguageEnglish: "The '%s' parameter must be an integer between %d and %d"},
+	"param_number":           {LanguagePortuguese: "O parâmetro '%s' deve ser um número", LanguageEnglish: "The '%s' parameter must be a number"},
+	"param_bool":             {LanguagePortuguese: "O parâmetro '%s' deve ser 'true' ou 'false'", LanguageEnglish: "The '%s' parameter must be 'true' or 'false'"},
+	"param_date":             {LanguagePortuguese: "O parâmetro '%s' deve estar no formato AAAA-MM-DD", LanguageEnglish: "The '%s' parameter must be in the YYYY-MM-DD format"},
+	"param_datetime":         {LanguagePortuguese: "O parâmetro '%s' deve estar no formato AAAA-MM-DD ou AAAA-MM-DDTHH:MM:SSZ", LanguageEnglish: "The '%s' parameter must be in the YYYY-MM-DD or YYYY-MM-DDTHH:MM:SSZ format"},
+	"param_sort":             {LanguagePortuguese: "Não é possível ordenar pelo campo '%s'", LanguageEnglish: "Cannot sort by the '%s' field"},
+	"param_order":            {LanguagePortuguese: "O parâmetro 'order' deve ser 'asc' ou 'desc'", LanguageEnglish: "The 'order' parameter must be 'asc' or 'desc'"},
+	"coordinates_required":   {LanguagePortuguese: "Os parâmetros 'lat' e 'lng' são obrigatórios", LanguageEnglish: "The 'lat' and 'lng' parameters are required"},
+	"nearby_params_required": {LanguagePortuguese: "Os parâmetros 'lat', 'lng' e 'radius_km' são obrigatórios", LanguageEnglish: "The 'lat', 'lng' and 'radius_km' parameters are required"},
+
+	// Autenticação
+	"invalid_credentials": {LanguagePortuguese: "E-mail ou senha incorretos", LanguageEnglish: "Incorrect email or password"},
+	"invalid_token":       {LanguagePortuguese: "Token inválido", LanguageEnglish: "Invalid token"},
+	"token_expired":       {LanguagePortuguese: "Token expirado", LanguageEnglish: "Token expired"},
+	"invalid_api_key":     {LanguagePortuguese: "Chave de API inválida, expirada ou removida", LanguageEnglish: "Invalid, expired or deleted API key"},
+	"user_duplicate":      {LanguagePortuguese: "E-mail já cadastrado", LanguageEnglish: "Email already registered"},
+	"shipper_duplicate":   {LanguagePortuguese: "Embarcador já cadastrado", LanguageEnglish: "Shipper already registered"},
+	"permission_denied":   {LanguagePortuguese: "Permissão '%s' necessária", LanguageEnglish: "Permission '%s' required"},
+	"shipper_forbidden":   {LanguagePortuguese: "Apenas o embarcador padrão gerencia outros embarcadores", LanguageEnglish: "Only the default shipper can manage other shippers"},
+	"shared_forbidden":    {LanguagePortuguese: "Apenas o embarcador padrão altera a frota e as zonas, compartilhadas por todos os embarcadores", LanguageEnglish: "Only the default shipper can change the fleet and zones shared by all shippers"},
+
+	// Erros dos serviços
+	"delivery_not_found":     {LanguagePortuguese: "Entrega não encontrada", LanguageEnglish: "Delivery not found"},
+	"api_key_not_found":      {LanguagePortuguese: "Chave de API não encontrada", LanguageEnglish: "API key not found"},
+	"client_not_found":       {LanguagePortuguese: "Cliente não encontrado", LanguageEnglish: "Client not found"},
+	"driver_not_found":       {LanguagePortuguese: "Motorista não encontrado", LanguageEnglish: "Driver not found"},
+	"vehicle_not_found":      {LanguagePortuguese: "Veículo não encontrado", LanguageEnglish: "Vehicle not found"},
+	"zone_not_found":         {LanguagePortuguese: "Zona não encontrada", LanguageEnglish: "Zone not found"},
+	"cep_not_found":          {LanguagePortuguese: "CEP não encontrado", LanguageEnglish: "Postal code (CEP) not found"},
+	"location_not_found":     {LanguagePortuguese: "Nenhum endereço conhecido perto das coordenadas", LanguageEnglish: "No known address near the coordinates"},
+	"address_not_geocoded":   {LanguagePortuguese: "Não foi possível obter as coordenadas do endereço", LanguageEnglish: "Could not find the coordinates of the address"},
+	"driver_duplicate":       {LanguagePortuguese: "Motorista já cadastrado", LanguageEnglish: "Driver already registered"},
+	"plate_duplicate":        {LanguagePortuguese: "Placa já cadastrada", LanguageEnglish: "License plate already registered"},
+	"zone_duplicate":         {LanguagePortuguese: "Nome de zona já cadastrado", LanguageEnglish: "Zone name already registered"},
+	"client_duplicate":       {LanguagePortuguese: "CPF já cadastrado", LanguageEnglish: "CPF already registered"},
+	"duplicate":              {LanguagePortuguese: "Registro já cadastrado", LanguageEnglish: "Record already exists"},
+	"foreign_key":            {LanguagePortuguese: "Registro relacionado inexistente ou em uso", LanguageEnglish: "Related record does not exist or is in use"},
+	"status_transition":      {LanguagePortuguese: "Transição de status não permitida", LanguageEnglish: "Status transition not allowed"},
+	"assignment_not_allowed": {LanguagePortuguese: "Atribuição de entrega não permitida", LanguageEnglish: "Delivery assignment not allowed"},
+	"invalid_status":         {LanguagePortuguese: "Status inválido", LanguageEnglish: "Invalid status"},
+	"invalid_search":         {LanguagePortuguese: "Parâmetros de busca inválidos", LanguageEnglish: "Invalid search parameters"},
+	"invalid_audit_filter":   {LanguagePortuguese: "Filtros da auditoria inválidos", LanguageEnglish: "Invalid audit filters"},
+	"invalid_cep":            {LanguagePortuguese: "CEP inválido", LanguageEnglish: "Invalid postal code (CEP)"},
+	"invalid_tracking_code":  {LanguagePortuguese: "Código de rastreio inválido", LanguageEnglish: "Invalid tracking code"},
+	"invalid_route":          {LanguagePortuguese: "Requisição de rota inválida", LanguageEnglish: "Invalid route request"},
+	"geocoding_unavailable":  {LanguagePortuguese: "Serviço de geocodificação indisponível", LanguageEnglish: "Geocoding service unavailable"},
+	"cep_unavailable":        {LanguagePortuguese: "Serviço de consulta de CEP indisponível", LanguageEnglish: "Postal code (CEP) lookup service unavailable"},
+}
+
+// fieldMessages são as mensagens em inglês dos erros de validação, por código (as mensagens em
+// português são as geradas pelo pacote validation).
+var fieldMessages = map[string]string{
+	validation.CodeRequired:      "The '%s' field is required",
+	validation.CodeInvalidFormat: "The '%s' field has an invalid format",
+	validation.CodeInvalidValue:  "The '%s' field has an invalid value",
+	validation.CodeOutOfRange:    "The '%s' field is out of range",
+	validation.CodeTooLong:       "The '%s' field is too long",
+}
+
+// Message retorna a mensagem da chave no idioma informado, formatada com os argumentos. Chaves sem
+// tradução usam o português; chaves desconhecidas retornam a própria chave.
+func Message(lang, key string, args ...any) string {
+	translations, ok := messages[key]
+	if !ok {
+		return key
+	}
+	format, ok := translations[lang]
+	if !ok {
+		format = translations[DefaultLanguage]
+	}
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// Language retorna o idioma da resposta: o parâmetro "lang" da query string ou, na falta dele, o primeiro
+// idioma suportado do cabeçalho Accept-Language. O padrão é o português.
+func Language(r *http.Request) string {
+	if lang, ok := matchLanguage(r.URL.Query().Get("lang")); ok {
+		return lang
+	}
+	for _, tag := range strings.Split(r.Header.Get("Accept-Language"), ",") {
+		tag, _, _ = strings.Cut(tag, ";") // Ignora o peso (ex: "en;q=0.8")
+		if lang, ok := matchLanguage(tag); ok {
+			return lang
+		}
+	}
+	return DefaultLanguage
+}
+
+// matchLanguage converte uma etiqueta de idioma (ex: "en-US", "pt") em um idioma suportado.
+func matchLanguage(tag string) (string, bool) {
+	primary, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+	switch primary {
+	case "pt":
+		return LanguagePortuguese, true
+	case "en":
+		return LanguageEnglish, true
+	}
+	return "", false
+}
+
+// localizeFields traduz as mensagens dos erros de validação para o idioma informado.
+func localizeFields(lang string, fields validation.Errors) validation.Errors {
+	if lang == DefaultLanguage || len(fields) == 0 {
+		return fields
+	}
+	localized := make(validation.Errors, len(fields))
+	for i, fieldErr := range fields {
+		if format, ok := fieldMessages[fieldErr.Code]; ok {
+			fieldErr.Message = fmt.Sprintf(format, fieldErr.Field)
+		}
+		localized[i] = fieldErr
+	}
+	return localized
+}
@@ -0,0 +1,44 @@
+package apierror
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"regexp"
+)
+
+// RequestIDHeader é o cabeçalho com o ID da requisição, recebido do cliente ou gerado pelo servidor.
+const RequestIDHeader = "X-Request-ID"
+
+// requestIDPattern restringe os IDs aceitos do cliente, para que possam ser registrados no log com segurança.
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// requestIDKey é a chave do ID da requisição no contexto.
+type requestIDKey struct{}
+
+// RequestID é o middleware que identifica cada requisição: reaproveita o cabeçalho X-Request-ID enviado pelo
+// cliente (se válido) ou gera um novo ID, devolve-o no cabeçalho da resposta e o guarda no contexto.
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if !requestIDPattern.MatchString(id) {
+			id = newRequestID()
+		}
+		w.Header().Set(RequestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestIDKey{}, id)))
+	})
+}
+
+// RequestIDFrom retorna o ID da requisição guardado no contexto pelo middleware RequestID.
+func RequestIDFrom(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// newRequestID gera um ID aleatório de 32 caracteres hexadecimais.
+func newRequestID() string {
+	buffer := make([]byte, 16)
+	rand.Read(buffer)
+	return hex.EncodeToString(buffer)
+}
@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// Formato das chaves de API: "wg_<prefixo>_<segredo>". O prefixo (ex: "wg_1a2b3c4d") identifica a chave nas
+// listagens e na busca pelo banco; o segredo nunca é guardado, apenas o hash SHA-256 da chave completa.
+const (
+	APIKeyTag          = "wg_"                       // Início de todas as chaves, para que sejam reconhecidas em vazamentos
+	apiKeyPrefixLength = len(APIKeyTag) + 8          // Tamanho do prefixo (tag + 8 caracteres hexadecimais)
+	apiKeyLength       = apiKeyPrefixLength + 1 + 64 // Prefixo, separador e segredo de 256 bits em hexadecimal
+)
+
+// NewAPIKey gera uma nova chave de API aleatória, retornando a chave completa (exibida apenas uma vez), o seu
+// prefixo e o hash guardado no banco.
+func NewAPIKey() (key, prefix, hash string, err error) {
+	random := make([]byte, 4+32)
+	if _, err := rand.Read(random); err != nil {
+		return "", "", "", err
+	}
+	prefix = APIKeyTag + hex.EncodeToString(random[:4])
+	key = prefix + "_" + hex.EncodeToString(random[4:])
+	return key, prefix, HashAPIKey(key), nil
+}
+
+// APIKeyPrefix retorna o prefixo da chave, ou false se ela não estiver no formato das chaves da API.
+func APIKeyPrefix(key string) (string, bool) {
+	if len(key) != apiKeyLength || !strings.HasPrefix(key, APIKeyTag) || key[apiKeyPrefixLength] != '_' {
+		return "", false
+	}
+	return key[:apiKeyPrefixLength], true
+}
+
+// HashAPIKey calcula o hash SHA-256 da chave, em hexadecimal. As chaves são aleatórias e longas, então não
+// precisam de um hash lento como o bcrypt das senhas (que seria calculado a cada requisição).
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// CheckAPIKey compara a chave com o hash guardado em tempo constante.
+func CheckAPIKey(key, hash string) bool {
+	return hmac.Equal([]byte(HashAPIKey(key)), []byte(hash))
+}
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"crypto/rand"
+	"fmt"
+	"log"
+	"os"
+	"time"
+)
+
+// Configuração padrão dos tokens.
+const (
+	DefaultAccessTTL  = 15 * time.Minute   // Validade padrão dos tokens de acesso
+	DefaultRefreshTTL = 7 * 24 * time.Hour // Validade padrão dos tokens de renovação
+	minSecretLength   = 32                 // Tamanho mínimo da chave secreta (256 bits, como o SHA-256)
+)
+
+// TokensFromEnv monta o emissor de tokens configurado pelas variáveis de ambiente:
+//
+//   - JWT_SECRET: chave secreta da assinatura, com pelo menos 32 caracteres. Se não for definida, uma chave
+//     aleatória é gerada, e os tokens emitidos deixam de valer quando o servidor reinicia;
+//   - JWT_ACCESS_TTL e JWT_REFRESH_TTL: validade dos tokens de acesso (padrão 15m) e de renovação (padrão 168h),
+//     no formato do time.ParseDuration.
+func TokensFromEnv() (*Tokens, error) {
+	tokens := &Tokens{Secret: []byte(os.Getenv("JWT_SECRET")), AccessTTL: DefaultAccessTTL, RefreshTTL: DefaultRefreshTTL}
+	if len(tokens.Secret) == 0 {
+		log.Println("JWT_SECRET não definida: usando uma chave aleatória (os tokens deixam de valer quando o servidor reinicia)")
+		tokens.Secret = make([]byte, minSecretLength)
+		if _, err := rand.Read(tokens.Secret); err != nil {
+			return nil, err
+		}
+	} else if len(tokens.Secret) < minSecretLength {
+		return nil, fmt.Errorf("JWT_SECRET deve ter pelo menos %d caracteres", minSecretLength)
+	}
+
+	var err error
+	if tokens.AccessTTL, err = durationFromEnv("JWT_ACCESS_TTL", DefaultAccessTTL); err != nil {
+		return nil, err
+	}
+	if tokens.RefreshTTL, err = durationFromEnv("JWT_REFRESH_TTL", DefaultRefreshTTL); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// durationFromEnv lê uma duração positiva da variável de ambiente, usando o valor padrão se ela não for definida.
+func durationFromEnv(name string, fallback time.Duration) (time.Duration, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return fallback, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		return 0, fmt.Errorf("%s inválida: %q (use, por exemplo, 15m ou 24h)", name, value)
+	}
+	return duration, nil
+}
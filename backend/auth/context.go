@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"context"
+	"slices"
+)
+
+// Principal identifica quem fez a requisição autenticada: um usuário, com o token de acesso, ou uma
+// integração, com uma chave de API. Ambos pertencem a um embarcador, ao qual os clientes e as entregas
+// acessados são restritos.
+type Principal struct {
+	UserID       int      // ID do usuário (0 nas chaves de API)
+	Email        string   // E-mail do usuário
+	Role         string   // Papel do usuário (admin, dispatcher, driver ou viewer)
+	MotoristaID  int      // ID do motorista vinculado ao usuário (apenas no papel driver; 0 se não houver)
+	EmbarcadorID int      // ID do embarcador do usuário ou da chave de API
+	APIKeyID     int      // ID da chave de API usada (0 nos tokens de usuário)
+	APIKey       string   // Prefixo da chave de API usada
+	Permissions  []string // Permissões da chave de API (os usuários recebem as permissões do papel)
+}
+
+// Can informa se o usuário tem a permissão: as chaves de API têm apenas as permissões concedidas a elas.
+func (p *Principal) Can(permission string) bool {
+	if p == nil {
+		return false
+	}
+	if p.APIKeyID != 0 {
+		return slices.Contains(p.Permissions, permission)
+	}
+	return Can(p.Role, permission)
+}
+
+// Authorize retorna um *PermissionError se o usuário não tiver a permissão.
+func (p *Principal) Authorize(permission string) error {
+	if p.Can(permission) {
+		return nil
+	}
+	err := &PermissionError{Permission: permission}
+	if p != nil {
+		err.Role, err.APIKey = p.Role, p.APIKey
+	}
+	return err
+}
+
+// Actor identifica quem fez a requisição nos registros de auditoria: o e-mail do usuário ou o prefixo da chave
+// de API. Retorna "" se a requisição não foi autenticada.
+func (p *Principal) Actor() string {
+	switch {
+	case p == nil:
+		return ""
+	case p.APIKeyID != 0:
+		return p.APIKey
+	}
+	return p.Email
+}
+
+// DriverScope informa se o usuário só pode acessar as entregas atribuídas a ele (papel driver), retornando
+// o ID do motorista vinculado. Um motorista sem vínculo recebe o ID -1, que não corresponde a nenhuma entrega.
+func (p *Principal) DriverScope() (int, bool) {
+	if p == nil || p.Role != RoleDriver {
+		return 0, false
+	}
+	if p.MotoristaID <= 0 {
+		return -1, true
+	}
+	return p.MotoristaID, true
+}
+
+// principalKey é a chave do usuário autenticado no contexto.
+type principalKey struct{}
+
+// WithPrincipal retorna uma cópia do contexto com o usuário autenticado.
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// FromContext retorna o usuário autenticado guardado no contexto, ou nil se a requisição não foi autenticada.
+func FromContext(ctx context.Context) *Principal {
+	principal, _ := ctx.Value(principalKey{}).(*Principal)
+	return principal
+}
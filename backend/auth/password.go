@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"errors"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// MinPasswordLength é o tamanho mínimo das senhas dos usuários.
+const MinPasswordLength = 8
+
+// ErrSenhaIncorreta é retornado quando a senha não corresponde ao hash armazenado.
+var ErrSenhaIncorreta = errors.New("senha incorreta")
+
+// dummyHash é comparado quando o usuário não existe, para que o tempo de resposta do login não revele
+// quais e-mails estão cadastrados.
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("senha-inexistente"), bcrypt.DefaultCost)
+
+// HashPassword gera o hash bcrypt da senha.
+func HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// CheckPassword verifica a senha contra o hash bcrypt. Com um hash vazio (usuário inexistente), a senha é
+// comparada com um hash fictício e ErrSenhaIncorreta é retornado.
+func CheckPassword(hash, password string) error {
+	if hash == "" {
+		bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+		return ErrSenhaIncorreta
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)); err != nil {
+		return ErrSenhaIncorreta
+	}
+	return nil
+}
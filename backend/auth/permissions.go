@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Papéis dos usuários da API.
+const (
+	RoleAdmin      = "admin"      // Acesso total, inclusive à exclusão de clientes e ao cadastro de usuários
+	RoleDispatcher = "dispatcher" // Operação do dia a dia: entregas, clientes, frota e rotas
+	RoleDriver     = "driver"     // Motorista: consulta e atualiza apenas as entregas atribuídas a ele
+	RoleViewer     = "viewer"     // Apenas consulta
+)
+
+// Roles são os papéis aceitos, do mais para o menos privilegiado.
+var Roles = []string{RoleAdmin, RoleDispatcher, RoleDriver, RoleViewer}
+
+// Permissões verificadas nas rotas da API, no formato "recurso:ação".
+const (
+	PermDeliveriesRead   = "deliveries:read"   // Consultar entregas e o histórico de rastreamento
+	PermDeliveriesWrite  = "deliveries:write"  // Cadastrar e atualizar entregas
+	PermDeliveriesDelete = "deliveries:delete" // Remover entregas
+	PermDeliveriesStatus = "deliveries:status" // Alterar o status e registrar eventos de rastreamento
+	PermClientsRead      = "clients:read"      // Consultar clientes
+	PermClientsWrite     = "clients:write"     // Cadastrar e atualizar clientes
+	PermClientsDelete    = "clients:delete"    // Remover clientes (e, em cascata, as suas entregas)
+	PermFleetRead        = "fleet:read"        // Consultar veículos, motoristas e zonas
+	PermFleetWrite       = "fleet:write"       // Cadastrar, atualizar e remover veículos, motoristas e zonas (apenas no embarcador padrão)
+	PermDriversAssign    = "drivers:assign"    // Atribuir entregas aos motoristas
+	PermRoutesPlan       = "routes:plan"       // Otimizar e planejar rotas
+	PermUsersManage      = "users:manage"      // Cadastrar usuários e gerenciar as chaves de API
+	PermAuditRead        = "audit:read"        // Consultar a auditoria das alterações em entregas e clientes
+)
+
+// Permissions são todas as permissões verificadas nas rotas.
+var Permissions = []string{
+	PermDeliveriesRead, PermDeliveriesWrite, PermDeliveriesDelete, PermDeliveriesStatus,
+	PermClientsRead, PermClientsWrite, PermClientsDelete,
+	PermFleetRead, PermFleetWrite, PermDriversAssign, PermRoutesPlan, PermUsersManage, PermAuditRead,
+}
+
+// rolePermissions é a matriz de permissões de cada papel. O papel admin tem todas as permissões.
+var rolePermissions = map[string][]string{
+	RoleDispatcher: {
+		PermDeliveriesRead, PermDeliveriesWrite, PermDeliveriesDelete, PermDeliveriesStatus,
+		PermClientsRead, PermClientsWrite,
+		PermFleetRead, PermFleetWrite, PermDriversAssign, PermRoutesPlan,
+	},
+	RoleDriver: {PermDeliveriesRead, PermDeliveriesStatus},
+	RoleViewer: {PermDeliveriesRead, PermClientsRead, PermFleetRead},
+}
+
+// ValidPermission informa se a permissão é uma das permissões verificadas nas rotas.
+func ValidPermission(permission string) bool {
+	return slices.Contains(Permissions, permission)
+}
+
+// ValidRole informa se o papel é um dos papéis aceitos.
+func ValidRole(role string) bool {
+	return slices.Contains(Roles, role)
+}
+
+// Can informa se o papel tem a permissão.
+func Can(role, permission string) bool {
+	if role == RoleAdmin {
+		return true
+	}
+	return slices.Contains(rolePermissions[role], permission)
+}
+
+// PermissionError é o erro de um usuário (ou chave de API) autenticado sem a permissão exigida pela rota.
+type PermissionError struct {
+	Role       string // Papel do usuário
+	APIKey     string // Prefixo da chave de API (vazio nas requisições de usuários)
+	Permission string // Permissão que faltou
+}
+
+// Error implementa error.
+func (e *PermissionError) Error() string {
+	if e.APIKey != "" {
+		return fmt.Sprintf("a chave de API '%s' não tem a permissão '%s'", e.APIKey, e.Permission)
+	}
+	return fmt.Sprintf("o papel '%s' não tem a permissão '%s'", e.Role, e.Permission)
+}
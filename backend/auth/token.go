@@ -0,0 +1,153 @@
+// Package auth implementa a autenticação da API: tokens JWT assinados com HS256 (de acesso e de renovação),
+// o hash das senhas dos usuários com bcrypt, as chaves de API das integrações, o usuário autenticado guardado
+// no contexto da requisição e a matriz de permissões de cada papel.
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Tipos de token emitidos pela API.
+const (
+	TokenAccess  = "access"  // Token de acesso, enviado no cabeçalho Authorization das requisições
+	TokenRefresh = "refresh" // Token de renovação, trocado por um novo par de tokens em /auth/refresh
+)
+
+// Erros retornados na validação dos tokens.
+var (
+	ErrTokenInvalido = errors.New("token inválido")
+	ErrTokenExpirado = errors.New("token expirado")
+)
+
+// header é o cabeçalho de todos os tokens emitidos: apenas HS256 é aceito.
+var header = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+// Claims são os dados assinados no token.
+type Claims struct {
+	Subject      string `json:"sub"`                 // ID do usuário
+	Email        string `json:"email"`               // E-mail do usuário
+	Role         string `json:"role"`                // Papel do usuário
+	MotoristaID  int    `json:"driver_id,omitempty"` // ID do motorista vinculado (apenas no papel driver)
+	EmbarcadorID int    `json:"tenant_id"`           // ID do embarcador do usuário
+	Type         string `json:"type"`                // Tipo do token (access ou refresh)
+	ID           string `json:"jti"`                 // ID aleatório do token
+	IssuedAt     int64  `json:"iat"`                 // Data de emissão (segundos desde 1970)
+	ExpiresAt    int64  `json:"exp"`                 // Data de expiração (segundos desde 1970)
+}
+
+// UserID retorna o ID do usuário do token.
+func (c Claims) UserID() (int, error) {
+	id, err := strconv.Atoi(c.Subject)
+	if err != nil || id <= 0 {
+		return 0, ErrTokenInvalido
+	}
+	return id, nil
+}
+
+// Principal retorna o usuário autenticado pelo token.
+func (c Claims) Principal() (*Principal, error) {
+	id, err := c.UserID()
+	if err != nil {
+		return nil, err
+	}
+	if !ValidRole(c.Role) {
+		return nil, ErrTokenInvalido // Tokens emitidos antes dos papéis (ou com um papel desconhecido) não são aceitos
+	}
+	if c.EmbarcadorID <= 0 {
+		return nil, ErrTokenInvalido // Tokens emitidos antes dos embarcadores não são aceitos
+	}
+	return &Principal{UserID: id, Email: c.Email, Role: c.Role, MotoristaID: c.MotoristaID, EmbarcadorID: c.EmbarcadorID}, nil
+}
+
+// Tokens emite e valida os tokens JWT da API.
+type Tokens struct {
+	Secret     []byte           // Chave secreta do HMAC-SHA256
+	AccessTTL  time.Duration    // Validade dos tokens de acesso
+	RefreshTTL time.Duration    // Validade dos tokens de renovação
+	Now        func() time.Time // Relógio usado na emissão e na validação (padrão: time.Now)
+}
+
+// now retorna a hora atual do relógio configurado.
+func (t *Tokens) now() time.Time {
+	if t.Now != nil {
+		return t.Now()
+	}
+	return time.Now()
+}
+
+// Issue emite um token do tipo informado para o usuário, retornando o token e a sua validade.
+func (t *Tokens) Issue(principal Principal, tokenType string) (string, time.Duration, error) {
+	ttl := t.AccessTTL
+	if tokenType == TokenRefresh {
+		ttl = t.RefreshTTL
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", 0, err
+	}
+	now := t.now()
+	claims := Claims{
+		Subject:      strconv.Itoa(principal.UserID),
+		Email:        principal.Email,
+		Role:         principal.Role,
+		MotoristaID:  principal.MotoristaID,
+		EmbarcadorID: principal.EmbarcadorID,
+		Type:         tokenType,
+		ID:           hex.EncodeToString(id),
+		IssuedAt:     now.Unix(),
+		ExpiresAt:    now.Add(ttl).Unix(),
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", 0, err
+	}
+
+	unsigned := header + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return unsigned + "." + t.sign(unsigned), ttl, nil
+}
+
+// Parse verifica a assinatura, o tipo e a validade do token e retorna os seus dados.
+func (t *Tokens) Parse(token, tokenType string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != header {
+		return nil, ErrTokenInvalido // Rejeita outros algoritmos (ex: "none") e tokens malformados
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrTokenInvalido
+	}
+	expected, _ := base64.RawURLEncoding.DecodeString(t.sign(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, expected) {
+		return nil, ErrTokenInvalido
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrTokenInvalido
+	}
+	var claims Claims
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Type != tokenType {
+		return nil, ErrTokenInvalido
+	}
+	if t.now().Unix() >= claims.ExpiresAt {
+		return nil, ErrTokenExpirado
+	}
+	return &claims, nil
+}
+
+// sign calcula a assinatura HMAC-SHA256 do cabeçalho e do payload, codificada em base64url.
+func (t *Tokens) sign(unsigned string) string {
+	mac := hmac.New(sha256.New, t.Secret)
+	mac.Write([]byte(unsigned))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
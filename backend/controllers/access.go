@@ -0,0 +1,85 @@
+package controllers
+
+import (
+	"net/http"
+
+	"meu-projeto/backend/apierror"
+	"meu-projeto/backend/auth"
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/services"
+)
+
+// tenantID retorna o embarcador do usuário (ou da chave de API) autenticado, ao qual os clientes e as entregas
+// acessados são restritos. Sem usuário autenticado (apenas nos testes dos controllers), usa o embarcador padrão.
+func tenantID(r *http.Request) int {
+	principal := auth.FromContext(r.Context())
+	if principal == nil || principal.EmbarcadorID == 0 {
+		return models.EmbarcadorPadrao
+	}
+	return principal.EmbarcadorID
+}
+
+// actor retorna quem fez a requisição, registrado na auditoria das operações de escrita: o e-mail do usuário ou
+// o prefixo da chave de API.
+func actor(r *http.Request) string {
+	return auth.FromContext(r.Context()).Actor()
+}
+
+// shipperScope retorna o embarcador cujos usuários e chaves de API o usuário autenticado gerencia, ou 0 se
+// ele gerencia todos: os usuários do embarcador padrão (a operação da plataforma) gerenciam os demais.
+func shipperScope(r *http.Request) int {
+	if tenant := tenantID(r); tenant != models.EmbarcadorPadrao {
+		return tenant
+	}
+	return 0
+}
+
+// authorizeShipper verifica se o usuário autenticado pode cadastrar usuários e chaves de API no embarcador
+// informado, respondendo com o erro 403 se ele for de outro embarcador.
+func authorizeShipper(r *http.Request, embarcadorID int) error {
+	if scope := shipperScope(r); scope != 0 && embarcadorID != scope {
+		return apierror.New(apierror.CodeForbidden, "shipper_forbidden")
+	}
+	return nil
+}
+
+// authorizeSharedWrite verifica se o usuário autenticado pode alterar os registros compartilhados por todos os
+// embarcadores (veículos, motoristas e zonas), respondendo com o erro 403 se ele não for do embarcador padrão:
+// as alterações afetam as entregas de todos os embarcadores e são feitas pela operação da plataforma.
+func authorizeSharedWrite(r *http.Request) error {
+	if shipperScope(r) != 0 {
+		return apierror.New(apierror.CodeForbidden, "shared_forbidden")
+	}
+	return nil
+}
+
+// driverScope retorna o ID do motorista ao qual o usuário autenticado está restrito (papel driver), ou 0 se
+// ele pode acessar todas as entregas.
+func driverScope(r *http.Request) int {
+	motoristaID, _ := auth.FromContext(r.Context()).DriverScope()
+	return motoristaID
+}
+
+// canAccessDelivery informa se o usuário autenticado pode acessar a entrega: os motoristas só acessam as
+// entregas atribuídas a eles.
+func canAccessDelivery(r *http.Request, delivery *models.Delivery) bool {
+	motoristaID := driverScope(r)
+	return motoristaID == 0 || (delivery.MotoristaID != nil && *delivery.MotoristaID == motoristaID)
+}
+
+// authorizeDelivery verifica se o usuário autenticado pode acessar a entrega informada, usando o serviço já
+// restrito ao embarcador dele. Para um motorista, as entregas de outros motoristas são tratadas como
+// inexistentes (ErrEntregaNaoEncontrada), para que o ID não revele a existência delas.
+func authorizeDelivery(r *http.Request, service *services.DeliveryService, id int) error {
+	if driverScope(r) == 0 {
+		return nil // Os demais papéis acessam todas as entregas (a própria rota trata as inexistentes)
+	}
+	delivery, err := service.FindByID(id)
+	if err != nil {
+		return err
+	}
+	if delivery == nil || !canAccessDelivery(r, delivery) {
+		return services.ErrEntregaNaoEncontrada
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"meu-projeto/backend/auth"
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/services"
+)
+
+// APIKeyController é responsável por lidar com as requisições HTTP de gerenciamento das chaves de API.
+type APIKeyController struct {
+	Service *services.APIKeyService // Serviço que contém a lógica das chaves de API
+}
+
+// Create godoc
+// @Summary Cadastra uma chave de API
+// @Description Gera uma chave de API para uma integração, com as permissões informadas e, opcionalmente, uma data de expiração. A chave completa só é retornada nesta resposta; guarde-a e envie-a no cabeçalho X-API-Key. Exige a permissão users:manage.
+// @Description A chave acessa apenas os clientes e as entregas do embarcador_id informado (padrão: o embarcador de quem faz o cadastro).
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param chave body models.ChaveAPI true "Nome, permissões, expiração e embarcador da chave"
+// @Success 201 {object} models.ChaveAPICriada
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 422 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /api-keys [post]
+func (c *APIKeyController) Create(w http.ResponseWriter, r *http.Request) {
+	// Decodifica o corpo da requisição JSON para a struct ChaveAPI
+	var key models.ChaveAPI
+	if err := json.NewDecoder(r.Body).Decode(&key); err != nil {
+		writeError(w, r, errInvalidJSON) // Retorna erro 400 se o JSON for inválido
+		return
+	}
+
+	// Sem embarcador informado, a chave é cadastrada no embarcador de quem faz o cadastro
+	if key.EmbarcadorID == 0 {
+		key.EmbarcadorID = tenantID(r)
+	}
+	if err := authorizeShipper(r, key.EmbarcadorID); err != nil {
+		writeError(w, r, err) // Retorna erro 403 se a chave for de outro embarcador
+		return
+	}
+
+	// Chama o serviço para gerar e cadastrar a chave
+	created, err := c.Service.Create(&key, auth.FromContext(r.Context()))
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 422 se houver campos inválidos
+		return
+	}
+
+	// Retorna o status 201 (Created) e a chave completa, que não pode ser consultada depois
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(created)
+}
+
+// List godoc
+// @Summary Lista as chaves de API
+// @Description Retorna as chaves de API cadastradas, identificadas pelo prefixo, com as permissões, a expiração e o último uso. Os usuários de outros embarcadores veem apenas as chaves do seu embarcador. Exige a permissão users:manage.
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} models.ChaveAPI
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /api-keys [get]
+func (c *APIKeyController) List(w http.ResponseWriter, r *http.Request) {
+	// Chama o serviço para obter as chaves
+	keys, err := c.Service.List(shipperScope(r))
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 500 se houver falha no serviço
+		return
+	}
+
+	// Retorna o status 200 (OK) e a lista de chaves no corpo da resposta
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(keys)
+}
+
+// Delete godoc
+// @Summary Remove uma chave de API
+// @Description Remove a chave de API pelo ID; as requisições com ela passam a ser recusadas imediatamente. As chaves de outros embarcadores são tratadas como inexistentes, exceto para os usuários do embarcador padrão. Exige a permissão users:manage.
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "ID da chave"
+// @Success 204 "No Content"
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /api-keys/{id} [delete]
+func (c *APIKeyController) Delete(w http.ResponseWriter, r *http.Request) {
+	// Extrai o ID da URL (ex: "/api-keys/1" -> "1")
+	id, err := strconv.Atoi(r.URL.Path[len("/api-keys/"):])
+	if err != nil {
+		writeError(w, r, errInvalidID) // Retorna erro 400 se o ID for inválido
+		return
+	}
+
+	// Chama o serviço para remover a chave
+	if err := c.Service.Delete(id, shipperScope(r)); err != nil {
+		writeError(w, r, err) // Retorna erro 404 se a chave não existir
+		return
+	}
+
+	// Retorna o status 204 (No Content) para indicar que a chave foi removida
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,82 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/services"
+)
+
+// AuditController é responsável por lidar com as requisições HTTP de consulta da auditoria.
+type AuditController struct {
+	Service *services.AuditService // Serviço que contém a lógica da auditoria
+}
+
+// List godoc
+// @Summary Consulta a auditoria
+// @Description Retorna os registros das operações de cadastro, alteração e remoção de entregas e clientes do embarcador do usuário, do mais recente para o mais antigo: quem fez, quando e os valores antes e depois de cada campo alterado. Exige a permissão audit:read.
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param entity query string false "Entidade alterada (delivery ou client)"
+// @Param id query int false "ID do registro alterado (exige entity)"
+// @Param from query string false "Início do período (AAAA-MM-DD ou AAAA-MM-DDTHH:MM:SSZ), inclusive"
+// @Param to query string false "Fim do período (AAAA-MM-DD, inclusive, ou AAAA-MM-DDTHH:MM:SSZ, exclusive)"
+// @Param page query int false "Página (padrão 1)"
+// @Param page_size query int false "Itens por página (padrão 20, máximo 100)"
+// @Success 200 {object} models.Page[models.RegistroAuditoria]
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /audit [get]
+func (c *AuditController) List(w http.ResponseWriter, r *http.Request) {
+	// Extrai os filtros e a paginação da query string
+	filter, err := parseAuditFilter(r.URL.Query())
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 400 se algum parâmetro for inválido
+		return
+	}
+
+	// Cada embarcador consulta apenas a auditoria dos seus registros
+	filter.EmbarcadorID = tenantID(r)
+
+	// Chama o serviço para obter a página de registros
+	page, err := c.Service.List(filter)
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 400 se os filtros forem inválidos
+		return
+	}
+
+	// Retorna o status 200 (OK) e a página de registros no corpo da resposta
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(page)
+}
+
+// parseAuditFilter monta o filtro da consulta da auditoria a partir da query string.
+func parseAuditFilter(query url.Values) (models.AuditFilter, error) {
+	filter := models.AuditFilter{Entidade: query.Get("entity")}
+
+	var err error
+	if filter.EntidadeID, err = parseIntParam(query, "id"); err != nil {
+		return filter, err
+	}
+	if filter.De, _, err = parseTimeParam(query, "from"); err != nil {
+		return filter, err
+	}
+	var dateOnly bool
+	if filter.Ate, dateOnly, err = parseTimeParam(query, "to"); err != nil {
+		return filter, err
+	}
+	if filter.Ate != nil && dateOnly {
+		// Apenas com a data, o fim do período é inclusivo: considera todo o dia informado
+		end := filter.Ate.AddDate(0, 0, 1)
+		filter.Ate = &end
+	}
+	if filter.Page, filter.PageSize, err = parsePagination(query); err != nil {
+		return filter, err
+	}
+	return filter, nil
+}
@@ -0,0 +1,191 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"meu-projeto/backend/auth"
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/services"
+)
+
+// AuthController é responsável por lidar com as requisições HTTP de autenticação e por proteger as rotas
+// que exigem um usuário autenticado.
+type AuthController struct {
+	Service *services.AuthService   // Serviço que contém a lógica de autenticação
+	APIKeys *services.APIKeyService // Serviço das chaves de API (opcional: sem ele, o cabeçalho X-API-Key é ignorado)
+}
+
+// Login godoc
+// @Summary Entra na API
+// @Description Verifica o e-mail e a senha e retorna um token de acesso (enviado no cabeçalho "Authorization: Bearer <token>") e um token de renovação.
+// @Accept json
+// @Produce json
+// @Param credenciais body models.LoginRequest true "E-mail e senha"
+// @Success 200 {object} models.TokenPair
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response "E-mail ou senha incorretos"
+// @Failure 500 {object} apierror.Response
+// @Router /auth/login [post]
+func (c *AuthController) Login(w http.ResponseWriter, r *http.Request) {
+	// Decodifica o corpo da requisição JSON para a struct LoginRequest
+	var request models.LoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeError(w, r, errInvalidJSON) // Retorna erro 400 se o JSON for inválido
+		return
+	}
+
+	// Chama o serviço para verificar as credenciais e emitir os tokens
+	tokens, err := c.Service.Login(request.Email, request.Senha)
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 401 se o e-mail ou a senha estiverem incorretos
+		return
+	}
+
+	// Retorna o status 200 (OK) e os tokens no corpo da resposta
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(tokens)
+}
+
+// Refresh godoc
+// @Summary Renova os tokens
+// @Description Troca um token de renovação válido por um novo par de tokens.
+// @Accept json
+// @Produce json
+// @Param token body models.RefreshRequest true "Token de renovação"
+// @Success 200 {object} models.TokenPair
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response "Token inválido ou expirado"
+// @Failure 500 {object} apierror.Response
+// @Router /auth/refresh [post]
+func (c *AuthController) Refresh(w http.ResponseWriter, r *http.Request) {
+	// Decodifica o corpo da requisição JSON para a struct RefreshRequest
+	var request models.RefreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeError(w, r, errInvalidJSON) // Retorna erro 400 se o JSON for inválido
+		return
+	}
+
+	// Chama o serviço para validar o token de renovação e emitir os novos tokens
+	tokens, err := c.Service.Refresh(request.RefreshToken)
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 401 se o token for inválido ou expirado
+		return
+	}
+
+	// Retorna o status 200 (OK) e os novos tokens no corpo da resposta
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(tokens)
+}
+
+// Me godoc
+// @Summary Retorna o usuário autenticado
+// @Description Retorna os dados do usuário dono do token de acesso.
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} models.Usuario
+// @Failure 401 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /auth/me [get]
+func (c *AuthController) Me(w http.ResponseWriter, r *http.Request) {
+	// Chama o serviço para buscar o usuário autenticado
+	user, err := c.Service.CurrentUser(auth.FromContext(r.Context()))
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 401 se o usuário não existir mais
+		return
+	}
+
+	// Retorna o status 200 (OK) e o usuário no corpo da resposta
+	json.NewEncoder(w).Encode(user)
+}
+
+// CreateUser godoc
+// @Summary Cadastra um usuário
+// @Description Cadastra um usuário da API (exige a permissão users:manage). A senha deve ter entre 8 e 72 caracteres e é guardada apenas como hash bcrypt.
+// @Description O papel (admin, dispatcher, driver ou viewer, o padrão) define as permissões do usuário; o papel driver exige o motorista_id do motorista cujas entregas o usuário pode acessar.
+// @Description O embarcador_id define o embarcador cujos clientes e entregas o usuário acessa (padrão: o embarcador de quem faz o cadastro).
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param usuario body models.Usuario true "Nome, e-mail, senha, papel, motorista e embarcador do usuário"
+// @Success 201 {object} models.Usuario
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 409 {object} apierror.Response "E-mail já cadastrado"
+// @Failure 422 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /users [post]
+func (c *AuthController) CreateUser(w http.ResponseWriter, r *http.Request) {
+	// Decodifica o corpo da requisição JSON para a struct Usuario
+	var user models.Usuario
+	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+		writeError(w, r, errInvalidJSON) // Retorna erro 400 se o JSON for inválido
+		return
+	}
+
+	// Sem embarcador informado, o usuário é cadastrado no embarcador de quem faz o cadastro
+	if user.EmbarcadorID == 0 {
+		user.EmbarcadorID = tenantID(r)
+	}
+	if err := authorizeShipper(r, user.EmbarcadorID); err != nil {
+		writeError(w, r, err) // Retorna erro 403 se o usuário for de outro embarcador
+		return
+	}
+
+	// Chama o serviço para cadastrar o usuário
+	if err := c.Service.CreateUser(&user); err != nil {
+		writeError(w, r, err) // Retorna erro 409 se o e-mail já estiver cadastrado ou 422 se houver campos inválidos
+		return
+	}
+
+	// Retorna o status 201 (Created) e o usuário cadastrado (sem a senha) no corpo da resposta
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(user)
+}
+
+// Require é o middleware que exige um token de acesso válido no cabeçalho "Authorization: Bearer <token>" ou
+// uma chave de API no cabeçalho X-API-Key. O usuário (ou a integração) autenticado é guardado no contexto da
+// requisição (veja auth.FromContext); sem credenciais, ou com credenciais inválidas ou expiradas, a
+// requisição é respondida com o erro 401.
+func (c *AuthController) Require(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if key := strings.TrimSpace(r.Header.Get("X-API-Key")); key != "" && c.APIKeys != nil {
+			principal, err := c.APIKeys.Authenticate(key)
+			if err != nil {
+				writeError(w, r, err)
+				return
+			}
+			next(w, r.WithContext(auth.WithPrincipal(r.Context(), principal)))
+			return
+		}
+
+		scheme, token, _ := strings.Cut(r.Header.Get("Authorization"), " ")
+		if !strings.EqualFold(scheme, "Bearer") || token == "" {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="api"`)
+			writeError(w, r, services.ErrNaoAutenticado)
+			return
+		}
+
+		principal, err := c.Service.Authenticate(strings.TrimSpace(token))
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="api", error="invalid_token"`)
+			writeError(w, r, err)
+			return
+		}
+		next(w, r.WithContext(auth.WithPrincipal(r.Context(), principal)))
+	}
+}
+
+// Allow é o middleware que exige a permissão informada do usuário autenticado (veja Require). Sem a
+// permissão, a requisição é respondida com o erro 403, que informa a permissão que faltou.
+func (c *AuthController) Allow(permission string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := auth.FromContext(r.Context()).Authorize(permission); err != nil {
+			writeError(w, r, err)
+			return
+		}
+		next(w, r)
+	}
+}
@@ -0,0 +1,43 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"meu-projeto/backend/services"
+)
+
+// CEPController é responsável por lidar com as requisições HTTP de consulta de CEP.
+type CEPController struct {
+	Service *services.CEPService // Serviço que contém a lógica da consulta de CEP
+}
+
+// Lookup godoc
+// @Summary Consulta o endereço de um CEP
+// @Description Retorna o logradouro, o bairro, a cidade e o estado de um CEP (com ou sem pontuação), consultando a tabela local de CEPs e, se configurado, um serviço HTTP compatível com o ViaCEP.
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param cep path string true "CEP (ex: 01310-100)"
+// @Success 200 {object} models.CEPAddress
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Failure 502 {object} apierror.Response
+// @Router /cep/{cep} [get]
+func (c *CEPController) Lookup(w http.ResponseWriter, r *http.Request) {
+	// Extrai o CEP da URL (ex: "/cep/01310-100" -> "01310-100")
+	cep := r.URL.Path[len("/cep/"):]
+
+	// Chama o serviço para consultar o CEP
+	address, err := c.Service.Lookup(cep)
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 400 se o CEP for inválido, 404 se o CEP não for encontrado, 502 se o serviço de CEP falhar ou 500 se houver falha no serviço
+		return
+	}
+
+	// Retorna o status 200 (OK) e o endereço do CEP no corpo da resposta
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(address)
+}
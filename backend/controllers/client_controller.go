@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"meu-projeto/backend/models"
 	"meu-projeto/backend/services"
@@ -14,28 +15,40 @@ type ClientController struct {
 	Service *services.ClientService // Serviço que contém a lógica de negócio para clientes
 }
 
+// tenantService retorna o serviço restrito ao embarcador do usuário autenticado, cujos clientes são os únicos acessíveis,
+// e que registra as alterações na auditoria em nome dele.
+func (controller *ClientController) tenantService(r *http.Request) *services.ClientService {
+	return controller.Service.ForTenant(tenantID(r)).ForActor(actor(r))
+}
+
 // Create godoc
 // @Summary Cria um novo cliente
-// @Description Cria um novo cliente no sistema.
+// @Description Cria um novo cliente no sistema. O nome e o CPF são obrigatórios; o e-mail, se informado, deve ser válido.
 // @Accept json
 // @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
 // @Param cliente body models.Cliente true "Dados do cliente"
 // @Success 201 {object} models.Cliente
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 409 {object} apierror.Response "CPF já cadastrado"
+// @Failure 422 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
 // @Router /clients [post]
 func (controller *ClientController) Create(w http.ResponseWriter, r *http.Request) {
 	var client models.Cliente
 
 	// Decodifica o corpo da requisição JSON para a struct Cliente
 	if err := json.NewDecoder(r.Body).Decode(&client); err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest) // Retorna erro 400 se o JSON for inválido
+		writeError(w, r, errInvalidJSON) // Retorna erro 400 se o JSON for inválido
 		return
 	}
 
 	// Chama o serviço para criar o cliente no banco de dados
-	if err := controller.Service.Create(&client); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError) // Retorna erro 500 se houver falha no serviço
+	if err := controller.tenantService(r).Create(&client); err != nil {
+		writeError(w, r, err) // Retorna erro 409 se o CPF já estiver cadastrado, 422 se houver campos inválidos ou 500
 		return
 	}
 
@@ -45,48 +58,75 @@ func (controller *ClientController) Create(w http.ResponseWriter, r *http.Reques
 }
 
 // List godoc
-// @Summary Lista todos os clientes
-// @Description Retorna uma lista de todos os clientes cadastrados.
+// @Summary Lista os clientes
+// @Description Retorna uma página de clientes. A busca "q" é parcial e ignora acentos em nome, e-mail e telefone, e exata no CPF (com ou sem pontuação).
 // @Produce json
-// @Success 200 {array} models.Cliente
-// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param q query string false "Texto buscado em nome, e-mail, telefone ou CPF"
+// @Param sort query string false "Campo de ordenação (id, nome, cpf, email)"
+// @Param order query string false "Direção da ordenação (asc ou desc)"
+// @Param page query int false "Página (padrão 1)"
+// @Param page_size query int false "Itens por página (padrão 20, máximo 100)"
+// @Success 200 {object} models.Page[models.Cliente]
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
 // @Router /clients [get]
 func (controller *ClientController) List(w http.ResponseWriter, r *http.Request) {
-	// Chama o serviço para obter a lista de clientes
-	clients, err := controller.Service.List()
+	query := r.URL.Query()
+	filter := models.ClientFilter{Q: strings.TrimSpace(query.Get("q"))}
+
+	// Extrai a ordenação e a paginação da query string
+	var err error
+	if filter.Sort, filter.Desc, err = parseSort(query, models.ClientSortColumns); err == nil {
+		filter.Page, filter.PageSize, err = parsePagination(query)
+	}
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 400 se algum parâmetro for inválido
+		return
+	}
+
+	// Chama o serviço para obter a página de clientes
+	page, err := controller.tenantService(r).List(filter)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError) // Retorna erro 500 se houver falha no serviço
+		writeError(w, r, err) // Retorna erro 500 se houver falha no serviço
 		return
 	}
 
-	// Retorna o status 200 (OK) e a lista de clientes no corpo da resposta
+	// Retorna o status 200 (OK) e a página de clientes no corpo da resposta
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(clients)
+	json.NewEncoder(w).Encode(page)
 }
 
 // FindByID godoc
 // @Summary Busca um cliente pelo ID
 // @Description Retorna os detalhes de um cliente específico com base no ID.
 // @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
 // @Param id path int true "ID do cliente"
 // @Success 200 {object} models.Cliente
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
 // @Router /clients/id/{id} [get]
 func (controller *ClientController) FindByID(w http.ResponseWriter, r *http.Request) {
 	// Extrai o ID da URL (ex: "/clients/id/1" -> "1")
 	idStr := r.URL.Path[len("/clients/id/"):]
 	id, err := strconv.Atoi(idStr) // Converte o ID de string para int
 	if err != nil {
-		http.Error(w, "ID inválido", http.StatusBadRequest) // Retorna erro 400 se o ID for inválido
+		writeError(w, r, errInvalidID) // Retorna erro 400 se o ID for inválido
 		return
 	}
 
 	// Chama o serviço para buscar o cliente pelo ID
-	client, err := controller.Service.FindByID(id)
+	client, err := controller.tenantService(r).FindByID(id)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusNotFound) // Retorna erro 404 se o cliente não for encontrado
+		writeError(w, r, err) // Retorna erro 404 se o cliente não for encontrado
 		return
 	}
 
@@ -100,23 +140,30 @@ func (controller *ClientController) FindByID(w http.ResponseWriter, r *http.Requ
 // @Description Atualiza os dados de um cliente existente.
 // @Accept json
 // @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
 // @Param cliente body models.Cliente true "Dados do cliente"
 // @Success 200 {object} models.Cliente
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 409 {object} apierror.Response "CPF já cadastrado"
+// @Failure 422 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
 // @Router /clients [put]
 func (controller *ClientController) Update(w http.ResponseWriter, r *http.Request) {
 	var client models.Cliente
 
 	// Decodifica o corpo da requisição JSON para a struct Cliente
 	if err := json.NewDecoder(r.Body).Decode(&client); err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest) // Retorna erro 400 se o JSON for inválido
+		writeError(w, r, errInvalidJSON) // Retorna erro 400 se o JSON for inválido
 		return
 	}
 
 	// Chama o serviço para atualizar o cliente no banco de dados
-	if err := controller.Service.Update(&client); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError) // Retorna erro 500 se houver falha no serviço
+	if err := controller.tenantService(r).Update(&client); err != nil {
+		writeError(w, r, err) // Retorna erro 404 se o cliente não existir, 409 se o CPF já estiver cadastrado ou 422 se houver campos inválidos
 		return
 	}
 
@@ -129,23 +176,28 @@ func (controller *ClientController) Update(w http.ResponseWriter, r *http.Reques
 // @Summary Exclui um cliente
 // @Description Exclui um cliente pelo ID.
 // @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
 // @Param id path int true "ID do cliente"
 // @Success 204 "No Content"
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
 // @Router /clients/{id} [delete]
 func (controller *ClientController) Delete(w http.ResponseWriter, r *http.Request) {
 	// Extrai o ID da URL (ex: "/clients/1" -> "1")
 	idStr := r.URL.Path[len("/clients/"):]
 	id, err := strconv.Atoi(idStr) // Converte o ID de string para int
 	if err != nil {
-		http.Error(w, "ID inválido", http.StatusBadRequest) // Retorna erro 400 se o ID for inválido
+		writeError(w, r, errInvalidID) // Retorna erro 400 se o ID for inválido
 		return
 	}
 
 	// Chama o serviço para deletar o cliente pelo ID
-	if err := controller.Service.Delete(id); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError) // Retorna erro 500 se houver falha no serviço
+	if err := controller.tenantService(r).Delete(id); err != nil {
+		writeError(w, r, err) // Retorna erro 404 se o cliente não existir ou 500 se houver falha no serviço
 		return
 	}
 
@@ -2,12 +2,15 @@ package controllers
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 
+	"meu-projeto/backend/apierror"
 	"meu-projeto/backend/models"
 	"meu-projeto/backend/services"
-	"meu-projeto/backend/utils"
 )
 
 // DeliveryController é responsável por lidar com as requisições HTTP relacionadas à entidade "Delivery" (Entregas).
@@ -15,16 +18,28 @@ type DeliveryController struct {
 	Service *services.DeliveryService // Serviço que contém a lógica de negócio para entregas
 }
 
+// tenantService retorna o serviço restrito ao embarcador do usuário autenticado (as entregas e os clientes de
+// outros embarcadores são tratados como inexistentes), que registra as alterações na auditoria em nome dele.
+func (c *DeliveryController) tenantService(r *http.Request) *services.DeliveryService {
+	return c.Service.ForTenant(tenantID(r)).ForActor(actor(r))
+}
+
 // Create godoc
 // @Summary Cria uma nova entrega
-// @Description Cria uma nova entrega associada a um cliente.
+// @Description Cria uma nova entrega associada a um cliente. Se apenas o CEP e o número forem informados, o logradouro, o bairro, a cidade e o estado são preenchidos a partir do CEP. Se a latitude e a longitude forem omitidas, são obtidas a partir do endereço (logradouro, bairro e cidade). O estado é convertido na sigla (UF), a cidade no nome oficial do IBGE, e coordenadas fora do Brasil são rejeitadas.
 // @Accept json
 // @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
 // @Param delivery body models.Delivery true "Dados da entrega"
 // @Param cliente body models.Cliente true "Dados do cliente"
 // @Success 200 {object} map[string]interface{}
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 422 {object} apierror.Response "Campos inválidos (ex: delivery.peso, cliente.cpf), CEP não encontrado ou endereço não geocodificado"
+// @Failure 500 {object} apierror.Response
+// @Failure 502 {object} apierror.Response
 // @Router /deliveries [post]
 func (c *DeliveryController) Create(w http.ResponseWriter, r *http.Request) {
 	var request struct {
@@ -34,52 +49,18 @@ func (c *DeliveryController) Create(w http.ResponseWriter, r *http.Request) {
 
 	// Decodifica o corpo da requisição JSON para a struct request
 	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
-		w.WriteHeader(http.StatusBadRequest) // Retorna erro 400 se o JSON for inválido
-		json.NewEncoder(w).Encode(map[string]string{"error": "Erro ao decodificar o JSON"})
-		return
-	}
-
-	// Validação do formato do CPF do cliente
-	if !utils.ValidateCPF(request.Cliente.CPF) {
-		w.WriteHeader(http.StatusBadRequest) // Retorna erro 400 se o CPF for inválido
-		json.NewEncoder(w).Encode(map[string]string{"error": "CPF inválido"})
-		return
-	}
-
-	// Validação dos campos obrigatórios da entrega
-	if request.Delivery.Peso <= 0 {
-		w.WriteHeader(http.StatusBadRequest) // Retorna erro 400 se o peso for inválido
-		json.NewEncoder(w).Encode(map[string]string{"error": "O campo 'peso' é obrigatório e deve ser maior que zero"})
-		return
-	}
-	if request.Delivery.Endereco == "" {
-		w.WriteHeader(http.StatusBadRequest) // Retorna erro 400 se o endereço estiver vazio
-		json.NewEncoder(w).Encode(map[string]string{"error": "O campo 'endereco' é obrigatório"})
-		return
-	}
-	if request.Delivery.Cidade == "" {
-		w.WriteHeader(http.StatusBadRequest) // Retorna erro 400 se a cidade estiver vazia
-		json.NewEncoder(w).Encode(map[string]string{"error": "O campo 'cidade' é obrigatório"})
-		return
-	}
-
-	// Validação dos campos obrigatórios do cliente
-	if request.Cliente.Nome == "" {
-		w.WriteHeader(http.StatusBadRequest) // Retorna erro 400 se o nome do cliente estiver vazio
-		json.NewEncoder(w).Encode(map[string]string{"error": "O campo 'nome' do cliente é obrigatório"})
-		return
-	}
-	if request.Cliente.CPF == "" {
-		w.WriteHeader(http.StatusBadRequest) // Retorna erro 400 se o CPF do cliente estiver vazio
-		json.NewEncoder(w).Encode(map[string]string{"error": "O campo 'cpf' do cliente é obrigatório"})
+		writeError(w, r, errInvalidJSON) // Retorna erro 400 se o JSON for inválido
 		return
 	}
 
 	// Chama o serviço para criar a entrega e o cliente no banco de dados
-	id, err := c.Service.Create(request.Delivery, request.Cliente)
+	id, err := c.tenantService(r).Create(request.Delivery, request.Cliente)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError) // Retorna erro 500 se houver falha no serviço
-		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		if errors.Is(err, services.ErrCEPNaoEncontrado) {
+			// No cadastro, o CEP inexistente é um dado que não pode ser processado (422), e não um recurso ausente
+			err = apierror.Wrap(err, apierror.CodeUnprocessable, "cep_not_found")
+		}
+		writeError(w, r, err) // Retorna erro 422 com os campos inválidos ou o endereço não encontrado, ou 502 se o provedor falhar
 		return
 	}
 
@@ -89,55 +70,139 @@ func (c *DeliveryController) Create(w http.ResponseWriter, r *http.Request) {
 }
 
 // List godoc
-// @Summary Lista todas as entregas
-// @Description Retorna uma lista de todas as entregas cadastradas.
+// @Summary Lista as entregas
+// @Description Retorna uma página de entregas, com filtros combináveis, ordenação e o total de entregas encontradas. Usuários com o papel driver só recebem as entregas atribuídas a eles.
 // @Produce json
-// @Success 200 {array} models.Delivery
-// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param cidade query string false "Filtra pela cidade"
+// @Param estado query string false "Filtra pelo estado"
+// @Param bairro query string false "Filtra pelo bairro"
+// @Param status query string false "Filtra pelo status"
+// @Param cliente_id query int false "Filtra pelo cliente"
+// @Param motorista_id query int false "Filtra pelo motorista responsável"
+// @Param zona_id query int false "Filtra pela zona de entrega"
+// @Param fora_de_zona query bool false "Apenas as entregas fora de todas as zonas (para revisão)"
+// @Param peso_min query number false "Peso mínimo (kg)"
+// @Param peso_max query number false "Peso máximo (kg)"
+// @Param data_inicio query string false "Data de cadastro inicial (AAAA-MM-DD)"
+// @Param data_fim query string false "Data de cadastro final, inclusive (AAAA-MM-DD)"
+// @Param sort query string false "Campo de ordenação (id, cliente_id, peso, cidade, estado, bairro, status, data_cadastro)"
+// @Param order query string false "Direção da ordenação (asc ou desc)"
+// @Param page query int false "Página (padrão 1)"
+// @Param page_size query int false "Itens por página (padrão 20, máximo 100)"
+// @Success 200 {object} models.Page[models.Delivery]
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
 // @Router /deliveries [get]
 func (c *DeliveryController) List(w http.ResponseWriter, r *http.Request) {
-	// Chama o serviço para obter a lista de entregas
-	deliveries, err := c.Service.List()
+	// Extrai os filtros, a ordenação e a paginação da query string
+	filter, err := parseDeliveryFilter(r.URL.Query())
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError) // Retorna erro 500 se houver falha no serviço
+		writeError(w, r, err) // Retorna erro 400 se algum parâmetro for inválido
 		return
 	}
 
-	// Retorna o status 200 (OK) e a lista de entregas no corpo da resposta
+	// Os motoristas só listam as entregas atribuídas a eles, qualquer que seja o filtro informado
+	if motoristaID := driverScope(r); motoristaID != 0 {
+		filter.MotoristaID = motoristaID
+	}
+
+	// Chama o serviço para obter a página de entregas
+	page, err := c.tenantService(r).List(filter)
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 500 se houver falha no serviço
+		return
+	}
+
+	// Retorna o status 200 (OK) e a página de entregas no corpo da resposta
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(deliveries)
+	json.NewEncoder(w).Encode(page)
+}
+
+// parseDeliveryFilter monta o filtro da listagem de entregas a partir da query string.
+func parseDeliveryFilter(query url.Values) (models.DeliveryFilter, error) {
+	filter := models.DeliveryFilter{
+		Cidade: query.Get("cidade"),
+		Estado: query.Get("estado"),
+		Bairro: query.Get("bairro"),
+		Status: query.Get("status"),
+	}
+
+	var err error
+	if filter.ClienteID, err = parseIntParam(query, "cliente_id"); err != nil {
+		return filter, err
+	}
+	if filter.MotoristaID, err = parseIntParam(query, "motorista_id"); err != nil {
+		return filter, err
+	}
+	if filter.ZonaID, err = parseIntParam(query, "zona_id"); err != nil {
+		return filter, err
+	}
+	foraDeZona, err := parseBoolParam(query, "fora_de_zona")
+	if err != nil {
+		return filter, err
+	}
+	filter.ForaDeZona = foraDeZona != nil && *foraDeZona
+	if filter.PesoMin, err = parseFloatParam(query, "peso_min"); err != nil {
+		return filter, err
+	}
+	if filter.PesoMax, err = parseFloatParam(query, "peso_max"); err != nil {
+		return filter, err
+	}
+	if filter.DataInicio, err = parseDateParam(query, "data_inicio"); err != nil {
+		return filter, err
+	}
+	if filter.DataFim, err = parseDateParam(query, "data_fim"); err != nil {
+		return filter, err
+	}
+	if filter.DataFim != nil {
+		// A data final é inclusiva: considera todo o dia informado
+		end := filter.DataFim.AddDate(0, 0, 1)
+		filter.DataFim = &end
+	}
+	if filter.Sort, filter.Desc, err = parseSort(query, models.DeliverySortColumns); err != nil {
+		return filter, err
+	}
+	if filter.Page, filter.PageSize, err = parsePagination(query); err != nil {
+		return filter, err
+	}
+	return filter, nil
 }
 
 // FindByID godoc
 // @Summary Busca uma entrega pelo ID
-// @Description Retorna os detalhes de uma entrega específica com base no ID.
+// @Description Retorna os detalhes de uma entrega específica com base no ID. Usuários com o papel driver só acessam as entregas atribuídas a eles.
 // @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
 // @Param id path int true "ID da entrega"
 // @Success 200 {object} models.Delivery
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
 // @Router /deliveries/id/{id} [get]
 func (c *DeliveryController) FindByID(w http.ResponseWriter, r *http.Request) {
 	// Extrai o ID da URL (ex: "/deliveries/id/1" -> "1")
 	id, err := strconv.Atoi(r.URL.Path[len("/deliveries/id/"):])
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest) // Retorna erro 400 se o ID for inválido
-		json.NewEncoder(w).Encode(map[string]string{"error": "ID inválido"})
+		writeError(w, r, errInvalidID) // Retorna erro 400 se o ID for inválido
 		return
 	}
 
 	// Chama o serviço para buscar a entrega pelo ID
-	delivery, err := c.Service.FindByID(id)
+	delivery, err := c.tenantService(r).FindByID(id)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError) // Retorna erro 500 se houver falha no serviço
-		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		writeError(w, r, err) // Retorna erro 500 se houver falha no serviço
 		return
 	}
 
-	if delivery == nil {
-		w.WriteHeader(http.StatusNotFound) // Retorna erro 404 se a entrega não for encontrada
-		json.NewEncoder(w).Encode(map[string]string{"error": "Entrega não encontrada"})
+	if delivery == nil || !canAccessDelivery(r, delivery) {
+		writeError(w, r, services.ErrEntregaNaoEncontrada) // Retorna erro 404 se a entrega não for encontrada ou for de outro motorista
 		return
 	}
 
@@ -148,27 +213,92 @@ func (c *DeliveryController) FindByID(w http.ResponseWriter, r *http.Request) {
 
 // FindByCity godoc
 // @Summary Busca entregas por cidade
-// @Description Retorna uma lista de entregas filtradas por cidade.
+// @Description Retorna uma lista de entregas filtradas por cidade. Usuários com o papel driver só recebem as entregas atribuídas a eles.
 // @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
 // @Param cidade query string true "Nome da cidade"
 // @Success 200 {array} models.Delivery
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
 // @Router /deliveries/city [get]
 func (c *DeliveryController) FindByCity(w http.ResponseWriter, r *http.Request) {
 	// Extrai o parâmetro "cidade" da query string
 	cidade := r.URL.Query().Get("cidade")
 	if cidade == "" {
-		w.WriteHeader(http.StatusBadRequest) // Retorna erro 400 se o parâmetro "cidade" estiver vazio
-		json.NewEncoder(w).Encode(map[string]string{"error": "O parâmetro 'cidade' é obrigatório"})
+		writeError(w, r, apierror.New(apierror.CodeBadRequest, "param_required", "cidade")) // Retorna erro 400 se o parâmetro "cidade" estiver vazio
 		return
 	}
 
 	// Chama o serviço para buscar as entregas por cidade
-	deliveries, err := c.Service.FindByCity(cidade)
+	deliveries, err := c.tenantService(r).FindByCity(cidade)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError) // Retorna erro 500 se houver falha no serviço
-		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		writeError(w, r, err) // Retorna erro 500 se houver falha no serviço
+		return
+	}
+
+	// Os motoristas só recebem as entregas atribuídas a eles
+	visible := deliveries[:0]
+	for _, delivery := range deliveries {
+		if canAccessDelivery(r, &delivery) {
+			visible = append(visible, delivery)
+		}
+	}
+	deliveries = visible
+
+	// Retorna o status 200 (OK) e a lista de entregas no corpo da resposta
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(deliveries)
+}
+
+// Nearby godoc
+// @Summary Busca entregas próximas a um ponto
+// @Description Retorna as entregas a até radius_km do ponto informado, da mais próxima para a mais distante, com a distância calculada. Sem o parâmetro status, retorna apenas as entregas em aberto (status não final). Usuários com o papel driver só recebem as entregas atribuídas a eles.
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param lat query number true "Latitude do ponto"
+// @Param lng query number true "Longitude do ponto"
+// @Param radius_km query number true "Raio da busca em km (máximo 500)"
+// @Param status query string false "Filtra pelo status"
+// @Param limit query int false "Quantidade máxima de entregas (padrão 50, máximo 100)"
+// @Success 200 {array} models.NearbyDelivery
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /deliveries/nearby [get]
+func (c *DeliveryController) Nearby(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	// Extrai os parâmetros obrigatórios e o limite da query string
+	var lat, lng, radius *float64
+	var err error
+	limit := models.DefaultNearbyLimit
+	if lat, err = parseFloatParam(query, "lat"); err == nil {
+		if lng, err = parseFloatParam(query, "lng"); err == nil {
+			radius, err = parseFloatParam(query, "radius_km")
+		}
+	}
+	if err == nil && (lat == nil || lng == nil || radius == nil) {
+		err = apierror.New(apierror.CodeBadRequest, "nearby_params_required")
+	}
+	if value := query.Get("limit"); err == nil && value != "" {
+		if limit, err = strconv.Atoi(value); err != nil || limit < 1 || limit > models.MaxPageSize {
+			err = apierror.New(apierror.CodeBadRequest, "param_range", "limit", 1, models.MaxPageSize)
+		}
+	}
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 400 se algum parâmetro for inválido
+		return
+	}
+
+	// Chama o serviço para buscar as entregas próximas (apenas as do motorista, no papel driver)
+	deliveries, err := c.tenantService(r).Nearby(*lat, *lng, *radius, query.Get("status"), driverScope(r), limit)
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 400 se os parâmetros forem inválidos ou 500 se houver falha no serviço
 		return
 	}
 
@@ -179,33 +309,40 @@ func (c *DeliveryController) FindByCity(w http.ResponseWriter, r *http.Request)
 
 // Update godoc
 // @Summary Atualiza uma entrega
-// @Description Atualiza os dados de uma entrega existente.
+// @Description Atualiza os dados de uma entrega existente. O endereço é padronizado como no cadastro (UF, nome oficial da cidade e coordenadas no Brasil).
 // @Accept json
 // @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
 // @Param id path int true "ID da entrega"
 // @Param delivery body models.Delivery true "Dados da entrega"
 // @Success 200 {object} map[string]string
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 409 {object} apierror.Response "Cliente inexistente"
+// @Failure 422 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
 // @Router /deliveries/{id} [put]
 func (c *DeliveryController) Update(w http.ResponseWriter, r *http.Request) {
 	// Extrai o ID da URL (ex: "/deliveries/1" -> "1")
 	id, err := strconv.Atoi(r.URL.Path[len("/deliveries/"):])
 	if err != nil {
-		http.Error(w, "ID inválido", http.StatusBadRequest) // Retorna erro 400 se o ID for inválido
+		writeError(w, r, errInvalidID) // Retorna erro 400 se o ID for inválido
 		return
 	}
 
 	// Decodifica o corpo da requisição JSON para a struct Delivery
 	var delivery models.Delivery
 	if err := json.NewDecoder(r.Body).Decode(&delivery); err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest) // Retorna erro 400 se o JSON for inválido
+		writeError(w, r, errInvalidJSON) // Retorna erro 400 se o JSON for inválido
 		return
 	}
 
 	// Chama o serviço para atualizar a entrega no banco de dados
-	if err := c.Service.Update(id, delivery); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError) // Retorna erro 500 se houver falha no serviço
+	if err := c.tenantService(r).Update(id, delivery); err != nil {
+		writeError(w, r, err) // Retorna erro 404 se a entrega não existir, 409 se o cliente não existir ou 422 com todos os campos inválidos
 		return
 	}
 
@@ -214,26 +351,84 @@ func (c *DeliveryController) Update(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(map[string]string{"message": "Entrega atualizada com sucesso!"})
 }
 
+// UpdateStatus godoc
+// @Summary Altera o status de uma entrega
+// @Description Move a entrega para um novo status e registra a mudança no histórico de rastreamento. Apenas transições válidas são aceitas (ex: pendente -> coletada -> em_rota -> entregue). Usuários com o papel driver só alteram as entregas atribuídas a eles.
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param id path int true "ID da entrega"
+// @Param evento body models.TrackingEvent true "Novo status e dados opcionais do evento (localização e observação; o responsável é o usuário autenticado)"
+// @Success 200 {object} models.Delivery
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 409 {object} apierror.Response
+// @Failure 422 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /deliveries/{id}/status [post]
+func (c *DeliveryController) UpdateStatus(w http.ResponseWriter, r *http.Request) {
+	// Extrai o ID da URL (ex: "/deliveries/1/status" -> "1")
+	idStr := strings.TrimSuffix(r.URL.Path[len("/deliveries/"):], "/status")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		writeError(w, r, errInvalidID) // Retorna erro 400 se o ID for inválido
+		return
+	}
+
+	// Decodifica o corpo da requisição JSON com o novo status e os dados do evento
+	var event models.TrackingEvent
+	if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+		writeError(w, r, errInvalidJSON) // Retorna erro 400 se o JSON for inválido
+		return
+	}
+
+	// Os motoristas só alteram o status das entregas atribuídas a eles
+	service := c.tenantService(r)
+	if err := authorizeDelivery(r, service, id); err != nil {
+		writeError(w, r, err) // Retorna erro 404 se a entrega não for encontrada
+		return
+	}
+
+	// Chama o serviço para alterar o status da entrega
+	delivery, err := service.UpdateStatus(id, event)
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 404, 409 (transição não permitida) ou 422 (status ou dados do evento inválidos)
+		return
+	}
+
+	// Retorna o status 200 (OK) e a entrega com o novo status
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(delivery)
+}
+
 // Delete godoc
 // @Summary Exclui uma entrega
 // @Description Exclui uma entrega pelo ID.
 // @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
 // @Param id path int true "ID da entrega"
 // @Success 200 {object} map[string]string
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
 // @Router /deliveries/{id} [delete]
 func (c *DeliveryController) Delete(w http.ResponseWriter, r *http.Request) {
 	// Extrai o ID da URL (ex: "/deliveries/1" -> "1")
 	id, err := strconv.Atoi(r.URL.Path[len("/deliveries/"):])
 	if err != nil {
-		http.Error(w, "ID inválido", http.StatusBadRequest) // Retorna erro 400 se o ID for inválido
+		writeError(w, r, errInvalidID) // Retorna erro 400 se o ID for inválido
 		return
 	}
 
 	// Chama o serviço para deletar a entrega pelo ID
-	if err := c.Service.Delete(id); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError) // Retorna erro 500 se houver falha no serviço
+	if err := c.tenantService(r).Delete(id); err != nil {
+		writeError(w, r, err) // Retorna erro 404 se a entrega não existir ou 500 se houver falha no serviço
 		return
 	}
 
@@ -0,0 +1,345 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"meu-projeto/backend/apierror"
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/services"
+)
+
+// DriverController é responsável por lidar com as requisições HTTP relacionadas aos motoristas.
+type DriverController struct {
+	Service *services.DriverService // Serviço que contém a lógica de negócio dos motoristas
+}
+
+// tenantService retorna o serviço restrito ao embarcador do usuário autenticado, usado na atribuição e na
+// carga de trabalho, com as atribuições registradas na auditoria em nome dele. Os motoristas são
+// compartilhados, e as demais rotas usam o serviço sem embarcador.
+func (c *DriverController) tenantService(r *http.Request) *services.DriverService {
+	return c.Service.ForTenant(tenantID(r)).ForActor(actor(r))
+}
+
+// parseDriverPath extrai os IDs de caminhos como "/drivers/1", "/drivers/1/deliveries" e "/drivers/1/deliveries/7".
+// O ID da entrega é 0 quando não faz parte do caminho.
+func parseDriverPath(path string) (driverID, deliveryID int, err error) {
+	parts := strings.Split(strings.Trim(path[len("/drivers/"):], "/"), "/")
+	driverID, err = strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, errInvalidID
+	}
+	if len(parts) == 3 {
+		deliveryID, err = strconv.Atoi(parts[2])
+		if err != nil {
+			return 0, 0, apierror.New(apierror.CodeBadRequest, "invalid_delivery_id")
+		}
+	}
+	return driverID, deliveryID, nil
+}
+
+// Create godoc
+// @Summary Cadastra um motorista
+// @Description Cadastra um motorista. O CPF é validado e armazenado no formato 123.456.789-09; a CNH deve ter 11 dígitos e uma categoria válida (A, B, C, D, E, AB, AC, AD ou AE). Se "ativo" for omitido, o motorista é cadastrado como ativo. Apenas os usuários do embarcador padrão alteram a frota e as zonas, compartilhadas por todos os embarcadores.
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param motorista body models.Motorista true "Dados do motorista"
+// @Success 201 {object} models.Motorista
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 409 {object} apierror.Response
+// @Failure 422 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /drivers [post]
+func (c *DriverController) Create(w http.ResponseWriter, r *http.Request) {
+	// Os registros compartilhados são alterados apenas pelo embarcador padrão
+	if err := authorizeSharedWrite(r); err != nil {
+		writeError(w, r, err) // Retorna erro 403 para os usuários dos demais embarcadores
+		return
+	}
+
+	// Decodifica o corpo da requisição JSON para a struct Motorista (ativo por padrão)
+	driver := models.Motorista{Ativo: true}
+	if err := json.NewDecoder(r.Body).Decode(&driver); err != nil {
+		writeError(w, r, errInvalidJSON) // Retorna erro 400 se o JSON for inválido
+		return
+	}
+
+	// Chama o serviço para cadastrar o motorista
+	if err := c.Service.Create(&driver); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	// Retorna o status 201 (Created) e o motorista cadastrado no corpo da resposta
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(driver)
+}
+
+// List godoc
+// @Summary Lista os motoristas
+// @Description Retorna uma página de motoristas em ordem alfabética, opcionalmente apenas os ativos ou inativos.
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param ativo query bool false "Filtra pelos motoristas ativos (true) ou inativos (false)"
+// @Param page query int false "Página (padrão 1)"
+// @Param page_size query int false "Itens por página (padrão 20, máximo 100)"
+// @Success 200 {object} models.Page[models.Motorista]
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /drivers [get]
+func (c *DriverController) List(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	var filter models.DriverFilter
+
+	// Extrai o filtro de ativos e a paginação da query string
+	var err error
+	filter.Ativo, err = parseBoolParam(query, "ativo")
+	if err == nil {
+		filter.Page, filter.PageSize, err = parsePagination(query)
+	}
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 400 se algum parâmetro for inválido
+		return
+	}
+
+	// Chama o serviço para obter a página de motoristas
+	page, err := c.Service.List(filter)
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 500 se houver falha no serviço
+		return
+	}
+
+	// Retorna o status 200 (OK) e a página de motoristas no corpo da resposta
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(page)
+}
+
+// FindByID godoc
+// @Summary Busca um motorista pelo ID
+// @Description Retorna os dados de um motorista.
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param id path int true "ID do motorista"
+// @Success 200 {object} models.Motorista
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /drivers/{id} [get]
+func (c *DriverController) FindByID(w http.ResponseWriter, r *http.Request) {
+	// Extrai o ID da URL (ex: "/drivers/1" -> "1")
+	id, _, err := parseDriverPath(r.URL.Path)
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 400 se o ID for inválido
+		return
+	}
+
+	// Chama o serviço para buscar o motorista pelo ID
+	driver, err := c.Service.FindByID(id)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	// Retorna o status 200 (OK) e o motorista encontrado no corpo da resposta
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(driver)
+}
+
+// Update godoc
+// @Summary Atualiza um motorista
+// @Description Atualiza os dados de um motorista, incluindo a ativação ou desativação. Apenas os usuários do embarcador padrão alteram a frota e as zonas, compartilhadas por todos os embarcadores.
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param id path int true "ID do motorista"
+// @Param motorista body models.Motorista true "Dados do motorista"
+// @Success 200 {object} models.Motorista
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 409 {object} apierror.Response
+// @Failure 422 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /drivers/{id} [put]
+func (c *DriverController) Update(w http.ResponseWriter, r *http.Request) {
+	// Os registros compartilhados são alterados apenas pelo embarcador padrão
+	if err := authorizeSharedWrite(r); err != nil {
+		writeError(w, r, err) // Retorna erro 403 para os usuários dos demais embarcadores
+		return
+	}
+
+	// Extrai o ID da URL (ex: "/drivers/1" -> "1")
+	id, _, err := parseDriverPath(r.URL.Path)
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 400 se o ID for inválido
+		return
+	}
+
+	// Decodifica o corpo da requisição JSON para a struct Motorista
+	var driver models.Motorista
+	if err := json.NewDecoder(r.Body).Decode(&driver); err != nil {
+		writeError(w, r, errInvalidJSON) // Retorna erro 400 se o JSON for inválido
+		return
+	}
+	driver.ID = id
+
+	// Chama o serviço para atualizar o motorista
+	if err := c.Service.Update(&driver); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	// Retorna o status 200 (OK) e o motorista atualizado no corpo da resposta
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(driver)
+}
+
+// Delete godoc
+// @Summary Exclui um motorista
+// @Description Remove um motorista pelo ID. As entregas atribuídas a ele ficam sem motorista. Apenas os usuários do embarcador padrão alteram a frota e as zonas, compartilhadas por todos os embarcadores.
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param id path int true "ID do motorista"
+// @Success 204 "No Content"
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /drivers/{id} [delete]
+func (c *DriverController) Delete(w http.ResponseWriter, r *http.Request) {
+	// Os registros compartilhados são alterados apenas pelo embarcador padrão
+	if err := authorizeSharedWrite(r); err != nil {
+		writeError(w, r, err) // Retorna erro 403 para os usuários dos demais embarcadores
+		return
+	}
+
+	// Extrai o ID da URL (ex: "/drivers/1" -> "1")
+	id, _, err := parseDriverPath(r.URL.Path)
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 400 se o ID for inválido
+		return
+	}
+
+	// Chama o serviço para excluir o motorista
+	if err := c.Service.ForActor(actor(r)).Delete(id); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	// Retorna o status 204 (No Content) para indicar que o motorista foi excluído
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Deliveries godoc
+// @Summary Lista a carga de trabalho do motorista
+// @Description Retorna as entregas em aberto (status não final) atribuídas ao motorista, da mais antiga para a mais recente.
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param id path int true "ID do motorista"
+// @Success 200 {array} models.Delivery
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /drivers/{id}/deliveries [get]
+func (c *DriverController) Deliveries(w http.ResponseWriter, r *http.Request) {
+	// Extrai o ID da URL (ex: "/drivers/1/deliveries" -> "1")
+	id, _, err := parseDriverPath(r.URL.Path)
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 400 se o ID for inválido
+		return
+	}
+
+	// Chama o serviço para obter as entregas do motorista
+	deliveries, err := c.tenantService(r).Workload(id)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	// Retorna o status 200 (OK) e a lista de entregas no corpo da resposta
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(deliveries)
+}
+
+// Assign godoc
+// @Summary Atribui uma entrega ao motorista
+// @Description Atribui a entrega ao motorista (transferindo-a se estiver com outro) e registra a atribuição no histórico de rastreamento. O motorista deve estar ativo e a entrega não pode estar com status final.
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param id path int true "ID do motorista"
+// @Param entregaId path int true "ID da entrega"
+// @Success 200 {object} models.Delivery
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 409 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /drivers/{id}/deliveries/{entregaId} [post]
+func (c *DriverController) Assign(w http.ResponseWriter, r *http.Request) {
+	c.changeAssignment(w, r, c.tenantService(r).Assign)
+}
+
+// Unassign godoc
+// @Summary Remove uma entrega do motorista
+// @Description Remove a atribuição da entrega ao motorista e registra a remoção no histórico de rastreamento.
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param id path int true "ID do motorista"
+// @Param entregaId path int true "ID da entrega"
+// @Success 200 {object} models.Delivery
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 409 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /drivers/{id}/deliveries/{entregaId} [delete]
+func (c *DriverController) Unassign(w http.ResponseWriter, r *http.Request) {
+	c.changeAssignment(w, r, c.tenantService(r).Unassign)
+}
+
+// changeAssignment extrai os IDs do motorista e da entrega da URL e aplica a alteração de atribuição informada.
+func (c *DriverController) changeAssignment(w http.ResponseWriter, r *http.Request, change func(driverID, deliveryID int) (*models.Delivery, error)) {
+	// Extrai os IDs da URL (ex: "/drivers/1/deliveries/7" -> 1 e 7)
+	driverID, deliveryID, err := parseDriverPath(r.URL.Path)
+	if err == nil && deliveryID == 0 {
+		err = apierror.New(apierror.CodeBadRequest, "invalid_delivery_id")
+	}
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 400 se algum ID for inválido
+		return
+	}
+
+	// Chama o serviço para alterar a atribuição
+	delivery, err := change(driverID, deliveryID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	// Retorna o status 200 (OK) e a entrega atualizada no corpo da resposta
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(delivery)
+}
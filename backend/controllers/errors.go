@@ -0,0 +1,99 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"meu-projeto/backend/apierror"
+	"meu-projeto/backend/auth"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/services"
+	"meu-projeto/backend/validation"
+)
+
+// Erros comuns das requisições, respondidos no formato único da API.
+var (
+	errInvalidID   = apierror.New(apierror.CodeBadRequest, "invalid_id")              // ID do caminho da URL inválido
+	errInvalidJSON = apierror.New(apierror.CodeInvalidJSON, apierror.CodeInvalidJSON) // Corpo da requisição não é um JSON válido
+)
+
+// serviceErrors associa os erros dos serviços ao código e à mensagem da resposta. Os erros são verificados
+// em ordem, com errors.Is; os erros dos repositórios vêm por último, para os casos que os serviços não
+// convertem (ex: um registro removido entre a busca e a atualização).
+var serviceErrors = []struct {
+	target error  // Erro do serviço
+	code   string // Código do erro na resposta
+	key    string // Chave da mensagem no catálogo
+}{
+	{services.ErrCredenciaisInvalidas, apierror.CodeUnauthorized, "invalid_credentials"},
+	{auth.ErrTokenExpirado, apierror.CodeUnauthorized, "token_expired"},
+	{auth.ErrTokenInvalido, apierror.CodeUnauthorized, "invalid_token"},
+	{services.ErrChaveAPIRecusada, apierror.CodeUnauthorized, "invalid_api_key"},
+	{services.ErrNaoAutenticado, apierror.CodeUnauthorized, apierror.CodeUnauthorized},
+	{services.ErrUsuarioDuplicado, apierror.CodeConflict, "user_duplicate"},
+	{services.ErrEmbarcadorDuplicado, apierror.CodeConflict, "shipper_duplicate"},
+	{services.ErrEntregaNaoEncontrada, apierror.CodeNotFound, "delivery_not_found"},
+	{services.ErrChaveAPINaoEncontrada, apierror.CodeNotFound, "api_key_not_found"},
+	{services.ErrClienteNaoEncontrado, apierror.CodeNotFound, "client_not_found"},
+	{services.ErrMotoristaNaoEncontrado, apierror.CodeNotFound, "driver_not_found"},
+	{services.ErrVeiculoNaoEncontrado, apierror.CodeNotFound, "vehicle_not_found"},
+	{services.ErrZonaNaoEncontrada, apierror.CodeNotFound, "zone_not_found"},
+	{services.ErrCEPNaoEncontrado, apierror.CodeNotFound, "cep_not_found"},
+	{services.ErrLocalNaoEncontrado, apierror.CodeNotFound, "location_not_found"},
+	{services.ErrMotoristaDuplicado, apierror.CodeConflict, "driver_duplicate"},
+	{services.ErrPlacaDuplicada, apierror.CodeConflict, "plate_duplicate"},
+	{services.ErrZonaDuplicada, apierror.CodeConflict, "zone_duplicate"},
+	{services.ErrClienteDuplicado, apierror.CodeConflict, "client_duplicate"},
+	{services.ErrTransicaoInvalida, apierror.CodeConflict, "status_transition"},
+	{services.ErrAtribuicaoInvalida, apierror.CodeConflict, "assignment_not_allowed"},
+	{services.ErrStatusInvalido, apierror.CodeBadRequest, "invalid_status"},
+	{services.ErrBuscaInvalida, apierror.CodeBadRequest, "invalid_search"},
+	{services.ErrAuditoriaInvalida, apierror.CodeBadRequest, "invalid_audit_filter"},
+	{services.ErrCEPInvalido, apierror.CodeBadRequest, "invalid_cep"},
+	{services.ErrCodigoRastreioInvalido, apierror.CodeBadRequest, "invalid_tracking_code"},
+	{services.ErrRotaInvalida, apierror.CodeBadRequest, "invalid_route"},
+	{services.ErrEnderecoNaoEncontrado, apierror.CodeUnprocessable, "address_not_geocoded"},
+	{services.ErrGeocodificacao, apierror.CodeUpstreamUnavailable, "geocoding_unavailable"},
+	{services.ErrConsultaCEP, apierror.CodeUpstreamUnavailable, "cep_unavailable"},
+	{repositories.ErrNotFound, apierror.CodeNotFound, apierror.CodeNotFound},
+	{repositories.ErrConflict, apierror.CodeConflict, "duplicate"},
+	{repositories.ErrForeignKey, apierror.CodeConflict, "foreign_key"},
+}
+
+// toAPIError converte um erro em um erro da API: erros da API são mantidos, erros de validação geram o código
+// validation_failed, a falta de permissão gera o código forbidden com a permissão na mensagem, erros
+// conhecidos dos serviços usam o código da tabela serviceErrors e os demais são tratados como falha interna.
+func toAPIError(err error) *apierror.Error {
+	var apiErr *apierror.Error
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+	if fields, ok := validation.FromError(err); ok {
+		return apierror.Validation(fields)
+	}
+	var permErr *auth.PermissionError
+	if errors.As(err, &permErr) {
+		return apierror.Wrap(err, apierror.CodeForbidden, "permission_denied", permErr.Permission)
+	}
+	for _, mapping := range serviceErrors {
+		if errors.Is(err, mapping.target) {
+			return apierror.Wrap(err, mapping.code, mapping.key)
+		}
+	}
+	return apierror.Wrap(err, apierror.CodeInternal, apierror.CodeInternal)
+}
+
+// writeError responde à requisição com o erro no formato único da API.
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	apierror.Write(w, r, toAPIError(err))
+}
+
+// MethodNotAllowed responde com o erro 405, para os métodos não aceitos pelas rotas.
+func MethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	apierror.Write(w, r, apierror.New(apierror.CodeMethodNotAllowed, apierror.CodeMethodNotAllowed))
+}
+
+// NotFound responde com o erro 404, para os caminhos que não correspondem a nenhuma rota.
+func NotFound(w http.ResponseWriter, r *http.Request) {
+	apierror.Write(w, r, apierror.New(apierror.CodeNotFound, apierror.CodeNotFound))
+}
@@ -0,0 +1,64 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"meu-projeto/backend/apierror"
+	"meu-projeto/backend/services"
+)
+
+// GeocodeController é responsável por lidar com as requisições HTTP de geocodificação.
+type GeocodeController struct {
+	Service *services.GeocodeService // Serviço que contém a lógica da geocodificação reversa
+}
+
+// tenantService retorna o serviço restrito ao embarcador do usuário autenticado, cujas entregas são as únicas
+// usadas na busca reversa.
+func (c *GeocodeController) tenantService(r *http.Request) *services.GeocodeService {
+	return c.Service.ForTenant(tenantID(r))
+}
+
+// Reverse godoc
+// @Summary Busca o endereço conhecido mais próximo de um ponto
+// @Description Converte coordenadas (ex: o GPS do motorista) no endereço conhecido mais próximo, com os mesmos campos de endereço da entrega. Usa o dicionário geográfico local e, quando ele não conhece a rua, o endereço da entrega cadastrada mais próxima (até 500 m); por fim, retorna apenas o bairro ou a cidade.
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param lat query number true "Latitude do ponto"
+// @Param lng query number true "Longitude do ponto"
+// @Success 200 {object} models.ReverseGeocodeResult
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /geocode/reverse [get]
+func (c *GeocodeController) Reverse(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	// Extrai as coordenadas obrigatórias da query string
+	lat, err := parseFloatParam(query, "lat")
+	var lng *float64
+	if err == nil {
+		lng, err = parseFloatParam(query, "lng")
+	}
+	if err == nil && (lat == nil || lng == nil) {
+		err = apierror.New(apierror.CodeBadRequest, "coordinates_required")
+	}
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 400 se algum parâmetro for inválido
+		return
+	}
+
+	// Chama o serviço para buscar o endereço mais próximo
+	result, err := c.tenantService(r).Reverse(*lat, *lng)
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 400 se as coordenadas forem inválidas, 404 se não houver endereço conhecido por perto ou 500 se houver falha no serviço
+		return
+	}
+
+	// Retorna o status 200 (OK) e o endereço encontrado no corpo da resposta
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,16 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Health godoc
+// @Summary Verifica a saúde do servidor
+// @Description Responde 200 enquanto o servidor estiver no ar. Não exige autenticação.
+// @Produce json
+// @Success 200 {object} map[string]string
+// @Router /health [get]
+func Health(w http.ResponseWriter, r *http.Request) {
+	json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+}
@@ -0,0 +1,118 @@
+package controllers
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"meu-projeto/backend/apierror"
+	"meu-projeto/backend/models"
+)
+
+// dateLayout é o formato aceito nos parâmetros de data da query string (ex: 2025-03-15).
+const dateLayout = "2006-01-02"
+
+// parsePagination extrai os parâmetros "page" e "page_size" da query string, aplicando os valores padrão e os limites.
+func parsePagination(query url.Values) (page, pageSize int, err error) {
+	page, pageSize = 1, models.DefaultPageSize
+
+	if value := query.Get("page"); value != "" {
+		page, err = strconv.Atoi(value)
+		if err != nil || page < 1 {
+			return 0, 0, apierror.New(apierror.CodeBadRequest, "param_positive", "page")
+		}
+	}
+	if value := query.Get("page_size"); value != "" {
+		pageSize, err = strconv.Atoi(value)
+		if err != nil || pageSize < 1 || pageSize > models.MaxPageSize {
+			return 0, 0, apierror.New(apierror.CodeBadRequest, "param_range", "page_size", 1, models.MaxPageSize)
+		}
+	}
+	return page, pageSize, nil
+}
+
+// parseSort extrai os parâmetros "sort" e "order" da query string, aceitando apenas as colunas permitidas.
+func parseSort(query url.Values, allowed map[string]bool) (sort string, desc bool, err error) {
+	sort = query.Get("sort")
+	if sort != "" && !allowed[sort] {
+		return "", false, apierror.New(apierror.CodeBadRequest, "param_sort", sort)
+	}
+
+	switch strings.ToLower(query.Get("order")) {
+	case "", "asc":
+		return sort, false, nil
+	case "desc":
+		return sort, true, nil
+	}
+	return "", false, apierror.New(apierror.CodeBadRequest, "param_order")
+}
+
+// parseFloatParam extrai um parâmetro numérico opcional da query string.
+func parseFloatParam(query url.Values, name string) (*float64, error) {
+	value := query.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, apierror.New(apierror.CodeBadRequest, "param_number", name)
+	}
+	return &number, nil
+}
+
+// parseDateParam extrai um parâmetro de data opcional (AAAA-MM-DD) da query string.
+func parseDateParam(query url.Values, name string) (*time.Time, error) {
+	value := query.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+	date, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return nil, apierror.New(apierror.CodeBadRequest, "param_date", name)
+	}
+	return &date, nil
+}
+
+// parseTimeParam extrai um parâmetro opcional de data (AAAA-MM-DD) ou de data e hora (RFC 3339, ex:
+// 2025-03-15T14:30:00Z) da query string, informando se foi informada apenas a data.
+func parseTimeParam(query url.Values, name string) (*time.Time, bool, error) {
+	value := query.Get(name)
+	if value == "" {
+		return nil, false, nil
+	}
+	if date, err := time.Parse(dateLayout, value); err == nil {
+		return &date, true, nil
+	}
+	moment, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, false, apierror.New(apierror.CodeBadRequest, "param_datetime", name)
+	}
+	return &moment, false, nil
+}
+
+// parseIntParam extrai um parâmetro inteiro opcional da query string (0 quando ausente).
+func parseIntParam(query url.Values, name string) (int, error) {
+	value := query.Get(name)
+	if value == "" {
+		return 0, nil
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, apierror.New(apierror.CodeBadRequest, "param_integer", name)
+	}
+	return number, nil
+}
+
+// parseBoolParam extrai um parâmetro booleano opcional da query string (nil quando ausente).
+func parseBoolParam(query url.Values, name string) (*bool, error) {
+	value := query.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+	flag, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, apierror.New(apierror.CodeBadRequest, "param_bool", name)
+	}
+	return &flag, nil
+}
@@ -0,0 +1,92 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/services"
+)
+
+// RouteController é responsável por lidar com as requisições HTTP relacionadas ao planejamento de rotas.
+type RouteController struct {
+	Service *services.RouteService // Serviço que contém a lógica de otimização de rotas
+}
+
+// tenantService retorna o serviço restrito ao embarcador do usuário autenticado, cujas entregas são as únicas
+// planejadas.
+func (c *RouteController) tenantService(r *http.Request) *services.RouteService {
+	return c.Service.ForTenant(tenantID(r))
+}
+
+// Optimize godoc
+// @Summary Otimiza a ordem de visita das entregas
+// @Description Calcula uma boa ordem de visita a partir do depósito (vizinho mais próximo seguido de 2-opt, com distâncias de haversine). As entregas podem ser informadas pelos IDs ou por cidade e/ou data de cadastro; no filtro, entregas com status final são ignoradas. O cálculo é feito sem serviços externos.
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param rota body models.RouteRequest true "Depósito e entregas"
+// @Success 200 {object} models.Route
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 422 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /routes/optimize [post]
+func (c *RouteController) Optimize(w http.ResponseWriter, r *http.Request) {
+	// Decodifica o corpo da requisição JSON para a struct RouteRequest
+	var request models.RouteRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeError(w, r, errInvalidJSON) // Retorna erro 400 se o JSON for inválido
+		return
+	}
+
+	// Chama o serviço para calcular a rota
+	route, err := c.tenantService(r).Optimize(request)
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 400 se a requisição for inválida, 404 se alguma entrega não for encontrada ou 500 se houver falha no serviço
+		return
+	}
+
+	// Retorna o status 200 (OK) e a rota calculada no corpo da resposta
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(route)
+}
+
+// Plan godoc
+// @Summary Planeja as rotas da frota
+// @Description Distribui as entregas entre os veículos ativos respeitando a capacidade de peso de cada um (heurística de varredura para o problema de roteamento com capacidade) e calcula uma rota otimizada por veículo. As entregas são selecionadas como em /routes/optimize; sem veiculo_ids, todos os veículos ativos são considerados. Entregas que não couberem em nenhum veículo são listadas em entregas_nao_alocadas.
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param planejamento body models.FleetPlanRequest true "Depósito, entregas e veículos"
+// @Success 200 {object} models.FleetPlan
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 422 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /routes/plan [post]
+func (c *RouteController) Plan(w http.ResponseWriter, r *http.Request) {
+	// Decodifica o corpo da requisição JSON para a struct FleetPlanRequest
+	var request models.FleetPlanRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeError(w, r, errInvalidJSON) // Retorna erro 400 se o JSON for inválido
+		return
+	}
+
+	// Chama o serviço para planejar as rotas
+	plan, err := c.tenantService(r).Plan(request)
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 400 se a requisição for inválida, 404 se alguma entrega ou veículo não for encontrado ou 500 se houver falha no serviço
+		return
+	}
+
+	// Retorna o status 200 (OK) e o planejamento no corpo da resposta
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(plan)
+}
@@ -0,0 +1,83 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/services"
+)
+
+// ShipperController é responsável por lidar com as requisições HTTP de gerenciamento dos embarcadores.
+type ShipperController struct {
+	Service *services.ShipperService // Serviço que contém a lógica dos embarcadores
+}
+
+// Create godoc
+// @Summary Cadastra um embarcador
+// @Description Cadastra um embarcador, cujos clientes e entregas ficam isolados dos demais. Os usuários e as chaves de API do embarcador são cadastrados em POST /users e POST /api-keys com o embarcador_id. Exige a permissão users:manage e um usuário do embarcador padrão.
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param embarcador body models.Embarcador true "Nome do embarcador"
+// @Success 201 {object} models.Embarcador
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 409 {object} apierror.Response "Embarcador já cadastrado"
+// @Failure 422 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /shippers [post]
+func (c *ShipperController) Create(w http.ResponseWriter, r *http.Request) {
+	// Apenas os usuários do embarcador padrão gerenciam os embarcadores
+	if err := authorizeShipper(r, models.EmbarcadorPadrao); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	// Decodifica o corpo da requisição JSON para a struct Embarcador
+	var shipper models.Embarcador
+	if err := json.NewDecoder(r.Body).Decode(&shipper); err != nil {
+		writeError(w, r, errInvalidJSON) // Retorna erro 400 se o JSON for inválido
+		return
+	}
+
+	// Chama o serviço para cadastrar o embarcador
+	if err := c.Service.Create(&shipper); err != nil {
+		writeError(w, r, err) // Retorna erro 409 se o nome já estiver cadastrado
+		return
+	}
+
+	// Retorna o status 201 (Created) e o embarcador cadastrado no corpo da resposta
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(shipper)
+}
+
+// List godoc
+// @Summary Lista os embarcadores
+// @Description Retorna todos os embarcadores, em ordem de cadastro. Exige a permissão users:manage e um usuário do embarcador padrão.
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} models.Embarcador
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /shippers [get]
+func (c *ShipperController) List(w http.ResponseWriter, r *http.Request) {
+	// Apenas os usuários do embarcador padrão gerenciam os embarcadores
+	if err := authorizeShipper(r, models.EmbarcadorPadrao); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	// Chama o serviço para obter os embarcadores
+	shippers, err := c.Service.List()
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 500 se houver falha no serviço
+		return
+	}
+
+	// Retorna o status 200 (OK) e a lista de embarcadores no corpo da resposta
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(shippers)
+}
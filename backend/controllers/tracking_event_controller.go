@@ -0,0 +1,140 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/services"
+)
+
+// TrackingEventController é responsável por lidar com as requisições HTTP relacionadas ao histórico de rastreamento das entregas.
+type TrackingEventController struct {
+	Service *services.TrackingEventService // Serviço que contém a lógica de negócio do rastreamento
+}
+
+// tenantService retorna o serviço restrito ao embarcador do usuário autenticado, que registra as mudanças de
+// status na auditoria em nome dele. O rastreio público usa o serviço sem embarcador.
+func (c *TrackingEventController) tenantService(r *http.Request) *services.TrackingEventService {
+	return c.Service.ForTenant(tenantID(r)).ForActor(actor(r))
+}
+
+// List godoc
+// @Summary Lista o histórico de rastreamento de uma entrega
+// @Description Retorna todos os eventos de rastreamento de uma entrega, do mais antigo para o mais recente. Usuários com o papel driver só acessam as entregas atribuídas a eles.
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param id path int true "ID da entrega"
+// @Success 200 {array} models.TrackingEvent
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /deliveries/{id}/events [get]
+func (c *TrackingEventController) List(w http.ResponseWriter, r *http.Request) {
+	// Extrai o ID da URL (ex: "/deliveries/1/events" -> "1")
+	id, err := strconv.Atoi(strings.TrimSuffix(r.URL.Path[len("/deliveries/"):], "/events"))
+	if err != nil {
+		writeError(w, r, errInvalidID) // Retorna erro 400 se o ID for inválido
+		return
+	}
+
+	// Os motoristas só acessam o histórico das entregas atribuídas a eles
+	service := c.tenantService(r)
+	if err := authorizeDelivery(r, service.Deliveries, id); err != nil {
+		writeError(w, r, err) // Retorna erro 404 se a entrega não for encontrada
+		return
+	}
+
+	// Chama o serviço para obter o histórico da entrega
+	events, err := service.List(id)
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 404 se a entrega não for encontrada ou 500 se houver falha no serviço
+		return
+	}
+
+	// Retorna o status 200 (OK) e a lista de eventos no corpo da resposta
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(events)
+}
+
+// Create godoc
+// @Summary Registra um evento de rastreamento
+// @Description Adiciona um evento ao histórico da entrega. Se o status for omitido, o status atual é mantido; se for diferente do atual, a transição é validada e aplicada. O responsável pelo evento é o usuário ou a chave de API autenticada. Usuários com o papel driver só acessam as entregas atribuídas a eles.
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param id path int true "ID da entrega"
+// @Param evento body models.TrackingEvent true "Dados do evento"
+// @Success 201 {object} models.TrackingEvent
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 409 {object} apierror.Response
+// @Failure 422 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /deliveries/{id}/events [post]
+func (c *TrackingEventController) Create(w http.ResponseWriter, r *http.Request) {
+	// Extrai o ID da URL (ex: "/deliveries/1/events" -> "1")
+	id, err := strconv.Atoi(strings.TrimSuffix(r.URL.Path[len("/deliveries/"):], "/events"))
+	if err != nil {
+		writeError(w, r, errInvalidID) // Retorna erro 400 se o ID for inválido
+		return
+	}
+
+	// Decodifica o corpo da requisição JSON para a struct TrackingEvent
+	var event models.TrackingEvent
+	if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+		writeError(w, r, errInvalidJSON) // Retorna erro 400 se o JSON for inválido
+		return
+	}
+
+	// Os motoristas só registram eventos nas entregas atribuídas a eles
+	service := c.tenantService(r)
+	if err := authorizeDelivery(r, service.Deliveries, id); err != nil {
+		writeError(w, r, err) // Retorna erro 404 se a entrega não for encontrada
+		return
+	}
+
+	// Chama o serviço para registrar o evento
+	if err := service.Create(id, &event); err != nil {
+		writeError(w, r, err) // Retorna erro 404 se a entrega não for encontrada, 409 se a transição não for permitida ou 500 se houver falha no serviço
+		return
+	}
+
+	// Retorna o status 201 (Created) e o evento registrado no corpo da resposta
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(event)
+}
+
+// Track godoc
+// @Summary Consulta pública de rastreio
+// @Description Retorna o status, a cidade de destino e as mudanças de status de uma entrega a partir do código de rastreio, sem expor dados pessoais do cliente. Cada mudança traz uma descrição fixa do status; as observações internas e os responsáveis pelos eventos não são publicados.
+// @Produce json
+// @Param code path string true "Código de rastreio (ex: ENF85JJ3CX4PER8BR)"
+// @Success 200 {object} models.TrackingView
+// @Failure 400 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /track/{code} [get]
+func (c *TrackingEventController) Track(w http.ResponseWriter, r *http.Request) {
+	// Extrai o código da URL (ex: "/track/ENF85JJ3CX4PER8BR" -> "ENF85JJ3CX4PER8BR")
+	code := r.URL.Path[len("/track/"):]
+
+	// Chama o serviço para montar a visão pública da entrega
+	view, err := c.Service.Track(code)
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 400 se o código for inválido, 404 se a entrega não for encontrada ou 500 se houver falha no serviço
+		return
+	}
+
+	// Retorna o status 200 (OK) e a visão pública da entrega
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(view)
+}
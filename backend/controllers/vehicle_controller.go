@@ -0,0 +1,221 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/services"
+)
+
+// VehicleController é responsável por lidar com as requisições HTTP relacionadas aos veículos da frota.
+type VehicleController struct {
+	Service *services.VehicleService // Serviço que contém a lógica de negócio dos veículos
+}
+
+// Create godoc
+// @Summary Cadastra um veículo
+// @Description Cadastra um veículo na frota. A placa é aceita com ou sem hífen, no padrão antigo ou Mercosul. Se "ativo" for omitido, o veículo é cadastrado como ativo. Apenas os usuários do embarcador padrão alteram a frota e as zonas, compartilhadas por todos os embarcadores.
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param veiculo body models.Vehicle true "Dados do veículo"
+// @Success 201 {object} models.Vehicle
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 409 {object} apierror.Response
+// @Failure 422 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /vehicles [post]
+func (c *VehicleController) Create(w http.ResponseWriter, r *http.Request) {
+	// Os registros compartilhados são alterados apenas pelo embarcador padrão
+	if err := authorizeSharedWrite(r); err != nil {
+		writeError(w, r, err) // Retorna erro 403 para os usuários dos demais embarcadores
+		return
+	}
+
+	// Decodifica o corpo da requisição JSON para a struct Vehicle (ativo por padrão)
+	vehicle := models.Vehicle{Ativo: true}
+	if err := json.NewDecoder(r.Body).Decode(&vehicle); err != nil {
+		writeError(w, r, errInvalidJSON) // Retorna erro 400 se o JSON for inválido
+		return
+	}
+
+	// Chama o serviço para cadastrar o veículo
+	if err := c.Service.Create(&vehicle); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	// Retorna o status 201 (Created) e o veículo cadastrado no corpo da resposta
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(vehicle)
+}
+
+// List godoc
+// @Summary Lista os veículos
+// @Description Retorna uma página de veículos da frota, opcionalmente apenas os ativos ou inativos.
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param ativo query bool false "Filtra pelos veículos ativos (true) ou inativos (false)"
+// @Param page query int false "Página (padrão 1)"
+// @Param page_size query int false "Itens por página (padrão 20, máximo 100)"
+// @Success 200 {object} models.Page[models.Vehicle]
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /vehicles [get]
+func (c *VehicleController) List(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	var filter models.VehicleFilter
+
+	// Extrai o filtro de ativos e a paginação da query string
+	var err error
+	filter.Ativo, err = parseBoolParam(query, "ativo")
+	if err == nil {
+		filter.Page, filter.PageSize, err = parsePagination(query)
+	}
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 400 se algum parâmetro for inválido
+		return
+	}
+
+	// Chama o serviço para obter a página de veículos
+	page, err := c.Service.List(filter)
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 500 se houver falha no serviço
+		return
+	}
+
+	// Retorna o status 200 (OK) e a página de veículos no corpo da resposta
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(page)
+}
+
+// FindByID godoc
+// @Summary Busca um veículo pelo ID
+// @Description Retorna os dados de um veículo da frota.
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param id path int true "ID do veículo"
+// @Success 200 {object} models.Vehicle
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /vehicles/{id} [get]
+func (c *VehicleController) FindByID(w http.ResponseWriter, r *http.Request) {
+	// Extrai o ID da URL (ex: "/vehicles/1" -> "1")
+	id, err := strconv.Atoi(r.URL.Path[len("/vehicles/"):])
+	if err != nil {
+		writeError(w, r, errInvalidID) // Retorna erro 400 se o ID for inválido
+		return
+	}
+
+	// Chama o serviço para buscar o veículo pelo ID
+	vehicle, err := c.Service.FindByID(id)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	// Retorna o status 200 (OK) e o veículo encontrado no corpo da resposta
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(vehicle)
+}
+
+// Update godoc
+// @Summary Atualiza um veículo
+// @Description Atualiza os dados de um veículo da frota, incluindo a ativação ou desativação. Apenas os usuários do embarcador padrão alteram a frota e as zonas, compartilhadas por todos os embarcadores.
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param id path int true "ID do veículo"
+// @Param veiculo body models.Vehicle true "Dados do veículo"
+// @Success 200 {object} models.Vehicle
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 409 {object} apierror.Response
+// @Failure 422 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /vehicles/{id} [put]
+func (c *VehicleController) Update(w http.ResponseWriter, r *http.Request) {
+	// Os registros compartilhados são alterados apenas pelo embarcador padrão
+	if err := authorizeSharedWrite(r); err != nil {
+		writeError(w, r, err) // Retorna erro 403 para os usuários dos demais embarcadores
+		return
+	}
+
+	// Extrai o ID da URL (ex: "/vehicles/1" -> "1")
+	id, err := strconv.Atoi(r.URL.Path[len("/vehicles/"):])
+	if err != nil {
+		writeError(w, r, errInvalidID) // Retorna erro 400 se o ID for inválido
+		return
+	}
+
+	// Decodifica o corpo da requisição JSON para a struct Vehicle
+	var vehicle models.Vehicle
+	if err := json.NewDecoder(r.Body).Decode(&vehicle); err != nil {
+		writeError(w, r, errInvalidJSON) // Retorna erro 400 se o JSON for inválido
+		return
+	}
+	vehicle.ID = id
+
+	// Chama o serviço para atualizar o veículo
+	if err := c.Service.Update(&vehicle); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	// Retorna o status 200 (OK) e o veículo atualizado no corpo da resposta
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(vehicle)
+}
+
+// Delete godoc
+// @Summary Exclui um veículo
+// @Description Remove um veículo da frota pelo ID. Apenas os usuários do embarcador padrão alteram a frota e as zonas, compartilhadas por todos os embarcadores.
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param id path int true "ID do veículo"
+// @Success 204 "No Content"
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /vehicles/{id} [delete]
+func (c *VehicleController) Delete(w http.ResponseWriter, r *http.Request) {
+	// Os registros compartilhados são alterados apenas pelo embarcador padrão
+	if err := authorizeSharedWrite(r); err != nil {
+		writeError(w, r, err) // Retorna erro 403 para os usuários dos demais embarcadores
+		return
+	}
+
+	// Extrai o ID da URL (ex: "/vehicles/1" -> "1")
+	id, err := strconv.Atoi(r.URL.Path[len("/vehicles/"):])
+	if err != nil {
+		writeError(w, r, errInvalidID) // Retorna erro 400 se o ID for inválido
+		return
+	}
+
+	// Chama o serviço para excluir o veículo
+	if err := c.Service.Delete(id); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	// Retorna o status 204 (No Content) para indicar que o veículo foi excluído
+	w.WriteHeader(http.StatusNoContent)
+}
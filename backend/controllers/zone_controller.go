@@ -0,0 +1,260 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/services"
+)
+
+// ZoneController é responsável por lidar com as requisições HTTP relacionadas às zonas de entrega.
+type ZoneController struct {
+	Service *services.ZoneService // Serviço que contém a lógica de negócio das zonas
+}
+
+// tenantService retorna o serviço restrito ao embarcador do usuário autenticado, usado na listagem das
+// entregas da zona. As zonas são compartilhadas, e as demais rotas usam o serviço sem embarcador.
+func (c *ZoneController) tenantService(r *http.Request) *services.ZoneService {
+	return c.Service.ForTenant(tenantID(r))
+}
+
+// parseZoneID extrai o ID de caminhos como "/zones/1" e "/zones/1/deliveries".
+func parseZoneID(path string) (int, error) {
+	parts := strings.Split(strings.Trim(path[len("/zones/"):], "/"), "/")
+	id, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, errInvalidID
+	}
+	return id, nil
+}
+
+// Create godoc
+// @Summary Cadastra uma zona de entrega
+// @Description Cadastra uma zona operacional delimitada por um polígono GeoJSON (posições [longitude, latitude]; anéis adicionais são buracos). As entregas dentro do polígono passam a pertencer à zona; se zonas se sobrepuserem, vale a cadastrada primeiro. Apenas os usuários do embarcador padrão alteram a frota e as zonas, compartilhadas por todos os embarcadores.
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param zona body models.Zona true "Dados da zona"
+// @Success 201 {object} models.Zona
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 409 {object} apierror.Response
+// @Failure 422 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /zones [post]
+func (c *ZoneController) Create(w http.ResponseWriter, r *http.Request) {
+	// Os registros compartilhados são alterados apenas pelo embarcador padrão
+	if err := authorizeSharedWrite(r); err != nil {
+		writeError(w, r, err) // Retorna erro 403 para os usuários dos demais embarcadores
+		return
+	}
+
+	// Decodifica o corpo da requisição JSON para a struct Zona
+	var zone models.Zona
+	if err := json.NewDecoder(r.Body).Decode(&zone); err != nil {
+		writeError(w, r, errInvalidJSON) // Retorna erro 400 se o JSON for inválido
+		return
+	}
+
+	// Chama o serviço para cadastrar a zona
+	if err := c.Service.ForActor(actor(r)).Create(&zone); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	// Retorna o status 201 (Created) e a zona cadastrada no corpo da resposta
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(zone)
+}
+
+// List godoc
+// @Summary Lista as zonas de entrega
+// @Description Retorna todas as zonas de entrega, em ordem de cadastro.
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Success 200 {array} models.Zona
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /zones [get]
+func (c *ZoneController) List(w http.ResponseWriter, r *http.Request) {
+	// Chama o serviço para obter as zonas
+	zones, err := c.Service.List()
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 500 se houver falha no serviço
+		return
+	}
+
+	// Retorna o status 200 (OK) e a lista de zonas no corpo da resposta
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(zones)
+}
+
+// FindByID godoc
+// @Summary Busca uma zona pelo ID
+// @Description Retorna os dados e o polígono de uma zona de entrega.
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param id path int true "ID da zona"
+// @Success 200 {object} models.Zona
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /zones/{id} [get]
+func (c *ZoneController) FindByID(w http.ResponseWriter, r *http.Request) {
+	// Extrai o ID da URL (ex: "/zones/1" -> "1")
+	id, err := parseZoneID(r.URL.Path)
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 400 se o ID for inválido
+		return
+	}
+
+	// Chama o serviço para buscar a zona pelo ID
+	zone, err := c.Service.FindByID(id)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	// Retorna o status 200 (OK) e a zona encontrada no corpo da resposta
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(zone)
+}
+
+// Update godoc
+// @Summary Atualiza uma zona de entrega
+// @Description Atualiza o nome e o polígono de uma zona. As entregas da área antiga e da nova são reatribuídas às zonas que as contêm. Apenas os usuários do embarcador padrão alteram a frota e as zonas, compartilhadas por todos os embarcadores.
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param id path int true "ID da zona"
+// @Param zona body models.Zona true "Dados da zona"
+// @Success 200 {object} models.Zona
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 409 {object} apierror.Response
+// @Failure 422 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /zones/{id} [put]
+func (c *ZoneController) Update(w http.ResponseWriter, r *http.Request) {
+	// Os registros compartilhados são alterados apenas pelo embarcador padrão
+	if err := authorizeSharedWrite(r); err != nil {
+		writeError(w, r, err) // Retorna erro 403 para os usuários dos demais embarcadores
+		return
+	}
+
+	// Extrai o ID da URL (ex: "/zones/1" -> "1")
+	id, err := parseZoneID(r.URL.Path)
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 400 se o ID for inválido
+		return
+	}
+
+	// Decodifica o corpo da requisição JSON para a struct Zona
+	var zone models.Zona
+	if err := json.NewDecoder(r.Body).Decode(&zone); err != nil {
+		writeError(w, r, errInvalidJSON) // Retorna erro 400 se o JSON for inválido
+		return
+	}
+	zone.ID = id
+
+	// Chama o serviço para atualizar a zona
+	if err := c.Service.ForActor(actor(r)).Update(&zone); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	// Retorna o status 200 (OK) e a zona atualizada no corpo da resposta
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(zone)
+}
+
+// Delete godoc
+// @Summary Exclui uma zona de entrega
+// @Description Remove uma zona pelo ID. As suas entregas passam para outra zona que as contenha ou ficam marcadas como fora de zona. Apenas os usuários do embarcador padrão alteram a frota e as zonas, compartilhadas por todos os embarcadores.
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param id path int true "ID da zona"
+// @Success 204 "No Content"
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /zones/{id} [delete]
+func (c *ZoneController) Delete(w http.ResponseWriter, r *http.Request) {
+	// Os registros compartilhados são alterados apenas pelo embarcador padrão
+	if err := authorizeSharedWrite(r); err != nil {
+		writeError(w, r, err) // Retorna erro 403 para os usuários dos demais embarcadores
+		return
+	}
+
+	// Extrai o ID da URL (ex: "/zones/1" -> "1")
+	id, err := parseZoneID(r.URL.Path)
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 400 se o ID for inválido
+		return
+	}
+
+	// Chama o serviço para excluir a zona
+	if err := c.Service.ForActor(actor(r)).Delete(id); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	// Retorna o status 204 (No Content) para indicar que a zona foi excluída
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Deliveries godoc
+// @Summary Lista as entregas de uma zona
+// @Description Retorna uma página das entregas cujas coordenadas estão dentro da zona. Para as entregas fora de todas as zonas, use GET /deliveries?fora_de_zona=true.
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param id path int true "ID da zona"
+// @Param page query int false "Página (padrão 1)"
+// @Param page_size query int false "Itens por página (padrão 20, máximo 100)"
+// @Success 200 {object} models.Page[models.Delivery]
+// @Failure 400 {object} apierror.Response
+// @Failure 401 {object} apierror.Response
+// @Failure 403 {object} apierror.Response
+// @Failure 404 {object} apierror.Response
+// @Failure 500 {object} apierror.Response
+// @Router /zones/{id}/deliveries [get]
+func (c *ZoneController) Deliveries(w http.ResponseWriter, r *http.Request) {
+	// Extrai o ID da URL (ex: "/zones/1/deliveries" -> "1") e a paginação da query string
+	id, err := parseZoneID(r.URL.Path)
+	var page, pageSize int
+	if err == nil {
+		page, pageSize, err = parsePagination(r.URL.Query())
+	}
+	if err != nil {
+		writeError(w, r, err) // Retorna erro 400 se algum parâmetro for inválido
+		return
+	}
+
+	// Chama o serviço para obter a página de entregas da zona
+	deliveries, err := c.tenantService(r).ListDeliveries(id, page, pageSize)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	// Retorna o status 200 (OK) e a página de entregas no corpo da resposta
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(deliveries)
+}
@@ -12,26 +12,41 @@ import (
 // DB é uma variável global que armazenará a conexão com o banco de dados.
 var DB *sql.DB
 
-// InitDB inicializa a conexão com o banco de dados.
-func InitDB() {
-	// Carrega as variáveis de ambiente do arquivo .env
+// LoadEnv carrega as variáveis de ambiente do arquivo .env, se ele existir.
+// Variáveis já definidas no ambiente (ex: pelo Docker Compose) não são sobrescritas.
+func LoadEnv() {
 	if err := godotenv.Load(".env"); err != nil {
-		log.Fatal("Erro ao carregar o arquivo .env") // Encerra o programa se o arquivo .env não for encontrado
+		log.Println("Arquivo .env não encontrado, usando apenas as variáveis de ambiente")
 	}
+}
 
-	// Recupera as variáveis de ambiente necessárias para a conexão com o banco de dados
-	dbUser := os.Getenv("DB_USER")         // Usuário do banco de dados
-	dbPassword := os.Getenv("DB_PASSWORD") // Senha do banco de dados
-	dbHost := os.Getenv("DB_HOST")         // Host do banco de dados (ex: localhost)
-	dbPort := os.Getenv("DB_PORT")         // Porta do banco de dados (ex: 3306)
-	dbName := os.Getenv("DB_NAME")         // Nome do banco de dados
+// Driver indica o banco de dados em uso ("mysql" ou "sqlite"), definido pela variável DB_DRIVER.
+var Driver string
 
-	// Monta a string de conexão com o banco de dados, incluindo o charset utf8mb4
-	connectionString := dbUser + ":" + dbPassword + "@tcp(" + dbHost + ":" + dbPort + ")/" + dbName + "?charset=utf8mb4"
+// InitDB inicializa a conexão com o banco de dados escolhido pela variável DB_DRIVER (padrão: mysql).
+func InitDB() {
+	// Carrega as variáveis de ambiente do arquivo .env
+	LoadEnv()
+
+	Driver = os.Getenv("DB_DRIVER")
+	if Driver == "" {
+		Driver = "mysql"
+	}
 
 	var err error
-	// Abre a conexão com o banco de dados usando o driver MySQL e a string de conexão
-	DB, err = sql.Open("mysql", connectionString)
+	switch Driver {
+	case "mysql":
+		DB, err = openMySQL()
+	case "sqlite":
+		// Caminho do arquivo do banco SQLite (ex: deliveries.db)
+		dbPath := os.Getenv("DB_PATH")
+		if dbPath == "" {
+			dbPath = "deliveries.db"
+		}
+		DB, err = OpenSQLite(dbPath)
+	default:
+		log.Fatalf("DB_DRIVER desconhecido: %s (use mysql ou sqlite)", Driver)
+	}
 	if err != nil {
 		log.Fatal(err) // Encerra o programa se houver erro ao abrir a conexão
 	}
@@ -42,5 +57,23 @@ func InitDB() {
 	}
 
 	// Loga uma mensagem de sucesso ao estabelecer a conexão
-	log.Println("Conexão com o banco de dados estabelecida!")
+	log.Println("Conexão com o banco de dados estabelecida!", "("+Driver+")")
+}
+
+// openMySQL abre a conexão com o MySQL usando as variáveis de ambiente DB_USER, DB_PASSWORD, DB_HOST, DB_PORT e DB_NAME.
+func openMySQL() (*sql.DB, error) {
+	// Recupera as variáveis de ambiente necessárias para a conexão com o banco de dados
+	dbUser := os.Getenv("DB_USER")         // Usuário do banco de dados
+	dbPassword := os.Getenv("DB_PASSWORD") // Senha do banco de dados
+	dbHost := os.Getenv("DB_HOST")         // Host do banco de dados (ex: localhost)
+	dbPort := os.Getenv("DB_PORT")         // Porta do banco de dados (ex: 3306)
+	dbName := os.Getenv("DB_NAME")         // Nome do banco de dados
+
+	// Monta a string de conexão com o banco de dados, incluindo o charset utf8mb4
+	// parseTime=true faz o driver converter colunas TIMESTAMP/DATETIME para time.Time, e clientFoundRows=true faz o
+	// RowsAffected contar as linhas encontradas pelo WHERE (e não apenas as alteradas), usado para detectar IDs inexistentes
+	connectionString := dbUser + ":" + dbPassword + "@tcp(" + dbHost + ":" + dbPort + ")/" + dbName + "?charset=utf8mb4&parseTime=true&clientFoundRows=true"
+
+	// Abre a conexão com o banco de dados usando o driver MySQL e a string de conexão
+	return sql.Open("mysql", connectionString)
 }
@@ -0,0 +1,309 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// migrationsFS contém os arquivos de migração de cada banco, no formato
+// migrations/<driver>/<versão>_<nome>.up.sql e migrations/<driver>/<versão>_<nome>.down.sql.
+//
+//go:embed migrations
+var migrationsFS embed.FS
+
+// ErrSchemaAhead é retornado quando o banco possui migrações que este binário não conhece
+// (ou seja, o banco foi migrado por uma versão mais nova da aplicação).
+var ErrSchemaAhead = errors.New("o banco de dados está em uma versão mais nova que a aplicação")
+
+// Migration representa uma migração versionada do esquema do banco de dados.
+type Migration struct {
+	Version int    // Número da versão (ex: 1 para 0001_esquema_inicial)
+	Name    string // Nome descritivo da migração
+	Up      string // SQL que aplica a migração
+	Down    string // SQL que desfaz a migração
+}
+
+// MigrationState indica se uma migração já foi aplicada ao banco.
+type MigrationState struct {
+	Migration
+	AppliedAt *time.Time // Data de aplicação (nil se pendente)
+}
+
+// createMigrationsTable é a tabela que registra as migrações já aplicadas (mesmo SQL para MySQL e SQLite).
+const createMigrationsTable = `CREATE TABLE IF NOT EXISTS schema_migrations (
+    version INT NOT NULL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+)`
+
+// migrationLockTimeout é o tempo máximo, em segundos, de espera pelo lock das migrações no MySQL.
+const migrationLockTimeout = 60
+
+// LoadMigrations lê as migrações embutidas do driver informado, em ordem crescente de versão.
+func LoadMigrations(driver string) ([]Migration, error) {
+	dir := path.Join("migrations", driver)
+	files, err := fs.ReadDir(migrationsFS, dir)
+	if err != nil {
+		return nil, fmt.Errorf("não há migrações para o driver %s: %w", driver, err)
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, file := range files {
+		// Separa "0002_status_entrega.up.sql" em versão, nome e direção
+		base, direction, ok := strings.Cut(strings.TrimSuffix(file.Name(), ".sql"), ".")
+		versionStr, name, found := strings.Cut(base, "_")
+		version, convErr := strconv.Atoi(versionStr)
+		if !ok || !found || convErr != nil || (direction != "up" && direction != "down") {
+			return nil, fmt.Errorf("nome de migração inválido: %s", file.Name())
+		}
+
+		content, err := fs.ReadFile(migrationsFS, path.Join(dir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		migration, exists := byVersion[version]
+		if !exists {
+			migration = &Migration{Version: version, Name: name}
+			byVersion[version] = migration
+		}
+		if direction == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" {
+			return nil, fmt.Errorf("migração %04d_%s sem arquivo .up.sql", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// appliedMigrations retorna as versões já aplicadas e a data de aplicação de cada uma.
+func appliedMigrations(db *sql.DB) (map[int]time.Time, error) {
+	if _, err := db.Exec(createMigrationsTable); err != nil {
+		return nil, err
+	}
+
+	rows, err := db.Query("SELECT version, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := make(map[int]time.Time)
+	for rows.Next() {
+		var version int
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = appliedAt
+	}
+	return applied, rows.Err()
+}
+
+// checkNotAhead retorna ErrSchemaAhead se o banco tiver alguma migração que o binário não conhece.
+func checkNotAhead(migrations []Migration, applied map[int]time.Time) error {
+	known := make(map[int]bool, len(migrations))
+	for _, migration := range migrations {
+		known[migration.Version] = true
+	}
+	for version := range applied {
+		if !known[version] {
+			return fmt.Errorf("%w (migração %04d desconhecida)", ErrSchemaAhead, version)
+		}
+	}
+	return nil
+}
+
+// MigrateUp aplica, em ordem, todas as migrações pendentes e retorna quantas foram aplicadas.
+// Recusa-se a continuar se o banco estiver à frente do binário. No MySQL, as migrações são aplicadas
+// com o lock das migrações (ver lockMigrations), para que instâncias iniciadas ao mesmo tempo não
+// apliquem as mesmas migrações.
+func MigrateUp(db *sql.DB, driver string) (int, error) {
+	migrations, err := LoadMigrations(driver)
+	if err != nil {
+		return 0, err
+	}
+	unlock, err := lockMigrations(db, driver)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	applied, err := appliedMigrations(db)
+	if err != nil {
+		return 0, err
+	}
+	if err := checkNotAhead(migrations, applied); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, migration := range migrations {
+		if _, ok := applied[migration.Version]; ok {
+			continue // Migração já aplicada
+		}
+		if err := runMigration(db, migration.Up, "INSERT INTO schema_migrations (version, name) VALUES (?, ?)", migration.Version, migration.Name); err != nil {
+			return count, fmt.Errorf("erro ao aplicar a migração %04d_%s: %w", migration.Version, migration.Name, err)
+		}
+		count++
+	}
+	return count, nil
+}
+
+// MigrateDown desfaz a última migração aplicada e retorna a migração desfeita (nil se não havia nenhuma).
+func MigrateDown(db *sql.DB, driver string) (*Migration, error) {
+	migrations, err := LoadMigrations(driver)
+	if err != nil {
+		return nil, err
+	}
+	unlock, err := lockMigrations(db, driver)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	applied, err := appliedMigrations(db)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkNotAhead(migrations, applied); err != nil {
+		return nil, err
+	}
+
+	// Procura a migração aplicada de maior versão
+	for i := len(migrations) - 1; i >= 0; i-- {
+		migration := migrations[i]
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+		if migration.Down == "" {
+			return nil, fmt.Errorf("a migração %04d_%s não pode ser desfeita (sem arquivo .down.sql)", migration.Version, migration.Name)
+		}
+		if err := runMigration(db, migration.Down, "DELETE FROM schema_migrations WHERE version = ?", migration.Version); err != nil {
+			return nil, fmt.Errorf("erro ao desfazer a migração %04d_%s: %w", migration.Version, migration.Name, err)
+		}
+		return &migration, nil
+	}
+	return nil, nil
+}
+
+// MigrationStatus retorna todas as migrações conhecidas, indicando quais já foram aplicadas.
+func MigrationStatus(db *sql.DB, driver string) ([]MigrationState, error) {
+	migrations, err := LoadMigrations(driver)
+	if err != nil {
+		return nil, err
+	}
+	applied, err := appliedMigrations(db)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]MigrationState, 0, len(migrations))
+	for _, migration := range migrations {
+		state := MigrationState{Migration: migration}
+		if appliedAt, ok := applied[migration.Version]; ok {
+			state.AppliedAt = &appliedAt
+		}
+		states = append(states, state)
+	}
+	return states, checkNotAhead(migrations, applied)
+}
+
+// lockMigrations obtém o lock das migrações do banco atual no MySQL (GET_LOCK), esperando até
+// migrationLockTimeout segundos enquanto outra instância aplica as migrações, e retorna a função que o
+// libera. O lock pertence à conexão, por isso uma conexão do pool fica reservada até a liberação. No
+// SQLite o lock não é usado: o banco é um arquivo local, usado por uma única instância.
+func lockMigrations(db *sql.DB, driver string) (func(), error) {
+	if driver != "mysql" {
+		return func() {}, nil
+	}
+
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var acquired sql.NullInt64
+	err = conn.QueryRowContext(ctx, "SELECT GET_LOCK(CONCAT('schema_migrations.', DATABASE()), ?)", migrationLockTimeout).Scan(&acquired)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if acquired.Int64 != 1 {
+		conn.Close()
+		return nil, fmt.Errorf("outra instância está aplicando as migrações: lock não obtido em %d segundos", migrationLockTimeout)
+	}
+
+	return func() {
+		conn.ExecContext(ctx, "DO RELEASE_LOCK(CONCAT('schema_migrations.', DATABASE()))")
+		conn.Close()
+	}, nil
+}
+
+// runMigration executa os comandos de uma migração e registra o resultado em schema_migrations,
+// dentro de uma transação. No SQLite a migração é atômica. No MySQL não: cada comando DDL
+// (CREATE, ALTER, DROP) confirma implicitamente a transação, e uma migração que falhe depois do
+// primeiro deles fica aplicada pela metade, sem registro em schema_migrations. Por isso as
+// migrações do MySQL alteram cada tabela com um único ALTER TABLE e, quando alteram mais de uma,
+// verificam em information_schema se cada alteração já foi feita, para que uma nova tentativa
+// continue de onde a anterior parou.
+func runMigration(db *sql.DB, script string, record string, args ...any) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+
+	for _, statement := range splitStatements(script) {
+		if _, err := tx.Exec(statement); err != nil {
+			tx.Rollback() // Desfaz a transação em caso de erro
+			return err
+		}
+	}
+	if _, err := tx.Exec(record, args...); err != nil {
+		tx.Rollback() // Desfaz a transação em caso de erro
+		return err
+	}
+	return tx.Commit()
+}
+
+// splitStatements separa um script SQL em comandos individuais, já que o driver do MySQL
+// executa um comando por chamada. Os comandos terminam com ";" no fim da linha e linhas
+// de comentário ("--") são ignoradas.
+func splitStatements(script string) []string {
+	var statements []string
+	var current strings.Builder
+	for _, line := range strings.Split(script, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		current.WriteString(line)
+		current.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			statements = append(statements, strings.TrimSuffix(strings.TrimSpace(current.String()), ";"))
+			current.Reset()
+		}
+	}
+	if rest := strings.TrimSpace(current.String()); rest != "" {
+		statements = append(statements, rest)
+	}
+	return statements
+}
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS Entrega;
+DROP TABLE IF EXISTS Cliente;
@@ -0,0 +1 @@
+ALTER TABLE Entrega DROP INDEX idx_entrega_status, DROP COLUMN status;
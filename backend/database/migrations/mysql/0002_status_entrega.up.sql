@@ -0,0 +1,3 @@
+ALTER TABLE Entrega
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'pendente' AFTER longitude,
+    ADD INDEX idx_entrega_status (status);
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS EventoRastreamento (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    entrega_id INT NOT NULL,
+    data_hora TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    status VARCHAR(20) NOT NULL,
+    latitude DECIMAL(9, 6),
+    longitude DECIMAL(9, 6),
+    observacao VARCHAR(255) NOT NULL DEFAULT '',
+    responsavel VARCHAR(100) NOT NULL DEFAULT '',
+    INDEX idx_evento_entrega (entrega_id, data_hora),
+    FOREIGN KEY (entrega_id) REFERENCES Entrega(id) ON DELETE CASCADE
+) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
@@ -0,0 +1 @@
+ALTER TABLE Entrega DROP INDEX uq_entrega_codigo_rastreio, DROP COLUMN codigo_rastreio;
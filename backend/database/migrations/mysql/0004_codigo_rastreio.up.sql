@@ -0,0 +1,3 @@
+ALTER TABLE Entrega
+    ADD COLUMN codigo_rastreio VARCHAR(13) NULL AFTER id,
+    ADD UNIQUE INDEX uq_entrega_codigo_rastreio (codigo_rastreio);
@@ -0,0 +1 @@
+ALTER TABLE Entrega DROP INDEX idx_entrega_data_cadastro, DROP INDEX idx_entrega_cidade;
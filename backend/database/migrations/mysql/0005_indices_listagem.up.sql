@@ -0,0 +1,3 @@
+ALTER TABLE Entrega
+    ADD INDEX idx_entrega_cidade (cidade),
+    ADD INDEX idx_entrega_data_cadastro (data_cadastro);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS Veiculo (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    placa VARCHAR(7) NOT NULL UNIQUE,
+    tipo VARCHAR(20) NOT NULL,
+    capacidade_peso DECIMAL(10, 2) NOT NULL,
+    capacidade_volume DECIMAL(10, 3) NOT NULL DEFAULT 0,
+    ativo BOOLEAN NOT NULL DEFAULT TRUE,
+    data_cadastro TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
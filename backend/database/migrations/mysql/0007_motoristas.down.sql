@@ -0,0 +1,2 @@
+ALTER TABLE Entrega DROP FOREIGN KEY fk_entrega_motorista, DROP COLUMN motorista_id;
+DROP TABLE IF EXISTS Motorista;
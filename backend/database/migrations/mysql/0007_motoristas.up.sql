@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS Motorista (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    nome VARCHAR(100) NOT NULL,
+    cpf VARCHAR(14) NOT NULL UNIQUE,
+    cnh_numero VARCHAR(11) NOT NULL UNIQUE,
+    cnh_categoria VARCHAR(2) NOT NULL,
+    telefone VARCHAR(20),
+    ativo BOOLEAN NOT NULL DEFAULT TRUE,
+    data_cadastro TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+ALTER TABLE Entrega
+    ADD COLUMN motorista_id INT NULL AFTER cliente_id,
+    ADD CONSTRAINT fk_entrega_motorista FOREIGN KEY (motorista_id) REFERENCES Motorista(id) ON DELETE SET NULL;
@@ -0,0 +1 @@
+CREATE INDEX idx_entrega_coordenadas ON Entrega (latitude, longitude);
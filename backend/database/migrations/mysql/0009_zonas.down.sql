@@ -0,0 +1,2 @@
+ALTER TABLE Entrega DROP FOREIGN KEY fk_entrega_zona, DROP COLUMN zona_id;
+DROP TABLE IF EXISTS Zona;
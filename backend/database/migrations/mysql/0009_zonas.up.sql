@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS Zona (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    nome VARCHAR(100) NOT NULL UNIQUE,
+    poligono TEXT NOT NULL,
+    data_cadastro TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+
+ALTER TABLE Entrega
+    ADD COLUMN zona_id INT NULL AFTER motorista_id,
+    ADD CONSTRAINT fk_entrega_zona FOREIGN KEY (zona_id) REFERENCES Zona(id) ON DELETE SET NULL;
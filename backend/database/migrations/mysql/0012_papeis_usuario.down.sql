@@ -0,0 +1 @@
+ALTER TABLE Usuario DROP FOREIGN KEY fk_usuario_motorista, DROP COLUMN motorista_id, DROP COLUMN papel;
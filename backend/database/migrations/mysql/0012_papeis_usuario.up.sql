@@ -0,0 +1,16 @@
+-- O ALTER TABLE confirma a transação da migração antes do UPDATE: ele só é executado se a coluna ainda não
+-- existir, para que a migração possa ser executada de novo se falhar depois dele.
+SET @ddl = IF(
+    (SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'Usuario' AND COLUMN_NAME = 'papel') = 0,
+    'ALTER TABLE Usuario
+        ADD COLUMN papel VARCHAR(20) NOT NULL DEFAULT ''viewer'' AFTER senha_hash,
+        ADD COLUMN motorista_id INT NULL AFTER papel,
+        ADD CONSTRAINT fk_usuario_motorista FOREIGN KEY (motorista_id) REFERENCES Motorista(id) ON DELETE SET NULL',
+    'DO 0'
+);
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
+
+-- Os usuários cadastrados antes dos papéis tinham acesso a todas as rotas
+UPDATE Usuario SET papel = 'admin';
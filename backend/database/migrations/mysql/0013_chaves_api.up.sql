@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS ChaveAPI (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    nome VARCHAR(100) NOT NULL,
+    prefixo VARCHAR(20) NOT NULL UNIQUE,
+    hash CHAR(64) NOT NULL,
+    permissoes VARCHAR(500) NOT NULL,
+    expira_em TIMESTAMP NULL,
+    ultimo_uso TIMESTAMP NULL,
+    usuario_id INT NULL,
+    data_cadastro TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_chave_usuario FOREIGN KEY (usuario_id) REFERENCES Usuario(id) ON DELETE SET NULL
+) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
@@ -0,0 +1,44 @@
+-- Como na migração, cada tabela é alterada em um único comando, executado apenas se a coluna embarcador_id
+-- ainda existir, para que a reversão possa ser executada de novo se falhar no meio.
+SET @ddl = IF(
+    (SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'ChaveAPI' AND COLUMN_NAME = 'embarcador_id') > 0,
+    'ALTER TABLE ChaveAPI DROP FOREIGN KEY fk_chave_embarcador, DROP COLUMN embarcador_id',
+    'DO 0'
+);
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
+
+SET @ddl = IF(
+    (SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'Usuario' AND COLUMN_NAME = 'embarcador_id') > 0,
+    'ALTER TABLE Usuario DROP FOREIGN KEY fk_usuario_embarcador, DROP COLUMN embarcador_id',
+    'DO 0'
+);
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
+
+SET @ddl = IF(
+    (SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'Entrega' AND COLUMN_NAME = 'embarcador_id') > 0,
+    'ALTER TABLE Entrega DROP FOREIGN KEY fk_entrega_embarcador, DROP COLUMN embarcador_id',
+    'DO 0'
+);
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
+
+-- Volta ao CPF único em todos os embarcadores (falha se o mesmo CPF estiver em mais de um embarcador)
+SET @ddl = IF(
+    (SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'Cliente' AND COLUMN_NAME = 'embarcador_id') > 0,
+    'ALTER TABLE Cliente
+        DROP FOREIGN KEY fk_cliente_embarcador,
+        DROP INDEX uk_cliente_embarcador_cpf,
+        ADD UNIQUE KEY cpf (cpf),
+        DROP COLUMN embarcador_id',
+    'DO 0'
+);
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
+
+DROP TABLE IF EXISTS Embarcador;
@@ -0,0 +1,59 @@
+-- Embarcadores: as empresas para as quais as entregas são feitas. Cada cliente, entrega, usuário e chave de
+-- API pertence a um embarcador; os dados já cadastrados ficam com o embarcador padrão (id 1).
+CREATE TABLE IF NOT EXISTS Embarcador (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    nome VARCHAR(100) NOT NULL UNIQUE,
+    data_cadastro TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
+INSERT IGNORE INTO Embarcador (id, nome) VALUES (1, 'Padrão');
+
+-- Cada ALTER TABLE confirma a transação da migração, então as alterações de cada tabela são feitas em um
+-- único comando, executado apenas se a coluna embarcador_id ainda não existir: se a migração falhar no meio,
+-- ela pode ser executada de novo a partir da tabela em que parou.
+
+-- O CPF passa a ser único por embarcador (o índice "cpf" é o da restrição UNIQUE do esquema inicial)
+SET @ddl = IF(
+    (SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'Cliente' AND COLUMN_NAME = 'embarcador_id') = 0,
+    'ALTER TABLE Cliente
+        ADD COLUMN embarcador_id INT NOT NULL DEFAULT 1 AFTER id,
+        ADD CONSTRAINT fk_cliente_embarcador FOREIGN KEY (embarcador_id) REFERENCES Embarcador(id),
+        DROP INDEX cpf,
+        ADD UNIQUE KEY uk_cliente_embarcador_cpf (embarcador_id, cpf)',
+    'DO 0'
+);
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
+
+SET @ddl = IF(
+    (SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'Entrega' AND COLUMN_NAME = 'embarcador_id') = 0,
+    'ALTER TABLE Entrega
+        ADD COLUMN embarcador_id INT NOT NULL DEFAULT 1 AFTER id,
+        ADD CONSTRAINT fk_entrega_embarcador FOREIGN KEY (embarcador_id) REFERENCES Embarcador(id)',
+    'DO 0'
+);
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
+
+SET @ddl = IF(
+    (SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'Usuario' AND COLUMN_NAME = 'embarcador_id') = 0,
+    'ALTER TABLE Usuario
+        ADD COLUMN embarcador_id INT NOT NULL DEFAULT 1 AFTER id,
+        ADD CONSTRAINT fk_usuario_embarcador FOREIGN KEY (embarcador_id) REFERENCES Embarcador(id)',
+    'DO 0'
+);
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
+
+SET @ddl = IF(
+    (SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'ChaveAPI' AND COLUMN_NAME = 'embarcador_id') = 0,
+    'ALTER TABLE ChaveAPI
+        ADD COLUMN embarcador_id INT NOT NULL DEFAULT 1 AFTER id,
+        ADD CONSTRAINT fk_chave_embarcador FOREIGN KEY (embarcador_id) REFERENCES Embarcador(id)',
+    'DO 0'
+);
+PREPARE ddl FROM @ddl;
+EXECUTE ddl;
+DEALLOCATE PREPARE ddl;
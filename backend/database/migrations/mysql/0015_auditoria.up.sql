@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS Auditoria (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    embarcador_id INT NOT NULL DEFAULT 1,
+    data_hora TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    ator VARCHAR(255) NOT NULL,
+    entidade VARCHAR(20) NOT NULL,
+    entidade_id INT NOT NULL,
+    acao VARCHAR(10) NOT NULL,
+    alteracoes LONGTEXT NOT NULL,
+    INDEX idx_auditoria_entidade (embarcador_id, entidade, entidade_id, data_hora),
+    INDEX idx_auditoria_data (embarcador_id, data_hora),
+    CONSTRAINT fk_auditoria_embarcador FOREIGN KEY (embarcador_id) REFERENCES Embarcador(id)
+) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
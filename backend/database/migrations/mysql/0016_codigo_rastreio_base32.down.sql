@@ -0,0 +1,2 @@
+-- Falha se houver entregas com códigos de rastreio no formato novo
+ALTER TABLE Entrega MODIFY COLUMN codigo_rastreio VARCHAR(13) NULL;
@@ -0,0 +1,2 @@
+-- Os códigos de rastreio passam a ter 12 caracteres aleatórios em base 32 (17 caracteres no total)
+ALTER TABLE Entrega MODIFY COLUMN codigo_rastreio VARCHAR(17) NULL;
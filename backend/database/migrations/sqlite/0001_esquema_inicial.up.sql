@@ -0,0 +1,26 @@
+-- Esquema inicial, equivalente ao do MySQL. As colunas de texto usam a collation UNICODE_CI
+-- (registrada em database/sqlite.go), que ignora acentos e maiúsculas como a utf8mb4_unicode_ci.
+CREATE TABLE IF NOT EXISTS Cliente (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    nome VARCHAR(100) NOT NULL COLLATE UNICODE_CI,
+    cpf VARCHAR(14) NOT NULL UNIQUE,
+    email VARCHAR(100) COLLATE UNICODE_CI,
+    telefone VARCHAR(20) COLLATE UNICODE_CI
+);
+
+CREATE TABLE IF NOT EXISTS Entrega (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    cliente_id INTEGER NOT NULL REFERENCES Cliente(id),
+    peso DECIMAL(10, 2) NOT NULL,
+    endereco VARCHAR(255) NOT NULL COLLATE UNICODE_CI,
+    logradouro VARCHAR(100) NOT NULL COLLATE UNICODE_CI,
+    numero VARCHAR(10) NOT NULL COLLATE UNICODE_CI,
+    bairro VARCHAR(100) NOT NULL COLLATE UNICODE_CI,
+    complemento VARCHAR(100) COLLATE UNICODE_CI,
+    cidade VARCHAR(100) NOT NULL COLLATE UNICODE_CI,
+    estado VARCHAR(50) NOT NULL COLLATE UNICODE_CI,
+    pais VARCHAR(50) NOT NULL COLLATE UNICODE_CI,
+    latitude DECIMAL(9, 6) NOT NULL,
+    longitude DECIMAL(9, 6) NOT NULL,
+    data_cadastro TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
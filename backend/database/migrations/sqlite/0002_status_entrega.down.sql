@@ -0,0 +1,2 @@
+DROP INDEX idx_entrega_status;
+ALTER TABLE Entrega DROP COLUMN status;
@@ -0,0 +1,2 @@
+ALTER TABLE Entrega ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'pendente';
+CREATE INDEX idx_entrega_status ON Entrega (status);
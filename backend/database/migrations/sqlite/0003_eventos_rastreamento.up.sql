@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS EventoRastreamento (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    entrega_id INTEGER NOT NULL REFERENCES Entrega(id) ON DELETE CASCADE,
+    data_hora TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    status VARCHAR(20) NOT NULL,
+    latitude DECIMAL(9, 6),
+    longitude DECIMAL(9, 6),
+    observacao VARCHAR(255) NOT NULL DEFAULT '' COLLATE UNICODE_CI,
+    responsavel VARCHAR(100) NOT NULL DEFAULT '' COLLATE UNICODE_CI
+);
+CREATE INDEX IF NOT EXISTS idx_evento_entrega ON EventoRastreamento (entrega_id, data_hora);
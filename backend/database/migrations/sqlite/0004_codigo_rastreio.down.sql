@@ -0,0 +1,2 @@
+DROP INDEX uq_entrega_codigo_rastreio;
+ALTER TABLE Entrega DROP COLUMN codigo_rastreio;
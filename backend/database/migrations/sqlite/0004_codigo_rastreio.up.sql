@@ -0,0 +1,2 @@
+ALTER TABLE Entrega ADD COLUMN codigo_rastreio VARCHAR(13);
+CREATE UNIQUE INDEX uq_entrega_codigo_rastreio ON Entrega (codigo_rastreio);
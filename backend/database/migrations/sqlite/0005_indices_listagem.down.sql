@@ -0,0 +1,2 @@
+DROP INDEX idx_entrega_data_cadastro;
+DROP INDEX idx_entrega_cidade;
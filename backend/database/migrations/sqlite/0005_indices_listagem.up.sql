@@ -0,0 +1,2 @@
+CREATE INDEX idx_entrega_cidade ON Entrega (cidade);
+CREATE INDEX idx_entrega_data_cadastro ON Entrega (data_cadastro);
@@ -0,0 +1,3 @@
+DROP INDEX idx_entrega_motorista;
+ALTER TABLE Entrega DROP COLUMN motorista_id;
+DROP TABLE IF EXISTS Motorista;
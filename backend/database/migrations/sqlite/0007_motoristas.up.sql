@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS Motorista (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    nome VARCHAR(100) NOT NULL COLLATE UNICODE_CI,
+    cpf VARCHAR(14) NOT NULL UNIQUE,
+    cnh_numero VARCHAR(11) NOT NULL UNIQUE,
+    cnh_categoria VARCHAR(2) NOT NULL,
+    telefone VARCHAR(20) COLLATE UNICODE_CI,
+    ativo BOOLEAN NOT NULL DEFAULT 1,
+    data_cadastro TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE Entrega ADD COLUMN motorista_id INTEGER REFERENCES Motorista(id) ON DELETE SET NULL;
+CREATE INDEX idx_entrega_motorista ON Entrega (motorista_id);
@@ -0,0 +1 @@
+DROP INDEX idx_entrega_coordenadas;
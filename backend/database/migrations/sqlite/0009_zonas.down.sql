@@ -0,0 +1,3 @@
+DROP INDEX idx_entrega_zona;
+ALTER TABLE Entrega DROP COLUMN zona_id;
+DROP TABLE IF EXISTS Zona;
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS Zona (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    nome VARCHAR(100) NOT NULL UNIQUE COLLATE UNICODE_CI,
+    poligono TEXT NOT NULL,
+    data_cadastro TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE Entrega ADD COLUMN zona_id INTEGER REFERENCES Zona(id) ON DELETE SET NULL;
+CREATE INDEX idx_entrega_zona ON Entrega (zona_id);
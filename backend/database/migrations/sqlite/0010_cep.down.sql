@@ -0,0 +1 @@
+ALTER TABLE Entrega DROP COLUMN cep;
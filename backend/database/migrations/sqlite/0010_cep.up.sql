@@ -0,0 +1 @@
+ALTER TABLE Entrega ADD COLUMN cep VARCHAR(9) NOT NULL DEFAULT '';
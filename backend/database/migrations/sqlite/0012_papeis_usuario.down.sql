@@ -0,0 +1,2 @@
+ALTER TABLE Usuario DROP COLUMN motorista_id;
+ALTER TABLE Usuario DROP COLUMN papel;
@@ -0,0 +1,5 @@
+ALTER TABLE Usuario ADD COLUMN papel VARCHAR(20) NOT NULL DEFAULT 'viewer';
+ALTER TABLE Usuario ADD COLUMN motorista_id INTEGER REFERENCES Motorista(id) ON DELETE SET NULL;
+
+-- Os usuários cadastrados antes dos papéis tinham acesso a todas as rotas
+UPDATE Usuario SET papel = 'admin';
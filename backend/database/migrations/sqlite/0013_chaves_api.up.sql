@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS ChaveAPI (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    nome VARCHAR(100) NOT NULL,
+    prefixo VARCHAR(20) NOT NULL UNIQUE,
+    hash CHAR(64) NOT NULL,
+    permissoes VARCHAR(500) NOT NULL,
+    expira_em TIMESTAMP NULL,
+    ultimo_uso TIMESTAMP NULL,
+    usuario_id INTEGER REFERENCES Usuario(id) ON DELETE SET NULL,
+    data_cadastro TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,20 @@
+ALTER TABLE ChaveAPI DROP COLUMN embarcador_id;
+ALTER TABLE Usuario DROP COLUMN embarcador_id;
+DROP INDEX IF EXISTS idx_entrega_embarcador;
+ALTER TABLE Entrega DROP COLUMN embarcador_id;
+
+-- Volta ao CPF único em todos os embarcadores (falha se o mesmo CPF estiver em mais de um embarcador)
+PRAGMA defer_foreign_keys = ON;
+CREATE TABLE Cliente_antigo AS SELECT id, nome, cpf, email, telefone FROM Cliente;
+DROP TABLE Cliente;
+CREATE TABLE Cliente (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    nome VARCHAR(100) NOT NULL COLLATE UNICODE_CI,
+    cpf VARCHAR(14) NOT NULL UNIQUE,
+    email VARCHAR(100) COLLATE UNICODE_CI,
+    telefone VARCHAR(20) COLLATE UNICODE_CI
+);
+INSERT INTO Cliente (id, nome, cpf, email, telefone) SELECT id, nome, cpf, email, telefone FROM Cliente_antigo;
+DROP TABLE Cliente_antigo;
+
+DROP TABLE IF EXISTS Embarcador;
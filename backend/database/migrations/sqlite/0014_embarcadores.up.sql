@@ -0,0 +1,33 @@
+-- Embarcadores: as empresas para as quais as entregas são feitas. Cada cliente, entrega, usuário e chave de
+-- API pertence a um embarcador; os dados já cadastrados ficam com o embarcador padrão (id 1).
+CREATE TABLE IF NOT EXISTS Embarcador (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    nome VARCHAR(100) NOT NULL UNIQUE COLLATE UNICODE_CI,
+    data_cadastro TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+INSERT INTO Embarcador (id, nome) VALUES (1, 'Padrão');
+
+-- O CPF passa a ser único por embarcador. O SQLite não remove a restrição UNIQUE de uma coluna, então a
+-- tabela Cliente é recriada; as chaves estrangeiras das entregas são verificadas apenas no fim da transação,
+-- depois que os clientes foram copiados para a nova tabela.
+PRAGMA defer_foreign_keys = ON;
+CREATE TABLE Cliente_antigo AS SELECT id, nome, cpf, email, telefone FROM Cliente;
+DROP TABLE Cliente;
+CREATE TABLE Cliente (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    embarcador_id INTEGER NOT NULL DEFAULT 1 REFERENCES Embarcador(id),
+    nome VARCHAR(100) NOT NULL COLLATE UNICODE_CI,
+    cpf VARCHAR(14) NOT NULL,
+    email VARCHAR(100) COLLATE UNICODE_CI,
+    telefone VARCHAR(20) COLLATE UNICODE_CI,
+    UNIQUE (embarcador_id, cpf)
+);
+INSERT INTO Cliente (id, nome, cpf, email, telefone) SELECT id, nome, cpf, email, telefone FROM Cliente_antigo;
+DROP TABLE Cliente_antigo;
+
+-- O SQLite não aceita REFERENCES em uma coluna adicionada com valor padrão; o embarcador das entregas é o
+-- do cliente, e o dos usuários e das chaves é verificado pela aplicação.
+ALTER TABLE Entrega ADD COLUMN embarcador_id INTEGER NOT NULL DEFAULT 1;
+CREATE INDEX idx_entrega_embarcador ON Entrega (embarcador_id);
+ALTER TABLE Usuario ADD COLUMN embarcador_id INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE ChaveAPI ADD COLUMN embarcador_id INTEGER NOT NULL DEFAULT 1;
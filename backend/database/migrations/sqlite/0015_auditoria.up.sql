@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS Auditoria (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    embarcador_id INTEGER NOT NULL DEFAULT 1 REFERENCES Embarcador(id),
+    data_hora TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ator VARCHAR(255) NOT NULL COLLATE UNICODE_CI,
+    entidade VARCHAR(20) NOT NULL,
+    entidade_id INTEGER NOT NULL,
+    acao VARCHAR(10) NOT NULL,
+    alteracoes TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_auditoria_entidade ON Auditoria (embarcador_id, entidade, entidade_id, data_hora);
+CREATE INDEX IF NOT EXISTS idx_auditoria_data ON Auditoria (embarcador_id, data_hora);
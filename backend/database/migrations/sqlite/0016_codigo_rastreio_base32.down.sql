@@ -0,0 +1 @@
+-- Nada a desfazer: o SQLite não limita o tamanho das colunas VARCHAR.
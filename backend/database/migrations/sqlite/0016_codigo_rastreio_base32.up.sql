@@ -0,0 +1,3 @@
+-- Os códigos de rastreio passam a ter 12 caracteres aleatórios em base 32 (17 caracteres no total). O SQLite
+-- não limita o tamanho das colunas VARCHAR, então nenhuma alteração é necessária; a migração existe para
+-- manter a mesma numeração do MySQL.
@@ -0,0 +1,82 @@
+package database
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+
+	"meu-projeto/backend/utils"
+
+	"modernc.org/sqlite" // Driver SQLite escrito em Go puro (não depende de CGO)
+)
+
+// init registra no driver SQLite as funções que reproduzem o comportamento do MySQL.
+func init() {
+	// Collation que ignora acentos e maiúsculas, equivalente à utf8mb4_unicode_ci.
+	// É usada nas colunas de texto para que "=" e ORDER BY se comportem como no MySQL.
+	sqlite.MustRegisterCollationUtf8("UNICODE_CI", func(left, right string) int {
+		return strings.Compare(utils.Fold(left), utils.Fold(right))
+	})
+
+	// Substitui o LIKE nativo (que só ignora maiúsculas em ASCII) por um que também ignora acentos.
+	// O SQLite chama like(padrão, valor, escape) para "valor LIKE padrão ESCAPE escape". O driver só
+	// permite registrar uma aridade por nome, por isso as buscas sempre declaram ESCAPE (ver utils.LikeEscape).
+	sqlite.MustRegisterDeterministicScalarFunction("like", 3, func(ctx *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		if args[0] == nil || args[1] == nil {
+			return nil, nil
+		}
+		escape := rune(0)
+		if value, ok := args[2].(string); ok && value != "" {
+			escape = []rune(value)[0]
+		}
+		return likeMatch(utils.Fold(fmt.Sprint(args[0])), utils.Fold(fmt.Sprint(args[1])), escape), nil
+	})
+}
+
+// OpenSQLite abre (ou cria) o banco de dados SQLite no caminho informado.
+// As tabelas são criadas pelas migrações (ver MigrateUp).
+func OpenSQLite(path string) (*sql.DB, error) {
+	// Ativa as chaves estrangeiras (necessárias para o ON DELETE CASCADE), espera por bloqueios
+	// em vez de falhar imediatamente e grava as datas no formato de data/hora do SQLite
+	dsn := "file:" + path + "?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_time_format=sqlite"
+	return sql.Open("sqlite", dsn)
+}
+
+// likeMatch verifica se o valor atende ao padrão do LIKE, onde "%" representa qualquer sequência
+// de caracteres, "_" representa um único caractere e o caractere de escape torna o seguinte literal.
+func likeMatch(pattern, value string, escape rune) bool {
+	p, v := []rune(pattern), []rune(value)
+
+	// matches[j] indica se o prefixo do padrão já processado combina com v[:j]
+	matches := make([]bool, len(v)+1)
+	matches[0] = true
+	for i := 0; i < len(p); i++ {
+		next := make([]bool, len(v)+1)
+		switch {
+		case p[i] == escape && escape != 0 && i+1 < len(p):
+			// Caractere escapado: compara literalmente com o próximo caractere do padrão
+			i++
+			for j := 1; j <= len(v); j++ {
+				next[j] = matches[j-1] && v[j-1] == p[i]
+			}
+		case p[i] == '%':
+			// Qualquer sequência: combina se algum prefixo anterior já combinava
+			next[0] = matches[0]
+			for j := 1; j <= len(v); j++ {
+				next[j] = next[j-1] || matches[j]
+			}
+		case p[i] == '_':
+			// Um único caractere qualquer
+			for j := 1; j <= len(v); j++ {
+				next[j] = matches[j-1]
+			}
+		default:
+			for j := 1; j <= len(v); j++ {
+				next[j] = matches[j-1] && v[j-1] == p[i]
+			}
+		}
+		matches = next
+	}
+	return matches[len(v)]
+}
@@ -15,277 +15,359 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/clients": {
+        "/api-keys": {
             "get": {
-                "description": "Retorna uma lista de todos os clientes cadastrados.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna as chaves de API cadastradas, identificadas pelo prefixo, com as permissões, a expiração e o último uso. Os usuários de outros embarcadores veem apenas as chaves do seu embarcador. Exige a permissão users:manage.",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Lista todos os clientes",
+                "summary": "Lista as chaves de API",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.Cliente"
+                                "$ref": "#/definitions/models.ChaveAPI"
                             }
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apierror.Response"
                         }
                     }
                 }
             },
-            "put": {
-                "description": "Atualiza os dados de um cliente existente.",
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Gera uma chave de API para uma integração, com as permissões informadas e, opcionalmente, uma data de expiração. A chave completa só é retornada nesta resposta; guarde-a e envie-a no cabeçalho X-API-Key. Exige a permissão users:manage.\nA chave acessa apenas os clientes e as entregas do embarcador_id informado (padrão: o embarcador de quem faz o cadastro).",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Atualiza um cliente",
+                "summary": "Cadastra uma chave de API",
                 "parameters": [
                     {
-                        "description": "Dados do cliente",
-                        "name": "cliente",
+                        "description": "Nome, permissões, expiração e embarcador da chave",
+                        "name": "chave",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Cliente"
+                            "$ref": "#/definitions/models.ChaveAPI"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.Cliente"
+                            "$ref": "#/definitions/models.ChaveAPICriada"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apierror.Response"
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Cria um novo cliente no sistema.",
-                "consumes": [
-                    "application/json"
+            }
+        },
+        "/api-keys/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Remove a chave de API pelo ID; as requisições com ela passam a ser recusadas imediatamente. As chaves de outros embarcadores são tratadas como inexistentes, exceto para os usuários do embarcador padrão. Exige a permissão users:manage.",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Cria um novo cliente",
+                "summary": "Remove uma chave de API",
                 "parameters": [
                     {
-                        "description": "Dados do cliente",
-                        "name": "cliente",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.Cliente"
-                        }
+                        "type": "integer",
+                        "description": "ID da chave",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/models.Cliente"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apierror.Response"
                         }
                     }
                 }
             }
         },
-        "/clients/id/{id}": {
+        "/audit": {
             "get": {
-                "description": "Retorna os detalhes de um cliente específico com base no ID.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna os registros das operações de cadastro, alteração e remoção de entregas e clientes do embarcador do usuário, do mais recente para o mais antigo: quem fez, quando e os valores antes e depois de cada campo alterado. Exige a permissão audit:read.",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Busca um cliente pelo ID",
+                "summary": "Consulta a auditoria",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entidade alterada (delivery ou client)",
+                        "name": "entity",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "description": "ID do cliente",
+                        "description": "ID do registro alterado (exige entity)",
                         "name": "id",
-                        "in": "path",
-                        "required": true
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Início do período (AAAA-MM-DD ou AAAA-MM-DDTHH:MM:SSZ), inclusive",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fim do período (AAAA-MM-DD, inclusive, ou AAAA-MM-DDTHH:MM:SSZ, exclusive)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Página (padrão 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Itens por página (padrão 20, máximo 100)",
+                        "name": "page_size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Cliente"
+                            "$ref": "#/definitions/models.Page-models_RegistroAuditoria"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apierror.Response"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apierror.Response"
                         }
                     }
                 }
             }
         },
-        "/clients/{id}": {
-            "delete": {
-                "description": "Exclui um cliente pelo ID.",
+        "/auth/login": {
+            "post": {
+                "description": "Verifica o e-mail e a senha e retorna um token de acesso (enviado no cabeçalho \"Authorization: Bearer \u003ctoken\u003e\") e um token de renovação.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Exclui um cliente",
+                "summary": "Entra na API",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ID do cliente",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "E-mail e senha",
+                        "name": "credenciais",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.LoginRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TokenPair"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "E-mail ou senha incorretos",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apierror.Response"
                         }
                     }
                 }
             }
         },
-        "/deliveries": {
+        "/auth/me": {
             "get": {
-                "description": "Retorna uma lista de todas as entregas cadastradas.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os dados do usuário dono do token de acesso.",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Lista todas as entregas",
+                "summary": "Retorna o usuário autenticado",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Delivery"
-                            }
+                            "$ref": "#/definitions/models.Usuario"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apierror.Response"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/auth/refresh": {
             "post": {
-                "description": "Cria uma nova entrega associada a um cliente.",
+                "description": "Troca um token de renovação válido por um novo par de tokens.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Cria uma nova entrega",
+                "summary": "Renova os tokens",
                 "parameters": [
                     {
-                        "description": "Dados da entrega",
-                        "name": "delivery",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.Delivery"
-                        }
-                    },
-                    {
-                        "description": "Dados do cliente",
-                        "name": "cliente",
+                        "description": "Token de renovação",
+                        "name": "token",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Cliente"
+                            "$ref": "#/definitions/models.RefreshRequest"
                         }
                     }
                 ],
@@ -293,44 +375,51 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/models.TokenPair"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Token inválido ou expirado",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apierror.Response"
                         }
                     }
                 }
             }
         },
-        "/deliveries/city": {
+        "/cep/{cep}": {
             "get": {
-                "description": "Retorna uma lista de entregas filtradas por cidade.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna o logradouro, o bairro, a cidade e o estado de um CEP (com ou sem pontuação), consultando a tabela local de CEPs e, se configurado, um serviço HTTP compatível com o ViaCEP.",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Busca entregas por cidade",
+                "summary": "Consulta o endereço de um CEP",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Nome da cidade",
-                        "name": "cidade",
-                        "in": "query",
+                        "description": "CEP (ex: 01310-100)",
+                        "name": "cep",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -338,111 +427,147 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Delivery"
-                            }
+                            "$ref": "#/definitions/models.CEPAddress"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
                         }
                     }
                 }
             }
         },
-        "/deliveries/id/{id}": {
+        "/clients": {
             "get": {
-                "description": "Retorna os detalhes de uma entrega específica com base no ID.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna uma página de clientes. A busca \"q\" é parcial e ignora acentos em nome, e-mail e telefone, e exata no CPF (com ou sem pontuação).",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Busca uma entrega pelo ID",
+                "summary": "Lista os clientes",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Texto buscado em nome, e-mail, telefone ou CPF",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campo de ordenação (id, nome, cpf, email)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Direção da ordenação (asc ou desc)",
+                        "name": "order",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "description": "ID da entrega",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Página (padrão 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Itens por página (padrão 20, máximo 100)",
+                        "name": "page_size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Delivery"
+                            "$ref": "#/definitions/models.Page-models_Cliente"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apierror.Response"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apierror.Response"
                         }
                     }
                 }
-            }
-        },
-        "/deliveries/{id}": {
+            },
             "put": {
-                "description": "Atualiza os dados de uma entrega existente.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Atualiza os dados de um cliente existente.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Atualiza uma entrega",
+                "summary": "Atualiza um cliente",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ID da entrega",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Dados da entrega",
-                        "name": "delivery",
+                        "description": "Dados do cliente",
+                        "name": "cliente",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Delivery"
+                            "$ref": "#/definitions/models.Cliente"
                         }
                     }
                 ],
@@ -450,42 +575,146 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/models.Cliente"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "CPF já cadastrado",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apierror.Response"
                         }
                     }
                 }
             },
-            "delete": {
-                "description": "Exclui uma entrega pelo ID.",
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Cria um novo cliente no sistema. O nome e o CPF são obrigatórios; o e-mail, se informado, deve ser válido.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Exclui uma entrega",
+                "summary": "Cria um novo cliente",
+                "parameters": [
+                    {
+                        "description": "Dados do cliente",
+                        "name": "cliente",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Cliente"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Cliente"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "CPF já cadastrado",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/clients/id/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna os detalhes de um cliente específico com base no ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Busca um cliente pelo ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID da entrega",
+                        "description": "ID do cliente",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -495,61 +724,3481 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/models.Cliente"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apierror.Response"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "models.Cliente": {
-            "type": "object",
-            "properties": {
-                "cpf": {
-                    "description": "CPF do cliente (formato: 123.456.789-00)",
-                    "type": "string"
-                },
-                "email": {
-                    "description": "Endereço de e-mail do cliente",
-                    "type": "string"
-                },
-                "id": {
-                    "description": "ID único do cliente",
-                    "type": "integer"
-                },
-                "nome": {
-                    "description": "Nome completo do cliente",
-                    "type": "string"
-                },
-                "telefone": {
-                    "description": "Número de telefone do cliente",
-                    "type": "string"
+        },
+        "/clients/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Exclui um cliente pelo ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Exclui um cliente",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do cliente",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
                 }
             }
         },
-        "models.Delivery": {
+        "/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna uma página de entregas, com filtros combináveis, ordenação e o total de entregas encontradas. Usuários com o papel driver só recebem as entregas atribuídas a eles.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Lista as entregas",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filtra pela cidade",
+                        "name": "cidade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filtra pelo estado",
+                        "name": "estado",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filtra pelo bairro",
+                        "name": "bairro",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filtra pelo status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filtra pelo cliente",
+                        "name": "cliente_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filtra pelo motorista responsável",
+                        "name": "motorista_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filtra pela zona de entrega",
+                        "name": "zona_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Apenas as entregas fora de todas as zonas (para revisão)",
+                        "name": "fora_de_zona",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Peso mínimo (kg)",
+                        "name": "peso_min",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Peso máximo (kg)",
+                        "name": "peso_max",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data de cadastro inicial (AAAA-MM-DD)",
+                        "name": "data_inicio",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data de cadastro final, inclusive (AAAA-MM-DD)",
+                        "name": "data_fim",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campo de ordenação (id, cliente_id, peso, cidade, estado, bairro, status, data_cadastro)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Direção da ordenação (asc ou desc)",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Página (padrão 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Itens por página (padrão 20, máximo 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Page-models_Delivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Cria uma nova entrega associada a um cliente. Se apenas o CEP e o número forem informados, o logradouro, o bairro, a cidade e o estado são preenchidos a partir do CEP. Se a latitude e a longitude forem omitidas, são obtidas a partir do endereço (logradouro, bairro e cidade). O estado é convertido na sigla (UF), a cidade no nome oficial do IBGE, e coordenadas fora do Brasil são rejeitadas.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Cria uma nova entrega",
+                "parameters": [
+                    {
+                        "description": "Dados da entrega",
+                        "name": "delivery",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Delivery"
+                        }
+                    },
+                    {
+                        "description": "Dados do cliente",
+                        "name": "cliente",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Cliente"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Campos inválidos (ex: delivery.peso, cliente.cpf), CEP não encontrado ou endereço não geocodificado",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/deliveries/city": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna uma lista de entregas filtradas por cidade. Usuários com o papel driver só recebem as entregas atribuídas a eles.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Busca entregas por cidade",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Nome da cidade",
+                        "name": "cidade",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Delivery"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/deliveries/id/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna os detalhes de uma entrega específica com base no ID. Usuários com o papel driver só acessam as entregas atribuídas a eles.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Busca uma entrega pelo ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da entrega",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Delivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/deliveries/nearby": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna as entregas a até radius_km do ponto informado, da mais próxima para a mais distante, com a distância calculada. Sem o parâmetro status, retorna apenas as entregas em aberto (status não final). Usuários com o papel driver só recebem as entregas atribuídas a eles.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Busca entregas próximas a um ponto",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Latitude do ponto",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Longitude do ponto",
+                        "name": "lng",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Raio da busca em km (máximo 500)",
+                        "name": "radius_km",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filtra pelo status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Quantidade máxima de entregas (padrão 50, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.NearbyDelivery"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/deliveries/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Atualiza os dados de uma entrega existente. O endereço é padronizado como no cadastro (UF, nome oficial da cidade e coordenadas no Brasil).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Atualiza uma entrega",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da entrega",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados da entrega",
+                        "name": "delivery",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Delivery"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Cliente inexistente",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Exclui uma entrega pelo ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Exclui uma entrega",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da entrega",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/deliveries/{id}/events": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna todos os eventos de rastreamento de uma entrega, do mais antigo para o mais recente. Usuários com o papel driver só acessam as entregas atribuídas a eles.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Lista o histórico de rastreamento de uma entrega",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da entrega",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.TrackingEvent"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Adiciona um evento ao histórico da entrega. Se o status for omitido, o status atual é mantido; se for diferente do atual, a transição é validada e aplicada. O responsável pelo evento é o usuário ou a chave de API autenticada. Usuários com o papel driver só acessam as entregas atribuídas a eles.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Registra um evento de rastreamento",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da entrega",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados do evento",
+                        "name": "evento",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.TrackingEvent"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.TrackingEvent"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/deliveries/{id}/status": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Move a entrega para um novo status e registra a mudança no histórico de rastreamento. Apenas transições válidas são aceitas (ex: pendente -\u003e coletada -\u003e em_rota -\u003e entregue). Usuários com o papel driver só alteram as entregas atribuídas a eles.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Altera o status de uma entrega",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da entrega",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Novo status e dados opcionais do evento (localização e observação; o responsável é o usuário autenticado)",
+                        "name": "evento",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.TrackingEvent"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Delivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/drivers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna uma página de motoristas em ordem alfabética, opcionalmente apenas os ativos ou inativos.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Lista os motoristas",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Filtra pelos motoristas ativos (true) ou inativos (false)",
+                        "name": "ativo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Página (padrão 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Itens por página (padrão 20, máximo 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Page-models_Motorista"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Cadastra um motorista. O CPF é validado e armazenado no formato 123.456.789-09; a CNH deve ter 11 dígitos e uma categoria válida (A, B, C, D, E, AB, AC, AD ou AE). Se \"ativo\" for omitido, o motorista é cadastrado como ativo. Apenas os usuários do embarcador padrão alteram a frota e as zonas, compartilhadas por todos os embarcadores.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Cadastra um motorista",
+                "parameters": [
+                    {
+                        "description": "Dados do motorista",
+                        "name": "motorista",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Motorista"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Motorista"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/drivers/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna os dados de um motorista.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Busca um motorista pelo ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do motorista",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Motorista"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Atualiza os dados de um motorista, incluindo a ativação ou desativação. Apenas os usuários do embarcador padrão alteram a frota e as zonas, compartilhadas por todos os embarcadores.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Atualiza um motorista",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do motorista",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados do motorista",
+                        "name": "motorista",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Motorista"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Motorista"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove um motorista pelo ID. As entregas atribuídas a ele ficam sem motorista. Apenas os usuários do embarcador padrão alteram a frota e as zonas, compartilhadas por todos os embarcadores.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Exclui um motorista",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do motorista",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/drivers/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna as entregas em aberto (status não final) atribuídas ao motorista, da mais antiga para a mais recente.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Lista a carga de trabalho do motorista",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do motorista",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Delivery"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/drivers/{id}/deliveries/{entregaId}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Atribui a entrega ao motorista (transferindo-a se estiver com outro) e registra a atribuição no histórico de rastreamento. O motorista deve estar ativo e a entrega não pode estar com status final.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Atribui uma entrega ao motorista",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do motorista",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID da entrega",
+                        "name": "entregaId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Delivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove a atribuição da entrega ao motorista e registra a remoção no histórico de rastreamento.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Remove uma entrega do motorista",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do motorista",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID da entrega",
+                        "name": "entregaId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Delivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/geocode/reverse": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Converte coordenadas (ex: o GPS do motorista) no endereço conhecido mais próximo, com os mesmos campos de endereço da entrega. Usa o dicionário geográfico local e, quando ele não conhece a rua, o endereço da entrega cadastrada mais próxima (até 500 m); por fim, retorna apenas o bairro ou a cidade.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Busca o endereço conhecido mais próximo de um ponto",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Latitude do ponto",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Longitude do ponto",
+                        "name": "lng",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ReverseGeocodeResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Responde 200 enquanto o servidor estiver no ar. Não exige autenticação.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Verifica a saúde do servidor",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/routes/optimize": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Calcula uma boa ordem de visita a partir do depósito (vizinho mais próximo seguido de 2-opt, com distâncias de haversine). As entregas podem ser informadas pelos IDs ou por cidade e/ou data de cadastro; no filtro, entregas com status final são ignoradas. O cálculo é feito sem serviços externos.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Otimiza a ordem de visita das entregas",
+                "parameters": [
+                    {
+                        "description": "Depósito e entregas",
+                        "name": "rota",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RouteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Route"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/routes/plan": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Distribui as entregas entre os veículos ativos respeitando a capacidade de peso de cada um (heurística de varredura para o problema de roteamento com capacidade) e calcula uma rota otimizada por veículo. As entregas são selecionadas como em /routes/optimize; sem veiculo_ids, todos os veículos ativos são considerados. Entregas que não couberem em nenhum veículo são listadas em entregas_nao_alocadas.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Planeja as rotas da frota",
+                "parameters": [
+                    {
+                        "description": "Depósito, entregas e veículos",
+                        "name": "planejamento",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.FleetPlanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.FleetPlan"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/shippers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna todos os embarcadores, em ordem de cadastro. Exige a permissão users:manage e um usuário do embarcador padrão.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Lista os embarcadores",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Embarcador"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cadastra um embarcador, cujos clientes e entregas ficam isolados dos demais. Os usuários e as chaves de API do embarcador são cadastrados em POST /users e POST /api-keys com o embarcador_id. Exige a permissão users:manage e um usuário do embarcador padrão.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Cadastra um embarcador",
+                "parameters": [
+                    {
+                        "description": "Nome do embarcador",
+                        "name": "embarcador",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Embarcador"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Embarcador"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Embarcador já cadastrado",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/track/{code}": {
+            "get": {
+                "description": "Retorna o status, a cidade de destino e as mudanças de status de uma entrega a partir do código de rastreio, sem expor dados pessoais do cliente. Cada mudança traz uma descrição fixa do status; as observações internas e os responsáveis pelos eventos não são publicados.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Consulta pública de rastreio",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Código de rastreio (ex: ENF85JJ3CX4PER8BR)",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TrackingView"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cadastra um usuário da API (exige a permissão users:manage). A senha deve ter entre 8 e 72 caracteres e é guardada apenas como hash bcrypt.\nO papel (admin, dispatcher, driver ou viewer, o padrão) define as permissões do usuário; o papel driver exige o motorista_id do motorista cujas entregas o usuário pode acessar.\nO embarcador_id define o embarcador cujos clientes e entregas o usuário acessa (padrão: o embarcador de quem faz o cadastro).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Cadastra um usuário",
+                "parameters": [
+                    {
+                        "description": "Nome, e-mail, senha, papel, motorista e embarcador do usuário",
+                        "name": "usuario",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Usuario"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Usuario"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "E-mail já cadastrado",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/vehicles": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna uma página de veículos da frota, opcionalmente apenas os ativos ou inativos.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Lista os veículos",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Filtra pelos veículos ativos (true) ou inativos (false)",
+                        "name": "ativo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Página (padrão 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Itens por página (padrão 20, máximo 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Page-models_Vehicle"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Cadastra um veículo na frota. A placa é aceita com ou sem hífen, no padrão antigo ou Mercosul. Se \"ativo\" for omitido, o veículo é cadastrado como ativo. Apenas os usuários do embarcador padrão alteram a frota e as zonas, compartilhadas por todos os embarcadores.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Cadastra um veículo",
+                "parameters": [
+                    {
+                        "description": "Dados do veículo",
+                        "name": "veiculo",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Vehicle"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Vehicle"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/vehicles/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna os dados de um veículo da frota.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Busca um veículo pelo ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do veículo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Vehicle"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Atualiza os dados de um veículo da frota, incluindo a ativação ou desativação. Apenas os usuários do embarcador padrão alteram a frota e as zonas, compartilhadas por todos os embarcadores.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Atualiza um veículo",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do veículo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados do veículo",
+                        "name": "veiculo",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Vehicle"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Vehicle"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove um veículo da frota pelo ID. Apenas os usuários do embarcador padrão alteram a frota e as zonas, compartilhadas por todos os embarcadores.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Exclui um veículo",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do veículo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/zones": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna todas as zonas de entrega, em ordem de cadastro.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Lista as zonas de entrega",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Zona"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Cadastra uma zona operacional delimitada por um polígono GeoJSON (posições [longitude, latitude]; anéis adicionais são buracos). As entregas dentro do polígono passam a pertencer à zona; se zonas se sobrepuserem, vale a cadastrada primeiro. Apenas os usuários do embarcador padrão alteram a frota e as zonas, compartilhadas por todos os embarcadores.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Cadastra uma zona de entrega",
+                "parameters": [
+                    {
+                        "description": "Dados da zona",
+                        "name": "zona",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Zona"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Zona"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/zones/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna os dados e o polígono de uma zona de entrega.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Busca uma zona pelo ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da zona",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Zona"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Atualiza o nome e o polígono de uma zona. As entregas da área antiga e da nova são reatribuídas às zonas que as contêm. Apenas os usuários do embarcador padrão alteram a frota e as zonas, compartilhadas por todos os embarcadores.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Atualiza uma zona de entrega",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da zona",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados da zona",
+                        "name": "zona",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Zona"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Zona"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove uma zona pelo ID. As suas entregas passam para outra zona que as contenha ou ficam marcadas como fora de zona. Apenas os usuários do embarcador padrão alteram a frota e as zonas, compartilhadas por todos os embarcadores.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Exclui uma zona de entrega",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da zona",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/zones/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna uma página das entregas cujas coordenadas estão dentro da zona. Para as entregas fora de todas as zonas, use GET /deliveries?fora_de_zona=true.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Lista as entregas de uma zona",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da zona",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Página (padrão 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Itens por página (padrão 20, máximo 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Page-models_Delivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apierror.Response"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "apierror.Body": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Código do erro (ex: not_found)",
+                    "type": "string"
+                },
+                "detail": {
+                    "description": "Detalhe do erro em português (ex: a transição rejeitada); ausente nos erros 5xx",
+                    "type": "string"
+                },
+                "fields": {
+                    "description": "Campos inválidos (apenas no código validation_failed)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/validation.FieldError"
+                    }
+                },
+                "message": {
+                    "description": "Mensagem no idioma da requisição",
+                    "type": "string"
+                },
+                "request_id": {
+                    "description": "ID da requisição (também no cabeçalho X-Request-ID)",
+                    "type": "string"
+                }
+            }
+        },
+        "apierror.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "description": "Dados do erro",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/apierror.Body"
+                        }
+                    ]
+                }
+            }
+        },
+        "models.Alteracao": {
+            "type": "object",
+            "properties": {
+                "antes": {
+                    "description": "Valor antes da operação"
+                },
+                "depois": {
+                    "description": "Valor depois da operação"
+                }
+            }
+        },
+        "models.CEPAddress": {
+            "type": "object",
+            "properties": {
+                "bairro": {
+                    "description": "Bairro do endereço",
+                    "type": "string"
+                },
+                "cep": {
+                    "description": "CEP consultado, no formato 01310-100",
+                    "type": "string"
+                },
+                "cidade": {
+                    "description": "Cidade do endereço",
+                    "type": "string"
+                },
+                "estado": {
+                    "description": "Estado (UF) do endereço",
+                    "type": "string"
+                },
+                "logradouro": {
+                    "description": "Nome da rua, avenida, etc. (vazio para CEPs gerais de cidade)",
+                    "type": "string"
+                },
+                "pais": {
+                    "description": "País do endereço",
+                    "type": "string"
+                }
+            }
+        },
+        "models.ChaveAPI": {
+            "type": "object",
+            "properties": {
+                "data_cadastro": {
+                    "description": "Data e hora do cadastro da chave",
+                    "type": "string"
+                },
+                "embarcador_id": {
+                    "description": "ID do embarcador cujos clientes e entregas a chave acessa",
+                    "type": "integer"
+                },
+                "expira_em": {
+                    "description": "Data e hora de expiração (nil se a chave não expirar)",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID único da chave",
+                    "type": "integer"
+                },
+                "nome": {
+                    "description": "Nome da integração que usa a chave",
+                    "type": "string"
+                },
+                "permissoes": {
+                    "description": "Permissões concedidas à chave (ex: [\"deliveries:write\"])",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "prefixo": {
+                    "description": "Início da chave (ex: \"wg_1a2b3c4d\"), que a identifica sem revelá-la",
+                    "type": "string"
+                },
+                "ultimo_uso": {
+                    "description": "Data e hora do último uso (nil se a chave nunca foi usada)",
+                    "type": "string"
+                },
+                "usuario_id": {
+                    "description": "ID do usuário que cadastrou a chave",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ChaveAPICriada": {
+            "type": "object",
+            "properties": {
+                "chave": {
+                    "description": "Chave completa, enviada no cabeçalho X-API-Key (não pode ser consultada depois)",
+                    "type": "string"
+                },
+                "data_cadastro": {
+                    "description": "Data e hora do cadastro da chave",
+                    "type": "string"
+                },
+                "embarcador_id": {
+                    "description": "ID do embarcador cujos clientes e entregas a chave acessa",
+                    "type": "integer"
+                },
+                "expira_em": {
+                    "description": "Data e hora de expiração (nil se a chave não expirar)",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID único da chave",
+                    "type": "integer"
+                },
+                "nome": {
+                    "description": "Nome da integração que usa a chave",
+                    "type": "string"
+                },
+                "permissoes": {
+                    "description": "Permissões concedidas à chave (ex: [\"deliveries:write\"])",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "prefixo": {
+                    "description": "Início da chave (ex: \"wg_1a2b3c4d\"), que a identifica sem revelá-la",
+                    "type": "string"
+                },
+                "ultimo_uso": {
+                    "description": "Data e hora do último uso (nil se a chave nunca foi usada)",
+                    "type": "string"
+                },
+                "usuario_id": {
+                    "description": "ID do usuário que cadastrou a chave",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Cliente": {
+            "type": "object",
+            "properties": {
+                "cpf": {
+                    "description": "CPF do cliente (formato: 123.456.789-00), único por embarcador",
+                    "type": "string"
+                },
+                "email": {
+                    "description": "Endereço de e-mail do cliente",
+                    "type": "string"
+                },
+                "embarcador_id": {
+                    "description": "ID do embarcador ao qual o cliente pertence",
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "ID único do cliente",
+                    "type": "integer"
+                },
+                "nome": {
+                    "description": "Nome completo do cliente",
+                    "type": "string"
+                },
+                "telefone": {
+                    "description": "Número de telefone do cliente",
+                    "type": "string"
+                }
+            }
+        },
+        "models.Delivery": {
+            "type": "object",
+            "properties": {
+                "bairro": {
+                    "description": "Bairro do endereço",
+                    "type": "string"
+                },
+                "cep": {
+                    "description": "CEP do endereço, no formato 01310-100",
+                    "type": "string"
+                },
+                "cidade": {
+                    "description": "Cidade do endereço",
+                    "type": "string"
+                },
+                "cliente_id": {
+                    "description": "ID do cliente associado à entrega",
+                    "type": "integer"
+                },
+                "codigo_rastreio": {
+                    "description": "Código público de rastreio (ex: ENF85JJ3CX4PER8BR)",
+                    "type": "string"
+                },
+                "complemento": {
+                    "description": "Complemento do endereço (ex: apartamento, bloco)",
+                    "type": "string"
+                },
+                "data_cadastro": {
+                    "description": "Data e hora do cadastro da entrega",
+                    "type": "string"
+                },
+                "embarcador_id": {
+                    "description": "ID do embarcador da entrega (o mesmo do cliente)",
+                    "type": "integer"
+                },
+                "endereco": {
+                    "description": "Endereço completo da entrega",
+                    "type": "string"
+                },
+                "estado": {
+                    "description": "Estado (UF) do endereço",
+                    "type": "string"
+                },
+                "fora_de_zona": {
+                    "description": "Indica que a entrega está fora de todas as zonas e precisa de revisão",
+                    "type": "boolean"
+                },
+                "id": {
+                    "description": "ID único da entrega",
+                    "type": "integer"
+                },
+                "latitude": {
+                    "description": "Latitude da localização da entrega",
+                    "type": "number"
+                },
+                "logradouro": {
+                    "description": "Nome da rua, avenida, etc.",
+                    "type": "string"
+                },
+                "longitude": {
+                    "description": "Longitude da localização da entrega",
+                    "type": "number"
+                },
+                "motorista_id": {
+                    "description": "ID do motorista responsável (nil se a entrega não foi atribuída)",
+                    "type": "integer"
+                },
+                "numero": {
+                    "description": "Número do endereço",
+                    "type": "string"
+                },
+                "pais": {
+                    "description": "País do endereço",
+                    "type": "string"
+                },
+                "peso": {
+                    "description": "Peso da entrega (em kg)",
+                    "type": "number"
+                },
+                "status": {
+                    "description": "Status atual da entrega (ex: pendente, em_rota, entregue)",
+                    "type": "string"
+                },
+                "ultimo_evento": {
+                    "description": "Evento de rastreamento mais recente (preenchido apenas na busca por ID)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TrackingEvent"
+                        }
+                    ]
+                },
+                "zona_id": {
+                    "description": "ID da zona que contém as coordenadas da entrega (nil se estiver fora de todas)",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Embarcador": {
+            "type": "object",
+            "properties": {
+                "data_cadastro": {
+                    "description": "Data e hora do cadastro do embarcador",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID único do embarcador",
+                    "type": "integer"
+                },
+                "nome": {
+                    "description": "Nome do embarcador (único)",
+                    "type": "string"
+                }
+            }
+        },
+        "models.FleetPlan": {
+            "type": "object",
+            "properties": {
+                "distancia_total_km": {
+                    "description": "Soma das distâncias de todas as rotas, em km",
+                    "type": "number"
+                },
+                "entregas_nao_alocadas": {
+                    "description": "IDs das entregas que excedem a capacidade disponível",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "rotas": {
+                    "description": "Rotas dos veículos utilizados",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.VehicleRoute"
+                    }
+                }
+            }
+        },
+        "models.FleetPlanRequest": {
+            "type": "object",
+            "properties": {
+                "cidade": {
+                    "description": "Filtra as entregas pela cidade (quando não há IDs)",
+                    "type": "string"
+                },
+                "data": {
+                    "description": "Filtra as entregas pela data de cadastro (AAAA-MM-DD)",
+                    "type": "string"
+                },
+                "deposito_latitude": {
+                    "description": "Latitude do depósito (ponto de partida)",
+                    "type": "number"
+                },
+                "deposito_longitude": {
+                    "description": "Longitude do depósito (ponto de partida)",
+                    "type": "number"
+                },
+                "entrega_ids": {
+                    "description": "IDs das entregas a visitar",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "retornar_ao_deposito": {
+                    "description": "Inclui a volta ao depósito no fim da rota",
+                    "type": "boolean"
+                },
+                "veiculo_ids": {
+                    "description": "IDs dos veículos disponíveis",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "models.GeoJSONPolygon": {
+            "type": "object",
+            "properties": {
+                "coordinates": {
+                    "description": "Anéis do polígono, com posições [longitude, latitude]",
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "array",
+                            "items": {
+                                "type": "number"
+                            }
+                        }
+                    }
+                },
+                "type": {
+                    "description": "Tipo da geometria (sempre \"Polygon\")",
+                    "type": "string",
+                    "example": "Polygon"
+                }
+            }
+        },
+        "models.LoginRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "description": "E-mail do usuário",
+                    "type": "string"
+                },
+                "senha": {
+                    "description": "Senha do usuário",
+                    "type": "string"
+                }
+            }
+        },
+        "models.Motorista": {
+            "type": "object",
+            "properties": {
+                "ativo": {
+                    "description": "Indica se o motorista pode receber entregas",
+                    "type": "boolean"
+                },
+                "cnh_categoria": {
+                    "description": "Categoria da CNH (ex: B, D, AB)",
+                    "type": "string"
+                },
+                "cnh_numero": {
+                    "description": "Número da CNH (11 dígitos)",
+                    "type": "string"
+                },
+                "cpf": {
+                    "description": "CPF do motorista (formato: 123.456.789-00)",
+                    "type": "string"
+                },
+                "data_cadastro": {
+                    "description": "Data e hora do cadastro do motorista",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID único do motorista",
+                    "type": "integer"
+                },
+                "nome": {
+                    "description": "Nome completo do motorista",
+                    "type": "string"
+                },
+                "telefone": {
+                    "description": "Número de telefone do motorista",
+                    "type": "string"
+                }
+            }
+        },
+        "models.NearbyDelivery": {
+            "type": "object",
+            "properties": {
+                "bairro": {
+                    "description": "Bairro do endereço",
+                    "type": "string"
+                },
+                "cep": {
+                    "description": "CEP do endereço, no formato 01310-100",
+                    "type": "string"
+                },
+                "cidade": {
+                    "description": "Cidade do endereço",
+                    "type": "string"
+                },
+                "cliente_id": {
+                    "description": "ID do cliente associado à entrega",
+                    "type": "integer"
+                },
+                "codigo_rastreio": {
+                    "description": "Código público de rastreio (ex: ENF85JJ3CX4PER8BR)",
+                    "type": "string"
+                },
+                "complemento": {
+                    "description": "Complemento do endereço (ex: apartamento, bloco)",
+                    "type": "string"
+                },
+                "data_cadastro": {
+                    "description": "Data e hora do cadastro da entrega",
+                    "type": "string"
+                },
+                "distancia_km": {
+                    "description": "Distância em linha reta até o ponto buscado, em km",
+                    "type": "number"
+                },
+                "embarcador_id": {
+                    "description": "ID do embarcador da entrega (o mesmo do cliente)",
+                    "type": "integer"
+                },
+                "endereco": {
+                    "description": "Endereço completo da entrega",
+                    "type": "string"
+                },
+                "estado": {
+                    "description": "Estado (UF) do endereço",
+                    "type": "string"
+                },
+                "fora_de_zona": {
+                    "description": "Indica que a entrega está fora de todas as zonas e precisa de revisão",
+                    "type": "boolean"
+                },
+                "id": {
+                    "description": "ID único da entrega",
+                    "type": "integer"
+                },
+                "latitude": {
+                    "description": "Latitude da localização da entrega",
+                    "type": "number"
+                },
+                "logradouro": {
+                    "description": "Nome da rua, avenida, etc.",
+                    "type": "string"
+                },
+                "longitude": {
+                    "description": "Longitude da localização da entrega",
+                    "type": "number"
+                },
+                "motorista_id": {
+                    "description": "ID do motorista responsável (nil se a entrega não foi atribuída)",
+                    "type": "integer"
+                },
+                "numero": {
+                    "description": "Número do endereço",
+                    "type": "string"
+                },
+                "pais": {
+                    "description": "País do endereço",
+                    "type": "string"
+                },
+                "peso": {
+                    "description": "Peso da entrega (em kg)",
+                    "type": "number"
+                },
+                "status": {
+                    "description": "Status atual da entrega (ex: pendente, em_rota, entregue)",
+                    "type": "string"
+                },
+                "ultimo_evento": {
+                    "description": "Evento de rastreamento mais recente (preenchido apenas na busca por ID)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TrackingEvent"
+                        }
+                    ]
+                },
+                "zona_id": {
+                    "description": "ID da zona que contém as coordenadas da entrega (nil se estiver fora de todas)",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Page-models_Cliente": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "description": "Itens da página atual",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Cliente"
+                    }
+                },
+                "page": {
+                    "description": "Número da página atual (começando em 1)",
+                    "type": "integer"
+                },
+                "page_size": {
+                    "description": "Quantidade de itens por página",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "Total de itens que atendem aos filtros",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "Total de páginas disponíveis",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Page-models_Delivery": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "description": "Itens da página atual",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Delivery"
+                    }
+                },
+                "page": {
+                    "description": "Número da página atual (começando em 1)",
+                    "type": "integer"
+                },
+                "page_size": {
+                    "description": "Quantidade de itens por página",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "Total de itens que atendem aos filtros",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "Total de páginas disponíveis",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Page-models_Motorista": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "description": "Itens da página atual",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Motorista"
+                    }
+                },
+                "page": {
+                    "description": "Número da página atual (começando em 1)",
+                    "type": "integer"
+                },
+                "page_size": {
+                    "description": "Quantidade de itens por página",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "Total de itens que atendem aos filtros",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "Total de páginas disponíveis",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Page-models_RegistroAuditoria": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "description": "Itens da página atual",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RegistroAuditoria"
+                    }
+                },
+                "page": {
+                    "description": "Número da página atual (começando em 1)",
+                    "type": "integer"
+                },
+                "page_size": {
+                    "description": "Quantidade de itens por página",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "Total de itens que atendem aos filtros",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "Total de páginas disponíveis",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Page-models_Vehicle": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "description": "Itens da página atual",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Vehicle"
+                    }
+                },
+                "page": {
+                    "description": "Número da página atual (começando em 1)",
+                    "type": "integer"
+                },
+                "page_size": {
+                    "description": "Quantidade de itens por página",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "Total de itens que atendem aos filtros",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "description": "Total de páginas disponíveis",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.RefreshRequest": {
+            "type": "object",
+            "properties": {
+                "refresh_token": {
+                    "description": "Token de renovação recebido no login",
+                    "type": "string"
+                }
+            }
+        },
+        "models.RegistroAuditoria": {
+            "type": "object",
+            "properties": {
+                "acao": {
+                    "description": "Ação realizada (create, update ou delete)",
+                    "type": "string"
+                },
+                "alteracoes": {
+                    "description": "Campos alterados, com os valores antes e depois da operação",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/models.Alteracao"
+                    }
+                },
+                "ator": {
+                    "description": "Quem fez a operação: o e-mail do usuário, o prefixo da chave de API ou \"sistema\"",
+                    "type": "string"
+                },
+                "data_hora": {
+                    "description": "Data e hora da operação",
+                    "type": "string"
+                },
+                "embarcador_id": {
+                    "description": "ID do embarcador do registro auditado",
+                    "type": "integer"
+                },
+                "entidade": {
+                    "description": "Entidade alterada (delivery ou client)",
+                    "type": "string"
+                },
+                "entidade_id": {
+                    "description": "ID do registro alterado",
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "ID único do registro",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ReverseGeocodeResult": {
             "type": "object",
             "properties": {
                 "bairro": {
@@ -560,52 +4209,394 @@ const docTemplate = `{
                     "description": "Cidade do endereço",
                     "type": "string"
                 },
-                "cliente_id": {
-                    "description": "ID do cliente associado à entrega",
-                    "type": "integer"
+                "distancia_km": {
+                    "description": "Distância entre o ponto consultado e o endereço encontrado",
+                    "type": "number"
                 },
-                "complemento": {
-                    "description": "Complemento do endereço (ex: apartamento, bloco)",
+                "estado": {
+                    "description": "Estado (UF) do endereço",
+                    "type": "string"
+                },
+                "fonte": {
+                    "description": "Origem do endereço (dicionario ou entrega)",
+                    "type": "string"
+                },
+                "latitude": {
+                    "description": "Latitude do endereço encontrado",
+                    "type": "number"
+                },
+                "logradouro": {
+                    "description": "Nome da rua, avenida, etc. (vazio se apenas o bairro ou a cidade forem conhecidos)",
+                    "type": "string"
+                },
+                "longitude": {
+                    "description": "Longitude do endereço encontrado",
+                    "type": "number"
+                },
+                "pais": {
+                    "description": "País do endereço",
+                    "type": "string"
+                },
+                "precisao": {
+                    "description": "Nível do endereço encontrado (logradouro, bairro ou cidade)",
+                    "type": "string"
+                }
+            }
+        },
+        "models.Route": {
+            "type": "object",
+            "properties": {
+                "distancia_total_km": {
+                    "description": "Distância total percorrida, em km",
+                    "type": "number"
+                },
+                "paradas": {
+                    "description": "Paradas na ordem de visita",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RouteStop"
+                    }
+                },
+                "retorno_km": {
+                    "description": "Distância da última parada até o depósito (0 se não houver retorno)",
+                    "type": "number"
+                }
+            }
+        },
+        "models.RouteRequest": {
+            "type": "object",
+            "properties": {
+                "cidade": {
+                    "description": "Filtra as entregas pela cidade (quando não há IDs)",
+                    "type": "string"
+                },
+                "data": {
+                    "description": "Filtra as entregas pela data de cadastro (AAAA-MM-DD)",
+                    "type": "string"
+                },
+                "deposito_latitude": {
+                    "description": "Latitude do depósito (ponto de partida)",
+                    "type": "number"
+                },
+                "deposito_longitude": {
+                    "description": "Longitude do depósito (ponto de partida)",
+                    "type": "number"
+                },
+                "entrega_ids": {
+                    "description": "IDs das entregas a visitar",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "retornar_ao_deposito": {
+                    "description": "Inclui a volta ao depósito no fim da rota",
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.RouteStop": {
+            "type": "object",
+            "properties": {
+                "cidade": {
+                    "description": "Cidade da entrega",
+                    "type": "string"
+                },
+                "codigo_rastreio": {
+                    "description": "Código de rastreio da entrega",
                     "type": "string"
                 },
+                "distancia_km": {
+                    "description": "Distância desde a parada anterior (ou desde o depósito), em km",
+                    "type": "number"
+                },
                 "endereco": {
                     "description": "Endereço completo da entrega",
                     "type": "string"
                 },
-                "estado": {
-                    "description": "Estado (UF) do endereço",
+                "entrega_id": {
+                    "description": "ID da entrega",
+                    "type": "integer"
+                },
+                "latitude": {
+                    "description": "Latitude da entrega",
+                    "type": "number"
+                },
+                "longitude": {
+                    "description": "Longitude da entrega",
+                    "type": "number"
+                },
+                "ordem": {
+                    "description": "Posição da parada na rota (começando em 1)",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.TokenPair": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "description": "Token de acesso, enviado no cabeçalho \"Authorization: Bearer \u003ctoken\u003e\"",
+                    "type": "string"
+                },
+                "expires_in": {
+                    "description": "Validade do token de acesso, em segundos",
+                    "type": "integer"
+                },
+                "refresh_token": {
+                    "description": "Token de renovação, trocado por um novo par em /auth/refresh",
+                    "type": "string"
+                },
+                "token_type": {
+                    "description": "Tipo do token (sempre \"Bearer\")",
                     "type": "string"
+                }
+            }
+        },
+        "models.TrackingEvent": {
+            "type": "object",
+            "properties": {
+                "data_hora": {
+                    "description": "Data e hora em que o evento ocorreu",
+                    "type": "string"
+                },
+                "entrega_id": {
+                    "description": "ID da entrega associada ao evento",
+                    "type": "integer"
                 },
                 "id": {
-                    "description": "ID único da entrega",
+                    "description": "ID único do evento",
                     "type": "integer"
                 },
                 "latitude": {
-                    "description": "Latitude da localização da entrega",
+                    "description": "Latitude onde o evento ocorreu (opcional)",
                     "type": "number"
                 },
-                "logradouro": {
-                    "description": "Nome da rua, avenida, etc.",
+                "longitude": {
+                    "description": "Longitude onde o evento ocorreu (opcional)",
+                    "type": "number"
+                },
+                "observacao": {
+                    "description": "Observação livre sobre o evento",
                     "type": "string"
                 },
-                "longitude": {
-                    "description": "Longitude da localização da entrega",
+                "responsavel": {
+                    "description": "Quem registrou o evento (o e-mail do usuário ou o prefixo da chave de API), definido pelo servidor",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Status da entrega no momento do evento",
+                    "type": "string"
+                }
+            }
+        },
+        "models.TrackingView": {
+            "type": "object",
+            "properties": {
+                "cidade": {
+                    "description": "Cidade de destino",
+                    "type": "string"
+                },
+                "codigo_rastreio": {
+                    "description": "Código de rastreio da entrega",
+                    "type": "string"
+                },
+                "estado": {
+                    "description": "Estado (UF) de destino",
+                    "type": "string"
+                },
+                "eventos": {
+                    "description": "Histórico de rastreamento em ordem cronológica",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TrackingViewEvent"
+                    }
+                },
+                "status": {
+                    "description": "Status atual da entrega",
+                    "type": "string"
+                }
+            }
+        },
+        "models.TrackingViewEvent": {
+            "type": "object",
+            "properties": {
+                "data_hora": {
+                    "description": "Data e hora da mudança de status",
+                    "type": "string"
+                },
+                "descricao": {
+                    "description": "Descrição do status para o destinatário",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Novo status da entrega",
+                    "type": "string"
+                }
+            }
+        },
+        "models.Usuario": {
+            "type": "object",
+            "properties": {
+                "ativo": {
+                    "description": "Usuários inativos não conseguem entrar nem renovar os tokens",
+                    "type": "boolean"
+                },
+                "data_cadastro": {
+                    "description": "Data e hora do cadastro do usuário",
+                    "type": "string"
+                },
+                "email": {
+                    "description": "E-mail do usuário, usado no login",
+                    "type": "string"
+                },
+                "embarcador_id": {
+                    "description": "ID do embarcador cujos clientes e entregas o usuário acessa",
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "ID único do usuário",
+                    "type": "integer"
+                },
+                "motorista_id": {
+                    "description": "ID do motorista vinculado (obrigatório no papel driver, nil nos demais)",
+                    "type": "integer"
+                },
+                "nome": {
+                    "description": "Nome do usuário",
+                    "type": "string"
+                },
+                "papel": {
+                    "description": "Papel do usuário: admin, dispatcher, driver ou viewer (padrão)",
+                    "type": "string"
+                },
+                "senha": {
+                    "description": "Senha em texto puro, informada apenas no cadastro (nunca armazenada nem retornada)",
+                    "type": "string"
+                }
+            }
+        },
+        "models.Vehicle": {
+            "type": "object",
+            "properties": {
+                "ativo": {
+                    "description": "Indica se o veículo está disponível para rotas",
+                    "type": "boolean"
+                },
+                "capacidade_peso": {
+                    "description": "Peso máximo transportado (em kg)",
                     "type": "number"
                 },
-                "numero": {
-                    "description": "Número do endereço",
+                "capacidade_volume": {
+                    "description": "Volume máximo transportado (em m³)",
+                    "type": "number"
+                },
+                "data_cadastro": {
+                    "description": "Data e hora do cadastro do veículo",
                     "type": "string"
                 },
-                "pais": {
-                    "description": "País do endereço",
+                "id": {
+                    "description": "ID único do veículo",
+                    "type": "integer"
+                },
+                "placa": {
+                    "description": "Placa do veículo, sem hífen (ex: ABC1D23 ou ABC1234)",
                     "type": "string"
                 },
-                "peso": {
-                    "description": "Peso da entrega (em kg)",
+                "tipo": {
+                    "description": "Tipo do veículo (moto, carro, van ou caminhao)",
+                    "type": "string"
+                }
+            }
+        },
+        "models.VehicleRoute": {
+            "type": "object",
+            "properties": {
+                "distancia_total_km": {
+                    "description": "Distância total percorrida, em km",
+                    "type": "number"
+                },
+                "paradas": {
+                    "description": "Paradas na ordem de visita",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RouteStop"
+                    }
+                },
+                "peso_total_kg": {
+                    "description": "Soma do peso das entregas da rota",
+                    "type": "number"
+                },
+                "retorno_km": {
+                    "description": "Distância da última parada até o depósito (0 se não houver retorno)",
                     "type": "number"
+                },
+                "veiculo": {
+                    "description": "Veículo que fará a rota",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Vehicle"
+                        }
+                    ]
+                }
+            }
+        },
+        "models.Zona": {
+            "type": "object",
+            "properties": {
+                "data_cadastro": {
+                    "description": "Data e hora do cadastro da zona",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID único da zona",
+                    "type": "integer"
+                },
+                "nome": {
+                    "description": "Nome da zona (único)",
+                    "type": "string"
+                },
+                "poligono": {
+                    "description": "Área da zona, em GeoJSON",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.GeoJSONPolygon"
+                        }
+                    ]
+                }
+            }
+        },
+        "validation.FieldError": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Código do erro (ex: required, invalid_format)",
+                    "type": "string"
+                },
+                "field": {
+                    "description": "Caminho do campo no corpo da requisição (ex: delivery.peso)",
+                    "type": "string"
+                },
+                "message": {
+                    "description": "Mensagem legível do erro",
+                    "type": "string"
                 }
             }
         }
+    },
+    "securityDefinitions": {
+        "ApiKeyAuth": {
+            "description": "Chave de API de uma integração, cadastrada em POST /api-keys",
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        },
+        "BearerAuth": {
+            "description": "Token de acesso obtido em POST /auth/login, no formato \"Bearer \u003ctoken\u003e\"",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        }
     }
 }`
 
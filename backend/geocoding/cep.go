@@ -0,0 +1,147 @@
+package geocoding
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"meu-projeto/backend/utils"
+)
+
+// defaultCEPTable é a tabela de CEPs embutida no binário, com alguns CEPs de exemplo.
+//
+//go:embed data/ceps.csv
+var defaultCEPTable string
+
+// CEPProvider é implementado pelos provedores de consulta de CEP. O CEP é informado com 8 dígitos,
+// com ou sem hífen. LookupCEP retorna ErrNotFound (possivelmente encapsulado) quando o CEP não existe.
+type CEPProvider interface {
+	LookupCEP(cep string) (*Address, error)
+}
+
+// CEPTable é um CEPProvider offline que consulta uma tabela local de CEPs.
+type CEPTable struct {
+	addresses map[string]Address // Endereços indexados pelo CEP (apenas dígitos)
+}
+
+// NewCEPTable lê uma tabela de CEPs em CSV com o cabeçalho "cep,logradouro,bairro,cidade,estado".
+func NewCEPTable(r io.Reader) (*CEPTable, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 5
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("tabela de CEPs inválida: %w", err)
+	}
+
+	table := &CEPTable{addresses: make(map[string]Address)}
+	for i, record := range records {
+		if i == 0 {
+			continue // Ignora o cabeçalho
+		}
+		if !utils.ValidateCEP(record[0]) || record[3] == "" {
+			return nil, fmt.Errorf("tabela de CEPs inválida: linha %d", i+1)
+		}
+		table.addresses[utils.OnlyDigits(record[0])] = Address{Logradouro: record[1], Bairro: record[2], Cidade: record[3], Estado: record[4], Pais: "Brasil"}
+	}
+	return table, nil
+}
+
+// LoadCEPTable lê a tabela de CEPs do arquivo informado.
+func LoadCEPTable(path string) (*CEPTable, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return NewCEPTable(file)
+}
+
+// DefaultCEPTable retorna a tabela de CEPs embutida no binário.
+func DefaultCEPTable() *CEPTable {
+	table, err := NewCEPTable(strings.NewReader(defaultCEPTable))
+	if err != nil {
+		panic(err) // O arquivo embutido é validado pelos testes
+	}
+	return table
+}
+
+// LookupCEP implementa CEPProvider.
+func (t *CEPTable) LookupCEP(cep string) (*Address, error) {
+	address, ok := t.addresses[utils.OnlyDigits(cep)]
+	if !ok {
+		return nil, fmt.Errorf("%w: CEP %s", ErrNotFound, cep)
+	}
+	return &address, nil
+}
+
+// HTTPCEPProvider é um CEPProvider que consulta um serviço HTTP compatível com o ViaCEP:
+// GET {BaseURL}/ws/{cep}/json/.
+type HTTPCEPProvider struct {
+	BaseURL string       // URL base do serviço (ex: https://viacep.com.br)
+	Client  *http.Client // Cliente HTTP (opcional; padrão com timeout de 5 segundos)
+}
+
+// viaCEPResponse é o trecho da resposta do ViaCEP usado pelo adaptador.
+type viaCEPResponse struct {
+	Logradouro string `json:"logradouro"` // Logradouro
+	Bairro     string `json:"bairro"`     // Bairro
+	Localidade string `json:"localidade"` // Cidade
+	UF         string `json:"uf"`         // Estado
+	Erro       any    `json:"erro"`       // Presente (true ou "true") quando o CEP não existe
+}
+
+// LookupCEP implementa CEPProvider.
+func (p *HTTPCEPProvider) LookupCEP(cep string) (*Address, error) {
+	client := p.Client
+	if client == nil {
+		client = &http.Client{Timeout: defaultHTTPTimeout}
+	}
+
+	// Executa a requisição
+	response, err := client.Get(strings.TrimRight(p.BaseURL, "/") + "/ws/" + utils.OnlyDigits(cep) + "/json/")
+	if err != nil {
+		return nil, fmt.Errorf("serviço de CEP indisponível: %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("serviço de CEP respondeu com status %d", response.StatusCode)
+	}
+
+	// Decodifica o endereço retornado
+	var body viaCEPResponse
+	if err := json.NewDecoder(response.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("resposta inválida do serviço de CEP: %w", err)
+	}
+	if body.Erro != nil || body.Localidade == "" {
+		return nil, fmt.Errorf("%w: CEP %s", ErrNotFound, cep)
+	}
+	return &Address{Logradouro: body.Logradouro, Bairro: body.Bairro, Cidade: body.Localidade, Estado: body.UF, Pais: "Brasil"}, nil
+}
+
+// CEPChain consulta os provedores de CEP em ordem e retorna o primeiro endereço encontrado,
+// com a mesma regra de erros de Chain.
+type CEPChain []CEPProvider
+
+// LookupCEP implementa CEPProvider.
+func (c CEPChain) LookupCEP(cep string) (*Address, error) {
+	var lastErr error
+	for _, provider := range c {
+		address, err := provider.LookupCEP(cep)
+		if err == nil {
+			return address, nil
+		}
+		if !errors.Is(err, ErrNotFound) {
+			lastErr = err // Provedor indisponível: tenta o próximo, mas guarda o erro
+		}
+	}
+	if lastErr != nil {
+		return nil, lastErr
+	}
+	return nil, ErrNotFound
+}
@@ -0,0 +1,50 @@
+package geocoding
+
+import "os"
+
+// GazetteerFromEnv carrega o dicionário geográfico do arquivo CSV indicado pela variável GEOCODER_GAZETTEER
+// ou, se ela não estiver definida, o dicionário embutido no binário.
+func GazetteerFromEnv() (*Gazetteer, error) {
+	if path := os.Getenv("GEOCODER_GAZETTEER"); path != "" {
+		return LoadGazetteer(path)
+	}
+	return DefaultGazetteer(), nil
+}
+
+// FromEnv monta o Geocoder configurado pelas variáveis de ambiente, usando o dicionário offline informado:
+//
+//   - GEOCODER_URL: URL base de um provedor HTTP compatível com o Nominatim. Se definida, o provedor é
+//     consultado primeiro e o dicionário offline é usado quando ele não encontra o endereço ou está indisponível;
+//   - GEOCODER_USER_AGENT: identificação enviada ao provedor HTTP.
+func FromEnv(gazetteer *Gazetteer) Geocoder {
+	baseURL := os.Getenv("GEOCODER_URL")
+	if baseURL == "" {
+		return gazetteer
+	}
+	userAgent := os.Getenv("GEOCODER_USER_AGENT")
+	if userAgent == "" {
+		userAgent = "gerenciamento-de-entregas"
+	}
+	return Chain{&HTTPGeocoder{BaseURL: baseURL, UserAgent: userAgent}, gazetteer}
+}
+
+// CEPProviderFromEnv monta o CEPProvider configurado pelas variáveis de ambiente:
+//
+//   - CEP_TABLE: arquivo CSV da tabela local de CEPs (padrão: a embutida no binário);
+//   - CEP_URL: URL base de um serviço HTTP compatível com o ViaCEP. Se definida, o serviço é
+//     consultado primeiro e a tabela local é usada quando ele não encontra o CEP ou está indisponível.
+func CEPProviderFromEnv() (CEPProvider, error) {
+	table := DefaultCEPTable()
+	if path := os.Getenv("CEP_TABLE"); path != "" {
+		var err error
+		if table, err = LoadCEPTable(path); err != nil {
+			return nil, err
+		}
+	}
+
+	baseURL := os.Getenv("CEP_URL")
+	if baseURL == "" {
+		return table, nil
+	}
+	return CEPChain{&HTTPCEPProvider{BaseURL: baseURL}, table}, nil
+}
@@ -0,0 +1,201 @@
+package geocoding
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"meu-projeto/backend/utils"
+)
+
+// defaultGazetteer é o dicionário geográfico embutido no binário, com as capitais e alguns bairros e ruas.
+//
+//go:embed data/gazetteer.csv
+var defaultGazetteer string
+
+// streetPrefixes expande as abreviações comuns de tipo de logradouro (ex: "Av. Paulista" -> "avenida paulista").
+var streetPrefixes = map[string]string{
+	"av": "avenida", "r": "rua", "al": "alameda", "pca": "praca", "pc": "praca",
+	"rod": "rodovia", "estr": "estrada", "tv": "travessa", "lgo": "largo",
+}
+
+// GazetteerEntry é uma linha do dicionário geográfico. Linhas sem logradouro representam o centro
+// do bairro e linhas sem bairro e sem logradouro representam o centro da cidade.
+type GazetteerEntry struct {
+	Estado     string  // Estado (UF)
+	Cidade     string  // Cidade
+	Bairro     string  // Bairro (opcional)
+	Logradouro string  // Logradouro (opcional)
+	Latitude   float64 // Latitude do ponto
+	Longitude  float64 // Longitude do ponto
+}
+
+// Gazetteer é um Geocoder offline que resolve endereços a partir de um dicionário geográfico local,
+// do mais exato (logradouro) ao mais aproximado (cidade). As comparações ignoram acentos e maiúsculas.
+type Gazetteer struct {
+	streets map[string]GazetteerEntry // Indexado por cidade, estado e logradouro
+	bairros map[string]GazetteerEntry // Indexado por cidade, estado e bairro
+	cities  map[string]GazetteerEntry // Indexado por cidade e estado
+	entries []GazetteerEntry          // Todas as entradas, na ordem do arquivo (usadas na busca reversa)
+}
+
+// NewGazetteer lê um dicionário geográfico em CSV com o cabeçalho
+// "estado,cidade,bairro,logradouro,latitude,longitude".
+func NewGazetteer(r io.Reader) (*Gazetteer, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 6
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("dicionário geográfico inválido: %w", err)
+	}
+
+	g := &Gazetteer{
+		streets: make(map[string]GazetteerEntry),
+		bairros: make(map[string]GazetteerEntry),
+		cities:  make(map[string]GazetteerEntry),
+	}
+	for i, record := range records {
+		if i == 0 {
+			continue // Ignora o cabeçalho
+		}
+
+		entry := GazetteerEntry{Estado: record[0], Cidade: record[1], Bairro: record[2], Logradouro: record[3]}
+		entry.Latitude, err = strconv.ParseFloat(record[4], 64)
+		if err == nil {
+			entry.Longitude, err = strconv.ParseFloat(record[5], 64)
+		}
+		if err != nil || entry.Cidade == "" || !utils.ValidCoordinates(entry.Latitude, entry.Longitude) {
+			return nil, fmt.Errorf("dicionário geográfico inválido: linha %d", i+1)
+		}
+		g.add(entry)
+		g.entries = append(g.entries, entry)
+	}
+	return g, nil
+}
+
+// LoadGazetteer lê o dicionário geográfico do arquivo informado.
+func LoadGazetteer(path string) (*Gazetteer, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return NewGazetteer(file)
+}
+
+// DefaultGazetteer retorna o dicionário geográfico embutido no binário.
+func DefaultGazetteer() *Gazetteer {
+	g, err := NewGazetteer(strings.NewReader(defaultGazetteer))
+	if err != nil {
+		panic(err) // O arquivo embutido é validado pelos testes
+	}
+	return g
+}
+
+// add indexa a entrada pelo nível mais exato que ela representa. Cada chave é indexada com e sem o
+// estado, para que endereços sem UF também sejam encontrados (vale a primeira entrada do arquivo).
+func (g *Gazetteer) add(entry GazetteerEntry) {
+	index, name := g.cities, ""
+	switch entry.precision() {
+	case PrecisaoLogradouro:
+		index, name = g.streets, normalizeStreet(entry.Logradouro)
+	case PrecisaoBairro:
+		index, name = g.bairros, utils.Fold(entry.Bairro)
+	}
+	for _, key := range []string{gazetteerKey(entry.Cidade, entry.Estado, name), gazetteerKey(entry.Cidade, "", name)} {
+		if _, exists := index[key]; !exists {
+			index[key] = entry
+		}
+	}
+}
+
+// Geocode implementa Geocoder, procurando pelo logradouro, depois pelo bairro e por fim pela cidade.
+func (g *Gazetteer) Geocode(address Address) (*Result, error) {
+	if strings.TrimSpace(address.Cidade) == "" {
+		return nil, fmt.Errorf("%w: a cidade é obrigatória", ErrNotFound)
+	}
+
+	lookups := []struct {
+		index    map[string]GazetteerEntry
+		name     string
+		precisao string
+	}{
+		{g.streets, normalizeStreet(address.Logradouro), PrecisaoLogradouro},
+		{g.bairros, utils.Fold(strings.TrimSpace(address.Bairro)), PrecisaoBairro},
+		{g.cities, "", PrecisaoCidade},
+	}
+	for _, lookup := range lookups {
+		if lookup.precisao != PrecisaoCidade && lookup.name == "" {
+			continue // Campo não informado
+		}
+		if entry, ok := lookup.index[gazetteerKey(address.Cidade, address.Estado, lookup.name)]; ok {
+			return &Result{Latitude: entry.Latitude, Longitude: entry.Longitude, Precisao: lookup.precisao, Provedor: "offline"}, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrNotFound, address.Cidade)
+}
+
+// gazetteerKey monta a chave de busca a partir da cidade, do estado e do nome normalizado.
+func gazetteerKey(cidade, estado, name string) string {
+	return utils.Fold(strings.TrimSpace(cidade)) + "|" + utils.Fold(strings.TrimSpace(estado)) + "|" + name
+}
+
+// normalizeStreet normaliza o nome do logradouro, ignorando acentos, maiúsculas, pontuação e abreviações do tipo.
+func normalizeStreet(street string) string {
+	words := strings.Fields(utils.Fold(strings.NewReplacer(".", " ", ",", " ").Replace(street)))
+	if len(words) > 0 {
+		if expanded, ok := streetPrefixes[words[0]]; ok {
+			words[0] = expanded
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// Raio máximo, em quilômetros, para que uma entrada de cada nível seja considerada na busca reversa.
+var reverseRadiusKm = map[string]float64{
+	PrecisaoLogradouro: 0.5,
+	PrecisaoBairro:     3,
+	PrecisaoCidade:     30,
+}
+
+// Reverse implementa ReverseGeocoder, retornando a entrada mais próxima do ponto. Entradas de logradouro
+// têm preferência sobre as de bairro, e estas sobre as de cidade, desde que estejam dentro do raio do nível.
+func (g *Gazetteer) Reverse(lat, lng float64) (*Place, error) {
+	best := make(map[string]*Place)
+	for _, entry := range g.entries {
+		precisao := entry.precision()
+		distance := utils.Haversine(lat, lng, entry.Latitude, entry.Longitude)
+		if distance > reverseRadiusKm[precisao] || (best[precisao] != nil && best[precisao].DistanciaKm <= distance) {
+			continue
+		}
+		best[precisao] = &Place{
+			Address:     Address{Logradouro: entry.Logradouro, Bairro: entry.Bairro, Cidade: entry.Cidade, Estado: entry.Estado, Pais: "Brasil"},
+			Latitude:    entry.Latitude,
+			Longitude:   entry.Longitude,
+			Precisao:    precisao,
+			DistanciaKm: distance,
+		}
+	}
+
+	for _, precisao := range []string{PrecisaoLogradouro, PrecisaoBairro, PrecisaoCidade} {
+		if place := best[precisao]; place != nil {
+			return place, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// precision retorna o nível que a entrada representa.
+func (e GazetteerEntry) precision() string {
+	switch {
+	case e.Logradouro != "":
+		return PrecisaoLogradouro
+	case e.Bairro != "":
+		return PrecisaoBairro
+	}
+	return PrecisaoCidade
+}
@@ -0,0 +1,85 @@
+// Package geocoding converte endereços em coordenadas (latitude e longitude) no servidor,
+// com provedores intercambiáveis: um dicionário geográfico local (offline) e um adaptador HTTP.
+package geocoding
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrNotFound indica que o provedor não encontrou o endereço informado.
+var ErrNotFound = errors.New("endereço não encontrado")
+
+// Precisões possíveis de um resultado, da mais exata para a mais aproximada.
+const (
+	PrecisaoLogradouro = "logradouro" // Coordenadas da rua
+	PrecisaoBairro     = "bairro"     // Coordenadas do centro do bairro
+	PrecisaoCidade     = "cidade"     // Coordenadas do centro da cidade
+)
+
+// Address é o endereço a ser geocodificado. Campos vazios são ignorados.
+type Address struct {
+	Logradouro string // Nome da rua, avenida, etc.
+	Numero     string // Número do endereço
+	Bairro     string // Bairro
+	Cidade     string // Cidade (obrigatória)
+	Estado     string // Estado (UF)
+	Pais       string // País (padrão Brasil)
+}
+
+// Result é o resultado de uma geocodificação.
+type Result struct {
+	Latitude  float64 // Latitude encontrada
+	Longitude float64 // Longitude encontrada
+	Precisao  string  // Precisão do resultado (logradouro, bairro ou cidade)
+	Provedor  string  // Nome do provedor que resolveu o endereço
+}
+
+// Geocoder é implementado pelos provedores de geocodificação.
+// Geocode retorna ErrNotFound (possivelmente encapsulado) quando o endereço não é encontrado.
+type Geocoder interface {
+	Geocode(address Address) (*Result, error)
+}
+
+// Place é um endereço conhecido encontrado pela busca reversa.
+type Place struct {
+	Address             // Endereço do lugar (sem número)
+	Latitude    float64 // Latitude do lugar
+	Longitude   float64 // Longitude do lugar
+	Precisao    string  // Nível do endereço (logradouro, bairro ou cidade)
+	DistanciaKm float64 // Distância entre o ponto consultado e o lugar
+}
+
+// ReverseGeocoder é implementado pelos provedores que convertem coordenadas no endereço conhecido mais próximo.
+// Reverse retorna ErrNotFound quando não há nenhum endereço conhecido perto do ponto.
+type ReverseGeocoder interface {
+	Reverse(lat, lng float64) (*Place, error)
+}
+
+// Chain consulta os provedores em ordem e retorna o primeiro resultado encontrado.
+// Se todos falharem, retorna o último erro diferente de ErrNotFound ou, se não houver, ErrNotFound.
+type Chain []Geocoder
+
+// Geocode implementa Geocoder.
+func (c Chain) Geocode(address Address) (*Result, error) {
+	var lastErr error
+	for _, geocoder := range c {
+		result, err := geocoder.Geocode(address)
+		if err == nil {
+			return result, nil
+		}
+		if !errors.Is(err, ErrNotFound) {
+			lastErr = err // Provedor indisponível: tenta o próximo, mas guarda o erro
+		}
+	}
+	if lastErr != nil {
+		return nil, lastErr
+	}
+	return nil, ErrNotFound
+}
+
+// StreetFromEndereco extrai o logradouro de um endereço completo (ex: "Rua das Flores, 123" -> "Rua das Flores").
+func StreetFromEndereco(endereco string) string {
+	street, _, _ := strings.Cut(endereco, ",")
+	return strings.TrimSpace(street)
+}
@@ -0,0 +1,98 @@
+package geocoding
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultHTTPTimeout é o tempo máximo de espera pela resposta do provedor HTTP.
+const defaultHTTPTimeout = 5 * time.Second
+
+// HTTPGeocoder é um Geocoder que consulta um provedor HTTP compatível com a busca estruturada do
+// Nominatim (OpenStreetMap): GET {BaseURL}/search?street=...&city=...&state=...&country=...&format=jsonv2.
+type HTTPGeocoder struct {
+	BaseURL   string       // URL base do provedor (ex: https://nominatim.openstreetmap.org)
+	UserAgent string       // Identificação da aplicação, exigida pela política de uso do Nominatim
+	Client    *http.Client // Cliente HTTP (opcional; padrão com timeout de 5 segundos)
+}
+
+// nominatimPlace é o trecho da resposta do provedor usado pelo adaptador.
+type nominatimPlace struct {
+	Lat         string `json:"lat"`         // Latitude, como texto
+	Lon         string `json:"lon"`         // Longitude, como texto
+	AddressType string `json:"addresstype"` // Tipo do lugar encontrado (road, suburb, city, ...)
+}
+
+// Geocode implementa Geocoder, retornando o primeiro lugar encontrado pelo provedor.
+func (g *HTTPGeocoder) Geocode(address Address) (*Result, error) {
+	// Monta a busca estruturada a partir dos campos do endereço
+	pais := address.Pais
+	if pais == "" {
+		pais = "Brasil"
+	}
+	query := url.Values{"format": {"jsonv2"}, "limit": {"1"}, "city": {address.Cidade}, "country": {pais}}
+	if street := strings.TrimSpace(address.Numero + " " + address.Logradouro); street != "" {
+		query.Set("street", street)
+	}
+	if address.Estado != "" {
+		query.Set("state", address.Estado)
+	}
+
+	request, err := http.NewRequest(http.MethodGet, strings.TrimRight(g.BaseURL, "/")+"/search?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", "application/json")
+	if g.UserAgent != "" {
+		request.Header.Set("User-Agent", g.UserAgent)
+	}
+
+	// Executa a requisição
+	client := g.Client
+	if client == nil {
+		client = &http.Client{Timeout: defaultHTTPTimeout}
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("provedor de geocodificação indisponível: %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("provedor de geocodificação respondeu com status %d", response.StatusCode)
+	}
+
+	// Decodifica a lista de lugares encontrados
+	var places []nominatimPlace
+	if err := json.NewDecoder(response.Body).Decode(&places); err != nil {
+		return nil, fmt.Errorf("resposta inválida do provedor de geocodificação: %w", err)
+	}
+	if len(places) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, address.Cidade)
+	}
+
+	result := &Result{Precisao: precisionFromType(places[0].AddressType), Provedor: "http"}
+	result.Latitude, err = strconv.ParseFloat(places[0].Lat, 64)
+	if err == nil {
+		result.Longitude, err = strconv.ParseFloat(places[0].Lon, 64)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("coordenadas inválidas na resposta do provedor de geocodificação: %w", err)
+	}
+	return result, nil
+}
+
+// precisionFromType converte o tipo de lugar do Nominatim na precisão do resultado.
+func precisionFromType(addressType string) string {
+	switch addressType {
+	case "road", "house", "building", "place":
+		return PrecisaoLogradouro
+	case "suburb", "neighbourhood", "quarter", "city_district":
+		return PrecisaoBairro
+	}
+	return PrecisaoCidade
+}
@@ -1,11 +1,17 @@
 package main
 
 import (
+	"fmt"
 	"log"
 	"net/http"
+	"os"
+	"strings"
 
+	"meu-projeto/backend/apierror"
+	"meu-projeto/backend/auth"
 	"meu-projeto/backend/controllers"
 	"meu-projeto/backend/database"
+	"meu-projeto/backend/geocoding"
 	"meu-projeto/backend/repositories"
 	"meu-projeto/backend/services"
 
@@ -19,7 +25,8 @@ func enableCORS(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-API-Key, Accept-Language, X-Request-ID")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Request-ID")
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
@@ -30,94 +37,508 @@ func enableCORS(next http.HandlerFunc) http.HandlerFunc {
 	}
 }
 
-func main() {
-	// Inicializa o banco de dados
+// runMigrateCommand executa o subcomando "migrate" (ex: go run main.go migrate status).
+func runMigrateCommand(args []string) {
 	database.InitDB()
 
-	// Configura o repositório, serviço e controlador para entregas
-	deliveryRepo := &repositories.DeliveryRepository{DB: database.DB}
-	deliveryService := &services.DeliveryService{Repository: deliveryRepo}
+	action := "status"
+	if len(args) > 0 {
+		action = args[0]
+	}
+
+	switch action {
+	case "up":
+		count, err := database.MigrateUp(database.DB, database.Driver)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("%d migração(ões) aplicada(s)", count)
+	case "down":
+		migration, err := database.MigrateDown(database.DB, database.Driver)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if migration == nil {
+			log.Println("Nenhuma migração para desfazer")
+		} else {
+			log.Printf("Migração %04d_%s desfeita", migration.Version, migration.Name)
+		}
+	case "status":
+		states, err := database.MigrationStatus(database.DB, database.Driver)
+		for _, state := range states {
+			applied := "pendente"
+			if state.AppliedAt != nil {
+				applied = "aplicada em " + state.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d_%-30s %s\n", state.Version, state.Name, applied)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+	default:
+		log.Fatalf("Subcomando desconhecido: migrate %s (use up, down ou status)", action)
+	}
+}
+
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description Token de acesso obtido em POST /auth/login, no formato "Bearer <token>"
+
+// @securityDefinitions.apikey ApiKeyAuth
+// @in header
+// @name X-API-Key
+// @description Chave de API de uma integração, cadastrada em POST /api-keys
+func main() {
+	// Subcomando para gerenciar as migrações do banco de dados (migrate up, migrate down ou migrate status)
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		runMigrateCommand(os.Args[2:])
+		return
+	}
+
+	// Carrega as variáveis de ambiente do arquivo .env
+	database.LoadEnv()
+
+	// Configura o armazenamento: em memória no modo de demonstração (DB_DRIVER=memory) ou no banco de dados
+	var stores repositories.Stores
+	if os.Getenv("DB_DRIVER") == "memory" {
+		log.Println("Modo de demonstração: os dados são mantidos apenas em memória")
+		stores = repositories.NewMemoryStores()
+	} else {
+		database.InitDB()
+
+		// Aplica as migrações pendentes; recusa-se a iniciar se o banco for mais novo que a aplicação
+		count, err := database.MigrateUp(database.DB, database.Driver)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if count > 0 {
+			log.Printf("%d migração(ões) aplicada(s)", count)
+		}
+		stores = repositories.NewSQLStores(database.DB)
+	}
+
+	// Carrega o dicionário geográfico e configura o provedor de coordenadas usado no cadastro de entregas sem latitude e longitude
+	gazetteer, err := geocoding.GazetteerFromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	geocoder := geocoding.FromEnv(gazetteer)
+
+	// Carrega a tabela de CEPs e configura o serviço e o controlador da consulta de CEP
+	cepProvider, err := geocoding.CEPProviderFromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	cepService := &services.CEPService{Provider: cepProvider}
+	cepController := &controllers.CEPController{Service: cepService}
+
+	// Configura o serviço e o controlador da auditoria das alterações em entregas e clientes
+	auditService := &services.AuditService{Repository: stores.Audit}
+	auditController := &controllers.AuditController{Service: auditService}
+
+	// Configura o serviço e o controlador para entregas
+	deliveryService := &services.DeliveryService{Repository: stores.Deliveries, Events: stores.Events, Zones: stores.Zones, Geocoder: geocoder, CEPs: cepService, Audit: auditService}
 	deliveryController := &controllers.DeliveryController{Service: deliveryService}
 
-	// Configura o repositório, serviço e controlador para clientes
-	clientRepo := &repositories.ClientRepository{DB: database.DB}
-	clientService := &services.ClientService{Repository: clientRepo}
+	// Configura o serviço e o controlador do histórico de rastreamento
+	eventService := &services.TrackingEventService{Repository: stores.Events, Deliveries: deliveryService}
+	eventController := &controllers.TrackingEventController{Service: eventService}
+
+	// Configura o serviço e o controlador para clientes
+	clientService := &services.ClientService{Repository: stores.Clients, Deliveries: stores.Deliveries, Audit: auditService}
 	clientController := &controllers.ClientController{Service: clientService}
 
+	// Configura o serviço e o controlador para veículos
+	vehicleService := &services.VehicleService{Repository: stores.Vehicles}
+	vehicleController := &controllers.VehicleController{Service: vehicleService}
+
+	// Configura o serviço e o controlador para motoristas
+	driverService := &services.DriverService{Repository: stores.Drivers, Deliveries: deliveryService}
+	driverController := &controllers.DriverController{Service: driverService}
+
+	// Configura o serviço e o controlador para zonas de entrega
+	zoneService := &services.ZoneService{Repository: stores.Zones, Deliveries: deliveryService}
+	zoneController := &controllers.ZoneController{Service: zoneService}
+
+	// Configura o serviço e o controlador da geocodificação reversa
+	geocodeService := &services.GeocodeService{Dataset: gazetteer, Deliveries: stores.Deliveries}
+	geocodeController := &controllers.GeocodeController{Service: geocodeService}
+
+	// Configura o serviço e o controlador de planejamento de rotas
+	routeService := &services.RouteService{Deliveries: stores.Deliveries, Vehicles: stores.Vehicles}
+	routeController := &controllers.RouteController{Service: routeService}
+
+	// Configura o emissor dos tokens JWT e o serviço e o controlador de autenticação
+	tokens, err := auth.TokensFromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	authService := &services.AuthService{Users: stores.Users, Tokens: tokens, Shippers: stores.Shippers}
+	apiKeyService := &services.APIKeyService{Keys: stores.APIKeys, Shippers: stores.Shippers}
+	authController := &controllers.AuthController{Service: authService, APIKeys: apiKeyService}
+	apiKeyController := &controllers.APIKeyController{Service: apiKeyService}
+	shipperController := &controllers.ShipperController{Service: &services.ShipperService{Repository: stores.Shippers}}
+
+	// Cadastra o primeiro usuário (AUTH_ADMIN_EMAIL e AUTH_ADMIN_PASSWORD) se ainda não houver nenhum
+	if email := os.Getenv("AUTH_ADMIN_EMAIL"); email != "" {
+		created, err := authService.EnsureAdmin(email, os.Getenv("AUTH_ADMIN_PASSWORD"))
+		if err != nil {
+			log.Fatalf("Erro ao cadastrar o usuário inicial: %v", err)
+		}
+		if created {
+			log.Printf("Usuário inicial %s cadastrado", email)
+		}
+	}
+
+	// protected protege a rota com o token de acesso ou a chave de API. O CORS vem antes, para que as
+	// requisições OPTIONS (preflight) do navegador, que não levam o cabeçalho Authorization, sejam respondidas
+	protected := func(next http.HandlerFunc) http.HandlerFunc {
+		return enableCORS(authController.Require(next))
+	}
+
+	// can exige do usuário autenticado a permissão informada (veja auth.Can para a matriz de cada papel),
+	// respondendo com o erro 403 se ela faltar. É aplicado a cada combinação de rota e método
+	can := authController.Allow
+
+	// Rotas de autenticação (públicas, exceto /auth/me)
+	http.HandleFunc("/auth/login", enableCORS(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			authController.Login(w, r)
+		} else {
+			controllers.MethodNotAllowed(w, r)
+		}
+	}))
+
+	http.HandleFunc("/auth/refresh", enableCORS(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			authController.Refresh(w, r)
+		} else {
+			controllers.MethodNotAllowed(w, r)
+		}
+	}))
+
+	http.HandleFunc("/auth/me", protected(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			authController.Me(w, r)
+		} else {
+			controllers.MethodNotAllowed(w, r)
+		}
+	}))
+
+	// Configura a rota para o cadastro de usuários
+	http.HandleFunc("/users", protected(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			can(auth.PermUsersManage, authController.CreateUser)(w, r)
+		} else {
+			controllers.MethodNotAllowed(w, r)
+		}
+	}))
+
+	// Configura as rotas para o gerenciamento das chaves de API das integrações
+	http.HandleFunc("/api-keys", protected(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			can(auth.PermUsersManage, apiKeyController.Create)(w, r)
+		case http.MethodGet:
+			can(auth.PermUsersManage, apiKeyController.List)(w, r)
+		default:
+			controllers.MethodNotAllowed(w, r)
+		}
+	}))
+
+	http.HandleFunc("/api-keys/", protected(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			can(auth.PermUsersManage, apiKeyController.Delete)(w, r)
+		} else {
+			controllers.MethodNotAllowed(w, r)
+		}
+	}))
+
+	// Configura as rotas para o gerenciamento dos embarcadores
+	http.HandleFunc("/shippers", protected(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			can(auth.PermUsersManage, shipperController.Create)(w, r)
+		case http.MethodGet:
+			can(auth.PermUsersManage, shipperController.List)(w, r)
+		default:
+			controllers.MethodNotAllowed(w, r)
+		}
+	}))
+
+	// Configura a rota da consulta da auditoria
+	http.HandleFunc("/audit", protected(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			can(auth.PermAuditRead, auditController.List)(w, r)
+		} else {
+			controllers.MethodNotAllowed(w, r)
+		}
+	}))
+
 	// Configura as rotas para entregas
-	http.HandleFunc("/deliveries", enableCORS(func(w http.ResponseWriter, r *http.Request) {
+	http.HandleFunc("/deliveries", protected(func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodPost:
-			deliveryController.Create(w, r)
+			can(auth.PermDeliveriesWrite, deliveryController.Create)(w, r)
 		case http.MethodGet:
-			deliveryController.List(w, r)
+			can(auth.PermDeliveriesRead, deliveryController.List)(w, r)
 		default:
-			http.Error(w, "Método não permitido", http.StatusMethodNotAllowed)
+			controllers.MethodNotAllowed(w, r)
+		}
+	}))
+
+	http.HandleFunc("/deliveries/id/", protected(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			can(auth.PermDeliveriesRead, deliveryController.FindByID)(w, r)
+		} else {
+			controllers.MethodNotAllowed(w, r)
 		}
 	}))
 
-	http.HandleFunc("/deliveries/id/", enableCORS(func(w http.ResponseWriter, r *http.Request) {
+	http.HandleFunc("/deliveries/city", protected(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method == http.MethodGet {
-			deliveryController.FindByID(w, r)
+			can(auth.PermDeliveriesRead, deliveryController.FindByCity)(w, r)
 		} else {
-			http.Error(w, "Método não permitido", http.StatusMethodNotAllowed)
+			controllers.MethodNotAllowed(w, r)
 		}
 	}))
 
-	http.HandleFunc("/deliveries/city", enableCORS(func(w http.ResponseWriter, r *http.Request) {
+	http.HandleFunc("/deliveries/nearby", protected(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method == http.MethodGet {
-			deliveryController.FindByCity(w, r)
+			can(auth.PermDeliveriesRead, deliveryController.Nearby)(w, r)
 		} else {
-			http.Error(w, "Método não permitido", http.StatusMethodNotAllowed)
+			controllers.MethodNotAllowed(w, r)
 		}
 	}))
 
-	http.HandleFunc("/deliveries/", enableCORS(func(w http.ResponseWriter, r *http.Request) {
+	http.HandleFunc("/deliveries/", protected(func(w http.ResponseWriter, r *http.Request) {
+		// Rota para alteração de status (ex: "/deliveries/1/status")
+		if strings.HasSuffix(r.URL.Path, "/status") {
+			if r.Method == http.MethodPost {
+				can(auth.PermDeliveriesStatus, deliveryController.UpdateStatus)(w, r)
+			} else {
+				controllers.MethodNotAllowed(w, r)
+			}
+			return
+		}
+
+		// Rotas para o histórico de rastreamento (ex: "/deliveries/1/events")
+		if strings.HasSuffix(r.URL.Path, "/events") {
+			switch r.Method {
+			case http.MethodGet:
+				can(auth.PermDeliveriesRead, eventController.List)(w, r)
+			case http.MethodPost:
+				can(auth.PermDeliveriesStatus, eventController.Create)(w, r)
+			default:
+				controllers.MethodNotAllowed(w, r)
+			}
+			return
+		}
+
 		switch r.Method {
 		case http.MethodPut:
-			deliveryController.Update(w, r)
+			can(auth.PermDeliveriesWrite, deliveryController.Update)(w, r)
 		case http.MethodDelete:
-			deliveryController.Delete(w, r)
+			can(auth.PermDeliveriesDelete, deliveryController.Delete)(w, r)
 		default:
-			http.Error(w, "Método não permitido", http.StatusMethodNotAllowed)
+			controllers.MethodNotAllowed(w, r)
 		}
 	}))
 
 	// Configura as rotas para clientes
-	http.HandleFunc("/clients", enableCORS(func(w http.ResponseWriter, r *http.Request) {
+	http.HandleFunc("/clients", protected(func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodPost:
-			clientController.Create(w, r)
+			can(auth.PermClientsWrite, clientController.Create)(w, r)
 		case http.MethodGet:
-			clientController.List(w, r)
+			can(auth.PermClientsRead, clientController.List)(w, r)
+		default:
+			controllers.MethodNotAllowed(w, r)
+		}
+	}))
+
+	http.HandleFunc("/clients/id/", protected(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			can(auth.PermClientsRead, clientController.FindByID)(w, r)
+		} else {
+			controllers.MethodNotAllowed(w, r)
+		}
+	}))
+
+	http.HandleFunc("/clients/", protected(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			can(auth.PermClientsWrite, clientController.Update)(w, r)
+		case http.MethodDelete:
+			// A exclusão remove em cascata todas as entregas do cliente: apenas administradores
+			can(auth.PermClientsDelete, clientController.Delete)(w, r)
 		default:
-			http.Error(w, "Método não permitido", http.StatusMethodNotAllowed)
+			controllers.MethodNotAllowed(w, r)
 		}
 	}))
 
-	http.HandleFunc("/clients/id/", enableCORS(func(w http.ResponseWriter, r *http.Request) {
+	// Rota pública de rastreio pelo código (não expõe dados pessoais do cliente)
+	http.HandleFunc("/track/", enableCORS(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method == http.MethodGet {
-			clientController.FindByID(w, r)
+			eventController.Track(w, r)
 		} else {
-			http.Error(w, "Método não permitido", http.StatusMethodNotAllowed)
+			controllers.MethodNotAllowed(w, r)
+		}
+	}))
+
+	// Configura as rotas para veículos
+	http.HandleFunc("/vehicles", protected(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			can(auth.PermFleetWrite, vehicleController.Create)(w, r)
+		case http.MethodGet:
+			can(auth.PermFleetRead, vehicleController.List)(w, r)
+		default:
+			controllers.MethodNotAllowed(w, r)
+		}
+	}))
+
+	http.HandleFunc("/vehicles/", protected(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			can(auth.PermFleetRead, vehicleController.FindByID)(w, r)
+		case http.MethodPut:
+			can(auth.PermFleetWrite, vehicleController.Update)(w, r)
+		case http.MethodDelete:
+			can(auth.PermFleetWrite, vehicleController.Delete)(w, r)
+		default:
+			controllers.MethodNotAllowed(w, r)
+		}
+	}))
+
+	// Configura as rotas para motoristas
+	http.HandleFunc("/drivers", protected(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			can(auth.PermFleetWrite, driverController.Create)(w, r)
+		case http.MethodGet:
+			can(auth.PermFleetRead, driverController.List)(w, r)
+		default:
+			controllers.MethodNotAllowed(w, r)
+		}
+	}))
+
+	http.HandleFunc("/drivers/", protected(func(w http.ResponseWriter, r *http.Request) {
+		// Rotas para as entregas do motorista (ex: "/drivers/1/deliveries" e "/drivers/1/deliveries/7")
+		if strings.Contains(r.URL.Path, "/deliveries") {
+			switch {
+			case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/deliveries"):
+				can(auth.PermFleetRead, driverController.Deliveries)(w, r)
+			case r.Method == http.MethodPost:
+				can(auth.PermDriversAssign, driverController.Assign)(w, r)
+			case r.Method == http.MethodDelete:
+				can(auth.PermDriversAssign, driverController.Unassign)(w, r)
+			default:
+				controllers.MethodNotAllowed(w, r)
+			}
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			can(auth.PermFleetRead, driverController.FindByID)(w, r)
+		case http.MethodPut:
+			can(auth.PermFleetWrite, driverController.Update)(w, r)
+		case http.MethodDelete:
+			can(auth.PermFleetWrite, driverController.Delete)(w, r)
+		default:
+			controllers.MethodNotAllowed(w, r)
+		}
+	}))
+
+	// Configura as rotas para zonas de entrega
+	http.HandleFunc("/zones", protected(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			can(auth.PermFleetWrite, zoneController.Create)(w, r)
+		case http.MethodGet:
+			can(auth.PermFleetRead, zoneController.List)(w, r)
+		default:
+			controllers.MethodNotAllowed(w, r)
 		}
 	}))
 
-	http.HandleFunc("/clients/", enableCORS(func(w http.ResponseWriter, r *http.Request) {
+	http.HandleFunc("/zones/", protected(func(w http.ResponseWriter, r *http.Request) {
+		// Rota para as entregas da zona (ex: "/zones/1/deliveries")
+		if strings.HasSuffix(r.URL.Path, "/deliveries") {
+			if r.Method == http.MethodGet {
+				can(auth.PermFleetRead, zoneController.Deliveries)(w, r)
+			} else {
+				controllers.MethodNotAllowed(w, r)
+			}
+			return
+		}
+
 		switch r.Method {
+		case http.MethodGet:
+			can(auth.PermFleetRead, zoneController.FindByID)(w, r)
 		case http.MethodPut:
-			clientController.Update(w, r)
+			can(auth.PermFleetWrite, zoneController.Update)(w, r)
 		case http.MethodDelete:
-			clientController.Delete(w, r)
+			can(auth.PermFleetWrite, zoneController.Delete)(w, r)
 		default:
-			http.Error(w, "Método não permitido", http.StatusMethodNotAllowed)
+			controllers.MethodNotAllowed(w, r)
 		}
 	}))
 
-	// Rota para o Swagger UI
+	// Rota para otimização da ordem de visita das entregas
+	http.HandleFunc("/routes/optimize", protected(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			can(auth.PermRoutesPlan, routeController.Optimize)(w, r)
+		} else {
+			controllers.MethodNotAllowed(w, r)
+		}
+	}))
+
+	// Rota para o planejamento das rotas da frota, respeitando a capacidade dos veículos
+	http.HandleFunc("/routes/plan", protected(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			can(auth.PermRoutesPlan, routeController.Plan)(w, r)
+		} else {
+			controllers.MethodNotAllowed(w, r)
+		}
+	}))
+
+	// Rota para a geocodificação reversa (coordenadas -> endereço conhecido mais próximo)
+	http.HandleFunc("/geocode/reverse", protected(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			can(auth.PermDeliveriesRead, geocodeController.Reverse)(w, r)
+		} else {
+			controllers.MethodNotAllowed(w, r)
+		}
+	}))
+
+	// Rota para a consulta de CEP (ex: /cep/01310-100), aberta a todos os usuários autenticados
+	http.HandleFunc("/cep/", protected(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			cepController.Lookup(w, r)
+		} else {
+			controllers.MethodNotAllowed(w, r)
+		}
+	}))
+
+	// Rota de verificação de saúde do servidor (pública, usada por balanceadores e pelo Docker)
+	http.HandleFunc("/health", enableCORS(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			controllers.Health(w, r)
+		} else {
+			controllers.MethodNotAllowed(w, r)
+		}
+	}))
+
+	// Rota para o Swagger UI (pública)
 	http.HandleFunc("/swagger/", httpSwagger.WrapHandler)
 
-	// Inicia o servidor
+	// Caminhos que não correspondem a nenhuma rota respondem com o erro 404 no formato da API
+	http.HandleFunc("/", enableCORS(controllers.NotFound))
+
+	// Inicia o servidor, identificando cada requisição com um ID (cabeçalho X-Request-ID) usado nas respostas de erro e nos logs
 	log.Println("Servidor rodando na porta 8080...")
-	log.Fatal(http.ListenAndServe(":8080", nil))
+	log.Fatal(http.ListenAndServe(":8080", apierror.RequestID(http.DefaultServeMux)))
 }
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// ChaveAPI é uma chave de API usada por integrações (ex: o job do e-commerce que cadastra as entregas), enviada
+// no cabeçalho X-API-Key. A chave só é exibida no cadastro; depois disso, é identificada pelo prefixo.
+type ChaveAPI struct {
+	ID           int        `json:"id"`            // ID único da chave
+	Nome         string     `json:"nome"`          // Nome da integração que usa a chave
+	Prefixo      string     `json:"prefixo"`       // Início da chave (ex: "wg_1a2b3c4d"), que a identifica sem revelá-la
+	Hash         string     `json:"-"`             // Hash SHA-256 da chave completa
+	Permissoes   []string   `json:"permissoes"`    // Permissões concedidas à chave (ex: ["deliveries:write"])
+	ExpiraEm     *time.Time `json:"expira_em"`     // Data e hora de expiração (nil se a chave não expirar)
+	UltimoUso    *time.Time `json:"ultimo_uso"`    // Data e hora do último uso (nil se a chave nunca foi usada)
+	UsuarioID    *int       `json:"usuario_id"`    // ID do usuário que cadastrou a chave
+	EmbarcadorID int        `json:"embarcador_id"` // ID do embarcador cujos clientes e entregas a chave acessa
+	DataCadastro time.Time  `json:"data_cadastro"` // Data e hora do cadastro da chave
+}
+
+// ChaveAPICriada é a resposta do cadastro de uma chave de API, a única que contém a chave completa.
+type ChaveAPICriada struct {
+	ChaveAPI
+	Chave string `json:"chave"` // Chave completa, enviada no cabeçalho X-API-Key (não pode ser consultada depois)
+}
@@ -0,0 +1,57 @@
+package models
+
+import "time"
+
+// Entidades registradas na auditoria, usadas no parâmetro "entity" da consulta.
+const (
+	EntidadeEntrega = "delivery" // Entregas
+	EntidadeCliente = "client"   // Clientes
+)
+
+// AuditEntities são as entidades aceitas na consulta da auditoria.
+var AuditEntities = map[string]bool{EntidadeEntrega: true, EntidadeCliente: true}
+
+// Ações registradas na auditoria.
+const (
+	AcaoCriacao     = "create" // Cadastro do registro
+	AcaoAtualizacao = "update" // Alteração do registro (inclusive do status)
+	AcaoRemocao     = "delete" // Remoção do registro
+)
+
+// RegistroAuditoria é o registro de uma operação de escrita em uma entrega ou em um cliente: quem fez, quando
+// e o que mudou. Os registros são apenas inseridos, nunca alterados, e continuam disponíveis depois que o
+// registro auditado é removido.
+type RegistroAuditoria struct {
+	ID           int                  `json:"id"`            // ID único do registro
+	EmbarcadorID int                  `json:"embarcador_id"` // ID do embarcador do registro auditado
+	DataHora     time.Time            `json:"data_hora"`     // Data e hora da operação
+	Ator         string               `json:"ator"`          // Quem fez a operação: o e-mail do usuário, o prefixo da chave de API ou "sistema"
+	Entidade     string               `json:"entidade"`      // Entidade alterada (delivery ou client)
+	EntidadeID   int                  `json:"entidade_id"`   // ID do registro alterado
+	Acao         string               `json:"acao"`          // Ação realizada (create, update ou delete)
+	Alteracoes   map[string]Alteracao `json:"alteracoes"`    // Campos alterados, com os valores antes e depois da operação
+}
+
+// Alteracao são os valores de um campo antes e depois de uma operação: no cadastro, o valor anterior é nulo, e
+// na remoção, o posterior.
+type Alteracao struct {
+	Antes  any `json:"antes"`  // Valor antes da operação
+	Depois any `json:"depois"` // Valor depois da operação
+}
+
+// AuditFilter reúne os filtros e a paginação da consulta da auditoria. Campos vazios (ou nil) não são
+// aplicados como filtro.
+type AuditFilter struct {
+	EmbarcadorID int        // Filtra pelo embarcador (0 = todos)
+	Entidade     string     // Filtra pela entidade (uma das AuditEntities)
+	EntidadeID   int        // Filtra pelo ID do registro auditado (0 = todos)
+	De           *time.Time // Data e hora inicial (inclusive)
+	Ate          *time.Time // Data e hora final (exclusive)
+	Page         int        // Página solicitada (começando em 1)
+	PageSize     int        // Quantidade de itens por página
+}
+
+// Offset retorna a quantidade de itens a pular para chegar à página solicitada.
+func (f AuditFilter) Offset() int {
+	return (f.Page - 1) * f.PageSize
+}
@@ -0,0 +1,11 @@
+package models
+
+// CEPAddress é o endereço de um CEP, com os mesmos campos de endereço de Delivery.
+type CEPAddress struct {
+	CEP        string `json:"cep"`        // CEP consultado, no formato 01310-100
+	Logradouro string `json:"logradouro"` // Nome da rua, avenida, etc. (vazio para CEPs gerais de cidade)
+	Bairro     string `json:"bairro"`     // Bairro do endereço
+	Cidade     string `json:"cidade"`     // Cidade do endereço
+	Estado     string `json:"estado"`     // Estado (UF) do endereço
+	Pais       string `json:"pais"`       // País do endereço
+}
@@ -0,0 +1,23 @@
+package models
+
+// ClientSortColumns são as colunas aceitas na ordenação da listagem de clientes.
+var ClientSortColumns = map[string]bool{
+	"id":    true,
+	"nome":  true,
+	"cpf":   true,
+	"email": true,
+}
+
+// ClientFilter reúne a busca, a ordenação e a paginação da listagem de clientes.
+type ClientFilter struct {
+	Q        string // Texto buscado em nome, e-mail e telefone (parcial) ou no CPF (exato)
+	Sort     string // Coluna de ordenação (uma das ClientSortColumns)
+	Desc     bool   // Ordenação decrescente
+	Page     int    // Página solicitada (começando em 1)
+	PageSize int    // Quantidade de itens por página
+}
+
+// Offset retorna a quantidade de itens a pular para chegar à página solicitada.
+func (f ClientFilter) Offset() int {
+	return (f.Page - 1) * f.PageSize
+}
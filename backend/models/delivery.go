@@ -1,18 +1,30 @@
 package models
 
+import "time"
+
 // Delivery é uma estrutura que representa uma entrega no sistema.
 type Delivery struct {
-	ID          int     `json:"id"`          // ID único da entrega
-	ClienteID   int     `json:"cliente_id"`  // ID do cliente associado à entrega
-	Peso        float64 `json:"peso"`        // Peso da entrega (em kg)
-	Endereco    string  `json:"endereco"`    // Endereço completo da entrega
-	Logradouro  string  `json:"logradouro"`  // Nome da rua, avenida, etc.
-	Numero      string  `json:"numero"`      // Número do endereço
-	Bairro      string  `json:"bairro"`      // Bairro do endereço
-	Complemento string  `json:"complemento"` // Complemento do endereço (ex: apartamento, bloco)
-	Cidade      string  `json:"cidade"`      // Cidade do endereço
-	Estado      string  `json:"estado"`      // Estado (UF) do endereço
-	Pais        string  `json:"pais"`        // País do endereço
-	Latitude    float64 `json:"latitude"`    // Latitude da localização da entrega
-	Longitude   float64 `json:"longitude"`   // Longitude da localização da entrega
+	ID             int       `json:"id"`              // ID único da entrega
+	CodigoRastreio string    `json:"codigo_rastreio"` // Código público de rastreio (ex: ENF85JJ3CX4PER8BR)
+	EmbarcadorID   int       `json:"embarcador_id"`   // ID do embarcador da entrega (o mesmo do cliente)
+	ClienteID      int       `json:"cliente_id"`      // ID do cliente associado à entrega
+	MotoristaID    *int      `json:"motorista_id"`    // ID do motorista responsável (nil se a entrega não foi atribuída)
+	ZonaID         *int      `json:"zona_id"`         // ID da zona que contém as coordenadas da entrega (nil se estiver fora de todas)
+	ForaDeZona     bool      `json:"fora_de_zona"`    // Indica que a entrega está fora de todas as zonas e precisa de revisão
+	Peso           float64   `json:"peso"`            // Peso da entrega (em kg)
+	Endereco       string    `json:"endereco"`        // Endereço completo da entrega
+	Logradouro     string    `json:"logradouro"`      // Nome da rua, avenida, etc.
+	Numero         string    `json:"numero"`          // Número do endereço
+	Bairro         string    `json:"bairro"`          // Bairro do endereço
+	Complemento    string    `json:"complemento"`     // Complemento do endereço (ex: apartamento, bloco)
+	CEP            string    `json:"cep"`             // CEP do endereço, no formato 01310-100
+	Cidade         string    `json:"cidade"`          // Cidade do endereço
+	Estado         string    `json:"estado"`          // Estado (UF) do endereço
+	Pais           string    `json:"pais"`            // País do endereço
+	Latitude       float64   `json:"latitude"`        // Latitude da localização da entrega
+	Longitude      float64   `json:"longitude"`       // Longitude da localização da entrega
+	Status         string    `json:"status"`          // Status atual da entrega (ex: pendente, em_rota, entregue)
+	DataCadastro   time.Time `json:"data_cadastro"`   // Data e hora do cadastro da entrega
+
+	UltimoEvento *TrackingEvent `json:"ultimo_evento,omitempty"` // Evento de rastreamento mais recente (preenchido apenas na busca por ID)
 }
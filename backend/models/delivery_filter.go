@@ -0,0 +1,41 @@
+package models
+
+import "time"
+
+// DeliverySortColumns são as colunas aceitas na ordenação da listagem de entregas.
+var DeliverySortColumns = map[string]bool{
+	"id":            true,
+	"cliente_id":    true,
+	"peso":          true,
+	"cidade":        true,
+	"estado":        true,
+	"bairro":        true,
+	"status":        true,
+	"data_cadastro": true,
+}
+
+// DeliveryFilter reúne os filtros, a ordenação e a paginação da listagem de entregas.
+// Campos vazios (ou nil) não são aplicados como filtro.
+type DeliveryFilter struct {
+	Cidade      string     // Filtra pela cidade (igualdade)
+	Estado      string     // Filtra pelo estado (igualdade)
+	Bairro      string     // Filtra pelo bairro (igualdade)
+	Status      string     // Filtra pelo status (igualdade)
+	ClienteID   int        // Filtra pelo cliente (0 = todos)
+	MotoristaID int        // Filtra pelo motorista responsável (0 = todos)
+	ZonaID      int        // Filtra pela zona (0 = todas)
+	ForaDeZona  bool       // Apenas as entregas fora de todas as zonas
+	PesoMin     *float64   // Peso mínimo (inclusive)
+	PesoMax     *float64   // Peso máximo (inclusive)
+	DataInicio  *time.Time // Data de cadastro inicial (inclusive)
+	DataFim     *time.Time // Data de cadastro final (exclusive)
+	Sort        string     // Coluna de ordenação (uma das DeliverySortColumns)
+	Desc        bool       // Ordenação decrescente
+	Page        int        // Página solicitada (começando em 1)
+	PageSize    int        // Quantidade de itens por página
+}
+
+// Offset retorna a quantidade de itens a pular para chegar à página solicitada.
+func (f DeliveryFilter) Offset() int {
+	return (f.Page - 1) * f.PageSize
+}
@@ -0,0 +1,57 @@
+package models
+
+// Status possíveis de uma entrega ao longo do seu ciclo de vida.
+const (
+	StatusPendente  = "pendente"  // Entrega cadastrada, aguardando coleta
+	StatusColetada  = "coletada"  // Pacote coletado e no centro de distribuição
+	StatusEmRota    = "em_rota"   // Pacote saiu para entrega
+	StatusEntregue  = "entregue"  // Pacote entregue ao destinatário
+	StatusFalhou    = "falhou"    // Tentativa de entrega sem sucesso
+	StatusDevolvida = "devolvida" // Pacote devolvido ao remetente
+	StatusCancelada = "cancelada" // Entrega cancelada antes da saída para rota
+)
+
+// StatusTransitions define, para cada status, os próximos status permitidos.
+// Status sem transições (entregue, devolvida e cancelada) são finais.
+var StatusTransitions = map[string][]string{
+	StatusPendente:  {StatusColetada, StatusCancelada},
+	StatusColetada:  {StatusEmRota, StatusDevolvida, StatusCancelada},
+	StatusEmRota:    {StatusEntregue, StatusFalhou},
+	StatusFalhou:    {StatusEmRota, StatusDevolvida},
+	StatusEntregue:  {},
+	StatusDevolvida: {},
+	StatusCancelada: {},
+}
+
+// StatusDescriptions contém o texto exibido ao destinatário para cada status no rastreamento público. As
+// observações dos eventos são internas (podem citar motoristas e atendentes) e não são publicadas.
+var StatusDescriptions = map[string]string{
+	StatusPendente:  "Pedido recebido, aguardando coleta",
+	StatusColetada:  "Pacote coletado e no centro de distribuição",
+	StatusEmRota:    "Pacote saiu para entrega",
+	StatusEntregue:  "Pacote entregue ao destinatário",
+	StatusFalhou:    "Tentativa de entrega sem sucesso",
+	StatusDevolvida: "Pacote devolvido ao remetente",
+	StatusCancelada: "Entrega cancelada",
+}
+
+// IsValidStatus verifica se o status informado é um dos status conhecidos.
+func IsValidStatus(status string) bool {
+	_, ok := StatusTransitions[status]
+	return ok
+}
+
+// CanTransition verifica se uma entrega pode passar do status "from" para o status "to".
+func CanTransition(from, to string) bool {
+	for _, next := range StatusTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFinalStatus verifica se o status é final, ou seja, se a entrega não pode mais mudar de status.
+func IsFinalStatus(status string) bool {
+	return len(StatusTransitions[status]) == 0
+}
@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// CNHCategories reúne as categorias de CNH aceitas para os motoristas.
+var CNHCategories = map[string]bool{
+	"A": true, "B": true, "C": true, "D": true, "E": true,
+	"AB": true, "AC": true, "AD": true, "AE": true,
+}
+
+// Motorista é uma estrutura que representa um motorista responsável por entregas.
+type Motorista struct {
+	ID           int       `json:"id"`            // ID único do motorista
+	Nome         string    `json:"nome"`          // Nome completo do motorista
+	CPF          string    `json:"cpf"`           // CPF do motorista (formato: 123.456.789-00)
+	CNHNumero    string    `json:"cnh_numero"`    // Número da CNH (11 dígitos)
+	CNHCategoria string    `json:"cnh_categoria"` // Categoria da CNH (ex: B, D, AB)
+	Telefone     string    `json:"telefone"`      // Número de telefone do motorista
+	Ativo        bool      `json:"ativo"`         // Indica se o motorista pode receber entregas
+	DataCadastro time.Time `json:"data_cadastro"` // Data e hora do cadastro do motorista
+}
+
+// DriverFilter reúne o filtro e a paginação da listagem de motoristas.
+type DriverFilter struct {
+	Ativo    *bool // Filtra pelos motoristas ativos ou inativos (nil = todos)
+	Page     int   // Página solicitada (começando em 1)
+	PageSize int   // Quantidade de itens por página
+}
+
+// Offset retorna a quantidade de itens a pular para chegar à página solicitada.
+func (f DriverFilter) Offset() int {
+	return (f.Page - 1) * f.PageSize
+}
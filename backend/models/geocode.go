@@ -0,0 +1,21 @@
+package models
+
+// Fontes possíveis do endereço retornado pela geocodificação reversa.
+const (
+	ReverseSourceGazetteer = "dicionario" // Dicionário geográfico local
+	ReverseSourceDelivery  = "entrega"    // Endereço de uma entrega já cadastrada
+)
+
+// ReverseGeocodeResult é o endereço conhecido mais próximo de um ponto, com os mesmos campos de endereço de Delivery.
+type ReverseGeocodeResult struct {
+	Logradouro  string  `json:"logradouro"`   // Nome da rua, avenida, etc. (vazio se apenas o bairro ou a cidade forem conhecidos)
+	Bairro      string  `json:"bairro"`       // Bairro do endereço
+	Cidade      string  `json:"cidade"`       // Cidade do endereço
+	Estado      string  `json:"estado"`       // Estado (UF) do endereço
+	Pais        string  `json:"pais"`         // País do endereço
+	Latitude    float64 `json:"latitude"`     // Latitude do endereço encontrado
+	Longitude   float64 `json:"longitude"`    // Longitude do endereço encontrado
+	DistanciaKm float64 `json:"distancia_km"` // Distância entre o ponto consultado e o endereço encontrado
+	Precisao    string  `json:"precisao"`     // Nível do endereço encontrado (logradouro, bairro ou cidade)
+	Fonte       string  `json:"fonte"`        // Origem do endereço (dicionario ou entrega)
+}
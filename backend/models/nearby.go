@@ -0,0 +1,21 @@
+package models
+
+// Limites da busca de entregas por proximidade.
+const (
+	MaxNearbyRadiusKm  = 500.0 // Raio máximo da busca, em km
+	DefaultNearbyLimit = 50    // Quantidade padrão de entregas retornadas
+)
+
+// BoundingBox é um retângulo de coordenadas usado como pré-filtro nas buscas por proximidade.
+type BoundingBox struct {
+	MinLat float64 // Latitude mínima (inclusive)
+	MaxLat float64 // Latitude máxima (inclusive)
+	MinLng float64 // Longitude mínima (inclusive)
+	MaxLng float64 // Longitude máxima (inclusive)
+}
+
+// NearbyDelivery é uma entrega encontrada na busca por proximidade, com a distância até o ponto buscado.
+type NearbyDelivery struct {
+	Delivery
+	DistanciaKm float64 `json:"distancia_km"` // Distância em linha reta até o ponto buscado, em km
+}
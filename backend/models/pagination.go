@@ -0,0 +1,28 @@
+package models
+
+// Valores padrão e limites da paginação das listagens.
+const (
+	DefaultPageSize = 20  // Quantidade de itens por página quando não informada
+	MaxPageSize     = 100 // Quantidade máxima de itens por página
+)
+
+// Page é o envelope das respostas paginadas, com os itens da página e os metadados de contagem.
+type Page[T any] struct {
+	Items      []T `json:"items"`       // Itens da página atual
+	Total      int `json:"total"`       // Total de itens que atendem aos filtros
+	Page       int `json:"page"`        // Número da página atual (começando em 1)
+	PageSize   int `json:"page_size"`   // Quantidade de itens por página
+	TotalPages int `json:"total_pages"` // Total de páginas disponíveis
+}
+
+// NewPage monta o envelope de uma página a partir dos itens e do total encontrado.
+func NewPage[T any](items []T, total, page, pageSize int) Page[T] {
+	if items == nil {
+		items = []T{} // Garante que a lista seja serializada como [] e não como null
+	}
+	totalPages := 0
+	if pageSize > 0 {
+		totalPages = (total + pageSize - 1) / pageSize
+	}
+	return Page[T]{Items: items, Total: total, Page: page, PageSize: pageSize, TotalPages: totalPages}
+}
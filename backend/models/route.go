@@ -0,0 +1,55 @@
+package models
+
+// MaxRouteStops é o número máximo de paradas aceitas em uma única otimização de rota.
+const MaxRouteStops = 300
+
+// RouteRequest reúne o depósito e as entregas a serem ordenadas em uma rota.
+// As entregas podem ser informadas pelos IDs ou por um filtro de cidade e data de cadastro.
+type RouteRequest struct {
+	DepositoLatitude   *float64 `json:"deposito_latitude"`              // Latitude do depósito (ponto de partida)
+	DepositoLongitude  *float64 `json:"deposito_longitude"`             // Longitude do depósito (ponto de partida)
+	EntregaIDs         []int    `json:"entrega_ids,omitempty"`          // IDs das entregas a visitar
+	Cidade             string   `json:"cidade,omitempty"`               // Filtra as entregas pela cidade (quando não há IDs)
+	Data               string   `json:"data,omitempty"`                 // Filtra as entregas pela data de cadastro (AAAA-MM-DD)
+	RetornarAoDeposito bool     `json:"retornar_ao_deposito,omitempty"` // Inclui a volta ao depósito no fim da rota
+}
+
+// RouteStop representa uma parada da rota otimizada.
+type RouteStop struct {
+	Ordem          int     `json:"ordem"`           // Posição da parada na rota (começando em 1)
+	EntregaID      int     `json:"entrega_id"`      // ID da entrega
+	CodigoRastreio string  `json:"codigo_rastreio"` // Código de rastreio da entrega
+	Endereco       string  `json:"endereco"`        // Endereço completo da entrega
+	Cidade         string  `json:"cidade"`          // Cidade da entrega
+	Latitude       float64 `json:"latitude"`        // Latitude da entrega
+	Longitude      float64 `json:"longitude"`       // Longitude da entrega
+	DistanciaKm    float64 `json:"distancia_km"`    // Distância desde a parada anterior (ou desde o depósito), em km
+}
+
+// Route é o resultado da otimização: as paradas na ordem de visita e as distâncias.
+type Route struct {
+	Paradas          []RouteStop `json:"paradas"`            // Paradas na ordem de visita
+	RetornoKm        float64     `json:"retorno_km"`         // Distância da última parada até o depósito (0 se não houver retorno)
+	DistanciaTotalKm float64     `json:"distancia_total_km"` // Distância total percorrida, em km
+}
+
+// FleetPlanRequest reúne o depósito, as entregas e os veículos para o planejamento das rotas da frota.
+// As entregas são selecionadas como em RouteRequest; sem veiculo_ids, todos os veículos ativos são usados.
+type FleetPlanRequest struct {
+	RouteRequest
+	VeiculoIDs []int `json:"veiculo_ids,omitempty"` // IDs dos veículos disponíveis
+}
+
+// VehicleRoute é a rota atribuída a um veículo no planejamento da frota.
+type VehicleRoute struct {
+	Veiculo     Vehicle `json:"veiculo"`       // Veículo que fará a rota
+	PesoTotalKg float64 `json:"peso_total_kg"` // Soma do peso das entregas da rota
+	Route
+}
+
+// FleetPlan é o resultado do planejamento: uma rota por veículo utilizado e as entregas que não couberam.
+type FleetPlan struct {
+	Rotas               []VehicleRoute `json:"rotas"`                 // Rotas dos veículos utilizados
+	EntregasNaoAlocadas []int          `json:"entregas_nao_alocadas"` // IDs das entregas que excedem a capacidade disponível
+	DistanciaTotalKm    float64        `json:"distancia_total_km"`    // Soma das distâncias de todas as rotas, em km
+}
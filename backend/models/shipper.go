@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// EmbarcadorPadrao é o ID do embarcador criado pela migração, ao qual pertencem os dados cadastrados antes dos
+// embarcadores e os registros inseridos sem embarcador.
+const EmbarcadorPadrao = 1
+
+// Embarcador é uma empresa para a qual as entregas são feitas. Os clientes e as entregas de um embarcador só
+// são visíveis para os usuários e as chaves de API desse embarcador; a frota e as zonas são compartilhadas.
+type Embarcador struct {
+	ID           int       `json:"id"`            // ID único do embarcador
+	Nome         string    `json:"nome"`          // Nome do embarcador (único)
+	DataCadastro time.Time `json:"data_cadastro"` // Data e hora do cadastro do embarcador
+}
@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// TrackingEvent é uma estrutura que representa um evento no histórico de rastreamento de uma entrega.
+type TrackingEvent struct {
+	ID          int       `json:"id"`                  // ID único do evento
+	EntregaID   int       `json:"entrega_id"`          // ID da entrega associada ao evento
+	DataHora    time.Time `json:"data_hora"`           // Data e hora em que o evento ocorreu
+	Status      string    `json:"status"`              // Status da entrega no momento do evento
+	Latitude    *float64  `json:"latitude,omitempty"`  // Latitude onde o evento ocorreu (opcional)
+	Longitude   *float64  `json:"longitude,omitempty"` // Longitude onde o evento ocorreu (opcional)
+	Observacao  string    `json:"observacao"`          // Observação livre sobre o evento
+	Responsavel string    `json:"responsavel"`         // Quem registrou o evento (o e-mail do usuário ou o prefixo da chave de API), definido pelo servidor
+}
@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// TrackingView é a visão pública de uma entrega, exibida na consulta pelo código de rastreio.
+// Não contém dados pessoais do cliente (CPF, e-mail e telefone) nem o ID interno da entrega.
+type TrackingView struct {
+	CodigoRastreio string              `json:"codigo_rastreio"` // Código de rastreio da entrega
+	Status         string              `json:"status"`          // Status atual da entrega
+	Cidade         string              `json:"cidade"`          // Cidade de destino
+	Estado         string              `json:"estado"`          // Estado (UF) de destino
+	Eventos        []TrackingViewEvent `json:"eventos"`         // Histórico de rastreamento em ordem cronológica
+}
+
+// TrackingViewEvent é a visão pública de uma mudança de status. A observação e o responsável do evento não
+// são publicados: a descrição é um texto fixo para cada status (ver StatusDescriptions).
+type TrackingViewEvent struct {
+	DataHora  time.Time `json:"data_hora"` // Data e hora da mudança de status
+	Status    string    `json:"status"`    // Novo status da entrega
+	Descricao string    `json:"descricao"` // Descrição do status para o destinatário
+}
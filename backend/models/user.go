@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// Usuario é um usuário da API, que se autentica com o e-mail e a senha. O papel define as permissões do
+// usuário; usuários com o papel driver são vinculados a um motorista e só acessam as entregas dele. Todo
+// usuário pertence a um embarcador e só acessa os clientes e as entregas desse embarcador.
+type Usuario struct {
+	ID           int       `json:"id"`              // ID único do usuário
+	Nome         string    `json:"nome"`            // Nome do usuário
+	Email        string    `json:"email"`           // E-mail do usuário, usado no login
+	Senha        string    `json:"senha,omitempty"` // Senha em texto puro, informada apenas no cadastro (nunca armazenada nem retornada)
+	SenhaHash    string    `json:"-"`               // Hash bcrypt da senha
+	Papel        string    `json:"papel"`           // Papel do usuário: admin, dispatcher, driver ou viewer (padrão)
+	MotoristaID  *int      `json:"motorista_id"`    // ID do motorista vinculado (obrigatório no papel driver, nil nos demais)
+	EmbarcadorID int       `json:"embarcador_id"`   // ID do embarcador cujos clientes e entregas o usuário acessa
+	Ativo        bool      `json:"ativo"`           // Usuários inativos não conseguem entrar nem renovar os tokens
+	DataCadastro time.Time `json:"data_cadastro"`   // Data e hora do cadastro do usuário
+}
+
+// LoginRequest é o corpo da requisição de login.
+type LoginRequest struct {
+	Email string `json:"email"` // E-mail do usuário
+	Senha string `json:"senha"` // Senha do usuário
+}
+
+// RefreshRequest é o corpo da requisição de renovação dos tokens.
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token"` // Token de renovação recebido no login
+}
+
+// TokenPair é o par de tokens emitido no login e na renovação.
+type TokenPair struct {
+	AccessToken  string `json:"access_token"`  // Token de acesso, enviado no cabeçalho "Authorization: Bearer <token>"
+	RefreshToken string `json:"refresh_token"` // Token de renovação, trocado por um novo par em /auth/refresh
+	TokenType    string `json:"token_type"`    // Tipo do token (sempre "Bearer")
+	ExpiresIn    int    `json:"expires_in"`    // Validade do token de acesso, em segundos
+}
@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+// Tipos de veículo aceitos na frota.
+const (
+	VehicleTypeMoto     = "moto"     // Motocicleta
+	VehicleTypeCarro    = "carro"    // Carro de passeio ou utilitário pequeno
+	VehicleTypeVan      = "van"      // Van ou furgão
+	VehicleTypeCaminhao = "caminhao" // Caminhão
+)
+
+// VehicleTypes reúne os tipos de veículo aceitos.
+var VehicleTypes = map[string]bool{
+	VehicleTypeMoto:     true,
+	VehicleTypeCarro:    true,
+	VehicleTypeVan:      true,
+	VehicleTypeCaminhao: true,
+}
+
+// Vehicle é uma estrutura que representa um veículo da frota.
+type Vehicle struct {
+	ID               int       `json:"id"`                // ID único do veículo
+	Placa            string    `json:"placa"`             // Placa do veículo, sem hífen (ex: ABC1D23 ou ABC1234)
+	Tipo             string    `json:"tipo"`              // Tipo do veículo (moto, carro, van ou caminhao)
+	CapacidadePeso   float64   `json:"capacidade_peso"`   // Peso máximo transportado (em kg)
+	CapacidadeVolume float64   `json:"capacidade_volume"` // Volume máximo transportado (em m³)
+	Ativo            bool      `json:"ativo"`             // Indica se o veículo está disponível para rotas
+	DataCadastro     time.Time `json:"data_cadastro"`     // Data e hora do cadastro do veículo
+}
+
+// VehicleFilter reúne o filtro e a paginação da listagem de veículos.
+type VehicleFilter struct {
+	Ativo    *bool // Filtra pelos veículos ativos ou inativos (nil = todos)
+	Page     int   // Página solicitada (começando em 1)
+	PageSize int   // Quantidade de itens por página
+}
+
+// Offset retorna a quantidade de itens a pular para chegar à página solicitada.
+func (f VehicleFilter) Offset() int {
+	return (f.Page - 1) * f.PageSize
+}
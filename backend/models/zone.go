@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// GeoJSONTypePolygon é o único tipo de geometria GeoJSON aceito para as zonas.
+const GeoJSONTypePolygon = "Polygon"
+
+// GeoJSONPolygon é uma geometria GeoJSON do tipo Polygon (RFC 7946). O primeiro anel é o contorno
+// externo e os demais são buracos; cada posição é [longitude, latitude].
+type GeoJSONPolygon struct {
+	Type        string        `json:"type" example:"Polygon"` // Tipo da geometria (sempre "Polygon")
+	Coordinates [][][]float64 `json:"coordinates"`            // Anéis do polígono, com posições [longitude, latitude]
+}
+
+// Zona é uma estrutura que representa uma zona operacional de entrega, delimitada por um polígono.
+type Zona struct {
+	ID           int            `json:"id"`            // ID único da zona
+	Nome         string         `json:"nome"`          // Nome da zona (único)
+	Poligono     GeoJSONPolygon `json:"poligono"`      // Área da zona, em GeoJSON
+	DataCadastro time.Time      `json:"data_cadastro"` // Data e hora do cadastro da zona
+}
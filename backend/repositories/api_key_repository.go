@@ -0,0 +1,118 @@
+package repositories
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"meu-projeto/backend/models"
+)
+
+// apiKeyColumns são as colunas da tabela ChaveAPI lidas por scanAPIKey, na mesma ordem.
+const apiKeyColumns = "id, nome, prefixo, hash, permissoes, expira_em, ultimo_uso, usuario_id, embarcador_id, data_cadastro"
+
+// scanAPIKey escaneia uma linha com as colunas de apiKeyColumns para a estrutura ChaveAPI.
+func scanAPIKey(row rowScanner) (models.ChaveAPI, error) {
+	var key models.ChaveAPI
+	var permissoes string
+	var expiraEm, ultimoUso sql.NullTime
+	var usuarioID sql.NullInt64
+	err := row.Scan(&key.ID, &key.Nome, &key.Prefixo, &key.Hash, &permissoes, &expiraEm, &ultimoUso, &usuarioID, &key.EmbarcadorID, &key.DataCadastro)
+	key.Permissoes = splitPermissions(permissoes)
+	if expiraEm.Valid {
+		key.ExpiraEm = &expiraEm.Time
+	}
+	if ultimoUso.Valid {
+		key.UltimoUso = &ultimoUso.Time
+	}
+	if usuarioID.Valid {
+		id := int(usuarioID.Int64)
+		key.UsuarioID = &id
+	}
+	return key, err
+}
+
+// splitPermissions converte a coluna permissoes (permissões separadas por vírgula) na lista de permissões.
+func splitPermissions(value string) []string {
+	if value == "" {
+		return []string{}
+	}
+	return strings.Split(value, ",")
+}
+
+// APIKeyRepository é uma estrutura que contém métodos para interagir com a tabela de chaves de API no banco de dados.
+type APIKeyRepository struct {
+	DB *sql.DB // Conexão com o banco de dados
+}
+
+// Create insere uma nova chave de API no banco de dados.
+func (r *APIKeyRepository) Create(key *models.ChaveAPI) error {
+	// Query SQL para inserir uma nova chave
+	query := "INSERT INTO ChaveAPI (nome, prefixo, hash, permissoes, expira_em, usuario_id, embarcador_id) VALUES (?, ?, ?, ?, ?, ?, ?)"
+
+	// Executa a query com os valores da chave
+	result, err := r.DB.Exec(query, key.Nome, key.Prefixo, key.Hash, strings.Join(key.Permissoes, ","), key.ExpiraEm, key.UsuarioID, tenantOf(0, key.EmbarcadorID))
+	if err != nil {
+		return translateError(err) // Retorna ErrConflict se o prefixo já existir
+	}
+
+	// Obtém o ID gerado para a nova chave
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err // Retorna erro se não for possível obter o ID
+	}
+
+	// Busca a chave recém-criada para obter a data de cadastro gerada pelo banco
+	created, err := r.findOne("id = ?", id)
+	if err != nil {
+		return err
+	}
+	*key = *created
+	return nil
+}
+
+// List retorna todas as chaves de API, da mais recente para a mais antiga.
+func (r *APIKeyRepository) List() ([]models.ChaveAPI, error) {
+	rows, err := r.DB.Query("SELECT " + apiKeyColumns + " FROM ChaveAPI ORDER BY id DESC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := []models.ChaveAPI{}
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+// FindByPrefix busca uma chave de API pelo prefixo, retornando nil se ela não existir.
+func (r *APIKeyRepository) FindByPrefix(prefix string) (*models.ChaveAPI, error) {
+	return r.findOne("prefixo = ?", prefix)
+}
+
+// Touch registra o último uso da chave de API.
+func (r *APIKeyRepository) Touch(id int, usedAt time.Time) error {
+	return checkAffected(r.DB.Exec("UPDATE ChaveAPI SET ultimo_uso = ? WHERE id = ?", usedAt, id))
+}
+
+// Delete remove uma chave de API, retornando ErrNotFound se ela não existir.
+func (r *APIKeyRepository) Delete(id int) error {
+	return checkAffected(r.DB.Exec("DELETE FROM ChaveAPI WHERE id = ?", id))
+}
+
+// findOne busca a chave de API que atende à condição, retornando nil se ela não existir.
+func (r *APIKeyRepository) findOne(where string, arg any) (*models.ChaveAPI, error) {
+	key, err := scanAPIKey(r.DB.QueryRow("SELECT "+apiKeyColumns+" FROM ChaveAPI WHERE "+where, arg))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Retorna nil se a chave não for encontrada
+		}
+		return nil, err // Retorna erro se houver outro problema
+	}
+	return &key, nil
+}
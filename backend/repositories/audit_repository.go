@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"meu-projeto/backend/models"
+)
+
+// AuditRepository é uma estrutura que contém métodos para interagir com a tabela de auditoria no banco de dados.
+type AuditRepository struct {
+	DB *sql.DB // Conexão com o banco de dados
+}
+
+// Create insere um novo registro de auditoria no banco de dados. Os registros são apenas inseridos, nunca
+// alterados, e não dependem do registro auditado, que pode ser removido depois.
+func (r *AuditRepository) Create(entry *models.RegistroAuditoria) error {
+	// As alterações são guardadas em JSON
+	alteracoes, err := json.Marshal(entry.Alteracoes)
+	if err != nil {
+		return err
+	}
+
+	// Query SQL para inserir um novo registro
+	query := "INSERT INTO Auditoria (embarcador_id, data_hora, ator, entidade, entidade_id, acao, alteracoes) VALUES (?, ?, ?, ?, ?, ?, ?)"
+
+	// Executa a query com os valores do registro, com a data e hora em UTC para que as consultas por período
+	// comparem sempre o mesmo fuso
+	entry.EmbarcadorID, entry.DataHora = tenantOf(0, entry.EmbarcadorID), entry.DataHora.UTC()
+	result, err := r.DB.Exec(query, entry.EmbarcadorID, entry.DataHora, entry.Ator, entry.Entidade, entry.EntidadeID, entry.Acao, string(alteracoes))
+	if err != nil {
+		return translateError(err) // Retorna ErrForeignKey se o embarcador não existir
+	}
+
+	// Obtém o ID gerado para o novo registro
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err // Retorna erro se não for possível obter o ID
+	}
+	entry.ID = int(id)
+	return nil
+}
+
+// List retorna uma página dos registros de auditoria que atendem ao filtro, do mais recente para o mais
+// antigo, e o total de registros encontrados.
+func (r *AuditRepository) List(filter models.AuditFilter) ([]models.RegistroAuditoria, int, error) {
+	// Monta a cláusula WHERE com os filtros informados
+	where, args := " WHERE 1 = 1", []any{}
+	scope, scopeArgs := tenantScope(filter.EmbarcadorID)
+	where += scope
+	args = append(args, scopeArgs...)
+	if filter.Entidade != "" {
+		where += " AND entidade = ?"
+		args = append(args, filter.Entidade)
+	}
+	if filter.EntidadeID != 0 {
+		where += " AND entidade_id = ?"
+		args = append(args, filter.EntidadeID)
+	}
+	if filter.De != nil {
+		where += " AND data_hora >= ?"
+		args = append(args, filter.De.UTC())
+	}
+	if filter.Ate != nil {
+		where += " AND data_hora < ?"
+		args = append(args, filter.Ate.UTC())
+	}
+
+	// Conta o total de registros que atendem ao filtro
+	var total int
+	if err := r.DB.QueryRow("SELECT COUNT(*) FROM Auditoria"+where, args...).Scan(&total); err != nil {
+		return nil, 0, err // Retorna erro se a contagem falhar
+	}
+
+	// Query SQL para selecionar a página de registros, do mais recente para o mais antigo
+	query := "SELECT id, embarcador_id, data_hora, ator, entidade, entidade_id, acao, alteracoes FROM Auditoria" + where + " ORDER BY data_hora DESC, id DESC LIMIT ? OFFSET ?"
+	rows, err := r.DB.Query(query, append(args, filter.PageSize, filter.Offset())...)
+	if err != nil {
+		return nil, 0, err // Retorna erro se a query falhar
+	}
+	defer rows.Close() // Garante que as linhas sejam fechadas após o uso
+
+	entries := []models.RegistroAuditoria{}
+	// Itera sobre as linhas retornadas pela query
+	for rows.Next() {
+		var entry models.RegistroAuditoria
+		var alteracoes string
+		// Escaneia os valores da linha para a estrutura RegistroAuditoria
+		if err := rows.Scan(&entry.ID, &entry.EmbarcadorID, &entry.DataHora, &entry.Ator, &entry.Entidade, &entry.EntidadeID, &entry.Acao, &alteracoes); err != nil {
+			return nil, 0, err // Retorna erro se o scan falhar
+		}
+		if err := json.Unmarshal([]byte(alteracoes), &entry.Alteracoes); err != nil {
+			return nil, 0, err // Retorna erro se as alterações guardadas não forem um JSON válido
+		}
+		// Adiciona o registro à lista
+		entries = append(entries, entry)
+	}
+	return entries, total, rows.Err()
+}
@@ -3,22 +3,33 @@ package repositories
 import (
 	"database/sql"
 	"meu-projeto/backend/models"
+	"meu-projeto/backend/utils"
 )
 
+// clientColumns são as colunas da tabela Cliente lidas nas consultas, na mesma ordem dos campos escaneados.
+const clientColumns = "id, embarcador_id, nome, cpf, email, telefone"
+
 // ClientRepository é uma estrutura que contém métodos para interagir com a tabela de clientes no banco de dados.
 type ClientRepository struct {
-	DB *sql.DB // Conexão com o banco de dados
+	DB           *sql.DB // Conexão com o banco de dados
+	EmbarcadorID int     // Embarcador ao qual as consultas são restritas (0 para todos)
+}
+
+// ForTenant retorna uma cópia do repositório restrita aos clientes do embarcador informado.
+func (repo *ClientRepository) ForTenant(embarcadorID int) ClientStore {
+	return &ClientRepository{DB: repo.DB, EmbarcadorID: embarcadorID}
 }
 
-// Create insere um novo cliente no banco de dados.
+// Create insere um novo cliente no banco de dados, no embarcador do repositório.
 func (repo *ClientRepository) Create(client *models.Cliente) error {
 	// Query SQL para inserir um novo cliente
-	query := `INSERT INTO Cliente (nome, cpf, email, telefone) VALUES (?, ?, ?, ?)`
+	query := `INSERT INTO Cliente (embarcador_id, nome, cpf, email, telefone) VALUES (?, ?, ?, ?, ?)`
 
 	// Executa a query com os valores do cliente
-	result, err := repo.DB.Exec(query, client.Nome, client.CPF, client.Email, client.Telefone)
+	client.EmbarcadorID = tenantOf(repo.EmbarcadorID, client.EmbarcadorID)
+	result, err := repo.DB.Exec(query, client.EmbarcadorID, client.Nome, client.CPF, client.Email, client.Telefone)
 	if err != nil {
-		return err // Retorna erro se a execução falhar
+		return translateError(err) // Retorna ErrConflict se o CPF já estiver cadastrado no embarcador
 	}
 
 	// Obtém o ID gerado para o novo cliente
@@ -32,12 +43,53 @@ func (repo *ClientRepository) Create(client *models.Cliente) error {
 	return nil
 }
 
-// List retorna uma lista de todos os clientes cadastrados no banco de dados.
-func (repo *ClientRepository) List() ([]models.Cliente, error) {
-	// Query SQL para selecionar todos os clientes
-	rows, err := repo.DB.Query("SELECT id, nome, cpf, email, telefone FROM Cliente")
+// List retorna uma página de clientes que atendem à busca, junto com o total de clientes encontrados.
+func (repo *ClientRepository) List(filter models.ClientFilter) ([]models.Cliente, int, error) {
+	// A condição do embarcador é acrescentada depois da busca, que fica entre parênteses por causa dos ORs
+	where := " WHERE 1 = 1"
+	var args []any
+
+	// A busca é parcial em nome, e-mail e telefone e exata no CPF (comparando apenas os dígitos).
+	// No MySQL as colunas usam a collation utf8mb4_unicode_ci, que já ignora acentos e maiúsculas no LIKE;
+	// no SQLite o LIKE é substituído por uma função equivalente (ver database/sqlite.go).
+	if filter.Q != "" {
+		like := "%" + utils.EscapeLike(filter.Q) + "%"
+		where = " WHERE (nome LIKE ? ESCAPE '!' OR email LIKE ? ESCAPE '!' OR telefone LIKE ? ESCAPE '!'"
+		args = append(args, like, like, like)
+
+		if digits := utils.OnlyDigits(filter.Q); len(digits) == 11 {
+			where += " OR REPLACE(REPLACE(cpf, '.', ''), '-', '') = ?"
+			args = append(args, digits)
+		}
+		where += ")"
+	}
+
+	// Restringe a busca aos clientes do embarcador
+	scope, scopeArgs := tenantScope(repo.EmbarcadorID)
+	where += scope
+	args = append(args, scopeArgs...)
+
+	// Conta o total de clientes que atendem à busca
+	var total int
+	if err := repo.DB.QueryRow("SELECT COUNT(*) FROM Cliente"+where, args...).Scan(&total); err != nil {
+		return nil, 0, err // Retorna erro se a contagem falhar
+	}
+
+	// Apenas colunas conhecidas podem ser usadas na ordenação (evita SQL injection)
+	sort := "id"
+	if models.ClientSortColumns[filter.Sort] {
+		sort = filter.Sort
+	}
+	order := " ASC"
+	if filter.Desc {
+		order = " DESC"
+	}
+
+	// Query SQL para selecionar a página de clientes, com o id como critério de desempate
+	query := "SELECT " + clientColumns + " FROM Cliente" + where + " ORDER BY " + sort + order + ", id" + order + " LIMIT ? OFFSET ?"
+	rows, err := repo.DB.Query(query, append(args, filter.PageSize, filter.Offset())...)
 	if err != nil {
-		return nil, err // Retorna erro se a query falhar
+		return nil, 0, err // Retorna erro se a query falhar
 	}
 	defer rows.Close() // Garante que as linhas sejam fechadas após o uso
 
@@ -46,41 +98,48 @@ func (repo *ClientRepository) List() ([]models.Cliente, error) {
 	for rows.Next() {
 		var client models.Cliente
 		// Escaneia os valores da linha para a estrutura Cliente
-		if err := rows.Scan(&client.ID, &client.Nome, &client.CPF, &client.Email, &client.Telefone); err != nil {
-			return nil, err // Retorna erro se o scan falhar
+		if err := rows.Scan(&client.ID, &client.EmbarcadorID, &client.Nome, &client.CPF, &client.Email, &client.Telefone); err != nil {
+			return nil, 0, err // Retorna erro se o scan falhar
 		}
 		// Adiciona o cliente à lista
 		clients = append(clients, client)
 	}
-	return clients, nil
+	return clients, total, nil
 }
 
-// FindByID busca um cliente pelo ID no banco de dados.
+// FindByID busca um cliente pelo ID no banco de dados, retornando ErrNotFound se ele não existir no embarcador.
 func (repo *ClientRepository) FindByID(id int) (*models.Cliente, error) {
 	var client models.Cliente
 	// Query SQL para selecionar um cliente pelo ID
-	query := `SELECT id, nome, cpf, email, telefone FROM Cliente WHERE id = ?`
+	scope, scopeArgs := tenantScope(repo.EmbarcadorID)
+	query := "SELECT " + clientColumns + " FROM Cliente WHERE id = ?" + scope
 
 	// Executa a query e escaneia o resultado para a estrutura Cliente
-	err := repo.DB.QueryRow(query, id).Scan(&client.ID, &client.Nome, &client.CPF, &client.Email, &client.Telefone)
+	err := repo.DB.QueryRow(query, append([]any{id}, scopeArgs...)...).Scan(&client.ID, &client.EmbarcadorID, &client.Nome, &client.CPF, &client.Email, &client.Telefone)
 	if err != nil {
-		return nil, err // Retorna erro se o cliente não for encontrado ou se houver outro erro
+		return nil, translateError(err) // Retorna ErrNotFound se o cliente não for encontrado
 	}
 	return &client, nil
 }
 
-// Update atualiza os dados de um cliente no banco de dados.
+// Update atualiza os dados de um cliente no banco de dados, retornando ErrNotFound se ele não existir no
+// embarcador e ErrConflict se o CPF pertencer a outro cliente do embarcador. O embarcador não é alterado.
 func (repo *ClientRepository) Update(client *models.Cliente) error {
 	// Query SQL para atualizar um cliente
-	query := `UPDATE Cliente SET nome = ?, cpf = ?, email = ?, telefone = ? WHERE id = ?`
+	scope, scopeArgs := tenantScope(repo.EmbarcadorID)
+	query := "UPDATE Cliente SET nome = ?, cpf = ?, email = ?, telefone = ? WHERE id = ?" + scope
 
 	// Executa a query com os valores atualizados do cliente
-	_, err := repo.DB.Exec(query, client.Nome, client.CPF, client.Email, client.Telefone, client.ID)
-	return err // Retorna erro se a execução falhar
+	args := append([]any{client.Nome, client.CPF, client.Email, client.Telefone, client.ID}, scopeArgs...)
+	return checkAffected(repo.DB.Exec(query, args...))
 }
 
-// Delete remove um cliente e suas entregas associadas do banco de dados.
+// Delete remove um cliente e suas entregas associadas do banco de dados, retornando ErrNotFound se ele não
+// existir no embarcador.
 func (repo *ClientRepository) Delete(clientID int) error {
+	scope, scopeArgs := tenantScope(repo.EmbarcadorID)
+	args := append([]any{clientID}, scopeArgs...)
+
 	// Inicia uma transação
 	tx, err := repo.DB.Begin()
 	if err != nil {
@@ -88,16 +147,15 @@ func (repo *ClientRepository) Delete(clientID int) error {
 	}
 
 	// Deleta as entregas vinculadas ao cliente
-	_, err = tx.Exec("DELETE FROM Entrega WHERE cliente_id = ?", clientID)
+	_, err = tx.Exec("DELETE FROM Entrega WHERE cliente_id = ?"+scope, args...)
 	if err != nil {
 		tx.Rollback() // Desfaz a transação em caso de erro
 		return err
 	}
 
 	// Deleta o cliente
-	_, err = tx.Exec("DELETE FROM Cliente WHERE id = ?", clientID)
-	if err != nil {
-		tx.Rollback() // Desfaz a transação em caso de erro
+	if err := checkAffected(tx.Exec("DELETE FROM Cliente WHERE id = ?"+scope, args...)); err != nil {
+		tx.Rollback() // Desfaz a transação em caso de erro (ou se o cliente não existir)
 		return err
 	}
 
@@ -2,22 +2,48 @@ package repositories
 
 import (
 	"database/sql"
+	"errors"
+	"fmt"
 	"meu-projeto/backend/models"
+	"strings"
 )
 
+// deliveryColumns são as colunas da tabela Entrega lidas por scanDelivery, na mesma ordem.
+const deliveryColumns = "id, embarcador_id, COALESCE(codigo_rastreio, ''), cliente_id, motorista_id, zona_id, peso, endereco, logradouro, numero, bairro, complemento, cep, cidade, estado, pais, latitude, longitude, status, data_cadastro"
+
+// rowScanner é implementado tanto por *sql.Row quanto por *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanDelivery escaneia uma linha com as colunas de deliveryColumns para a estrutura Delivery.
+func scanDelivery(row rowScanner) (models.Delivery, error) {
+	var delivery models.Delivery
+	err := row.Scan(&delivery.ID, &delivery.EmbarcadorID, &delivery.CodigoRastreio, &delivery.ClienteID, &delivery.MotoristaID, &delivery.ZonaID, &delivery.Peso, &delivery.Endereco, &delivery.Logradouro, &delivery.Numero, &delivery.Bairro, &delivery.Complemento, &delivery.CEP, &delivery.Cidade, &delivery.Estado, &delivery.Pais, &delivery.Latitude, &delivery.Longitude, &delivery.Status, &delivery.DataCadastro)
+	delivery.ForaDeZona = delivery.ZonaID == nil
+	return delivery, err
+}
+
 // DeliveryRepository é uma estrutura que contém métodos para interagir com a tabela de entregas no banco de dados.
 type DeliveryRepository struct {
-	DB *sql.DB // Conexão com o banco de dados
+	DB           *sql.DB // Conexão com o banco de dados
+	EmbarcadorID int     // Embarcador ao qual as consultas são restritas (0 para todos)
 }
 
-// FindByCPF busca um cliente pelo CPF no banco de dados.
+// ForTenant retorna uma cópia do repositório restrita às entregas (e aos clientes) do embarcador informado.
+func (r *DeliveryRepository) ForTenant(embarcadorID int) DeliveryStore {
+	return &DeliveryRepository{DB: r.DB, EmbarcadorID: embarcadorID}
+}
+
+// FindByCPF busca um cliente do embarcador pelo CPF no banco de dados.
 func (r *DeliveryRepository) FindByCPF(cpf string) (*models.Cliente, error) {
 	var cliente models.Cliente
 	// Query SQL para selecionar um cliente pelo CPF
-	query := "SELECT id, nome, email, telefone, cpf FROM Cliente WHERE cpf = ?"
+	scope, scopeArgs := tenantScope(r.EmbarcadorID)
+	query := "SELECT " + clientColumns + " FROM Cliente WHERE cpf = ?" + scope
 
 	// Executa a query e escaneia o resultado para a estrutura Cliente
-	err := r.DB.QueryRow(query, cpf).Scan(&cliente.ID, &cliente.Nome, &cliente.Email, &cliente.Telefone, &cliente.CPF)
+	err := r.DB.QueryRow(query, append([]any{cpf}, scopeArgs...)...).Scan(&cliente.ID, &cliente.EmbarcadorID, &cliente.Nome, &cliente.CPF, &cliente.Email, &cliente.Telefone)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil // Retorna nil se o cliente não for encontrado
@@ -27,69 +53,168 @@ func (r *DeliveryRepository) FindByCPF(cpf string) (*models.Cliente, error) {
 	return &cliente, nil
 }
 
-// CreateCliente insere um novo cliente no banco de dados.
+// CreateCliente insere um novo cliente no embarcador do repositório.
 func (r *DeliveryRepository) CreateCliente(cliente models.Cliente) (int64, error) {
-	// Query SQL para inserir um novo cliente
-	query := "INSERT INTO Cliente (nome, email, telefone, cpf) VALUES (?, ?, ?, ?)"
-
-	// Executa a query com os valores do cliente
-	result, err := r.DB.Exec(query, cliente.Nome, cliente.Email, cliente.Telefone, cliente.CPF)
-	if err != nil {
-		return 0, err // Retorna erro se a execução falhar
+	// Usa o repositório de clientes, que retorna ErrConflict se o CPF já estiver cadastrado no embarcador
+	if err := (&ClientRepository{DB: r.DB, EmbarcadorID: r.EmbarcadorID}).Create(&cliente); err != nil {
+		return 0, err
 	}
 
 	// Retorna o ID do cliente inserido
-	return result.LastInsertId()
+	return int64(cliente.ID), nil
 }
 
-// Create insere uma nova entrega no banco de dados.
+// clientTenant retorna o embarcador do cliente, que é também o das suas entregas, ou ErrForeignKey se o cliente
+// não existir no embarcador do repositório.
+func (r *DeliveryRepository) clientTenant(clienteID int) (int, error) {
+	var embarcadorID int
+	scope, scopeArgs := tenantScope(r.EmbarcadorID)
+	err := r.DB.QueryRow("SELECT embarcador_id FROM Cliente WHERE id = ?"+scope, append([]any{clienteID}, scopeArgs...)...).Scan(&embarcadorID)
+	if err == sql.ErrNoRows {
+		return 0, fmt.Errorf("%w: cliente não encontrado", ErrForeignKey)
+	}
+	return embarcadorID, err
+}
+
+// Create insere uma nova entrega no banco de dados, no embarcador do seu cliente.
 func (r *DeliveryRepository) Create(delivery models.Delivery) (int64, error) {
+	// Verifica se o cliente pertence ao embarcador
+	embarcadorID, err := r.clientTenant(delivery.ClienteID)
+	if err != nil {
+		return 0, err
+	}
+
 	// Query SQL para inserir uma nova entrega
-	query := `INSERT INTO Entrega (cliente_id, peso, endereco, logradouro, numero, bairro, complemento, cidade, estado, pais, latitude, longitude) 
-              VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	query := `INSERT INTO Entrega (embarcador_id, codigo_rastreio, cliente_id, zona_id, peso, endereco, logradouro, numero, bairro, complemento, cep, cidade, estado, pais, latitude, longitude, status) 
+              VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	// Executa a query com os valores da entrega
-	result, err := r.DB.Exec(query, delivery.ClienteID, delivery.Peso, delivery.Endereco, delivery.Logradouro, delivery.Numero, delivery.Bairro, delivery.Complemento, delivery.Cidade, delivery.Estado, delivery.Pais, delivery.Latitude, delivery.Longitude)
+	result, err := r.DB.Exec(query, embarcadorID, delivery.CodigoRastreio, delivery.ClienteID, delivery.ZonaID, delivery.Peso, delivery.Endereco, delivery.Logradouro, delivery.Numero, delivery.Bairro, delivery.Complemento, delivery.CEP, delivery.Cidade, delivery.Estado, delivery.Pais, delivery.Latitude, delivery.Longitude, delivery.Status)
 	if err != nil {
-		return 0, err // Retorna erro se a execução falhar
+		return 0, translateError(err) // Retorna ErrForeignKey se a zona não existir
 	}
 
 	// Retorna o ID da entrega inserida
 	return result.LastInsertId()
 }
 
-// List retorna uma lista de todas as entregas cadastradas no banco de dados.
-func (r *DeliveryRepository) List() ([]models.Delivery, error) {
-	// Query SQL para selecionar todas as entregas
-	rows, err := r.DB.Query("SELECT id, cliente_id, peso, endereco, logradouro, numero, bairro, complemento, cidade, estado, pais, latitude, longitude FROM Entrega")
+// buildDeliveryWhere monta a cláusula WHERE da listagem a partir dos filtros informados, restrita ao embarcador
+// (se embarcadorID não for 0). Os valores são sempre passados como parâmetros (?), nunca concatenados na query.
+func buildDeliveryWhere(filter models.DeliveryFilter, embarcadorID int) (string, []any) {
+	var conditions []string
+	var args []any
+
+	// Adiciona a condição do embarcador
+	if embarcadorID != 0 {
+		conditions = append(conditions, "embarcador_id = ?")
+		args = append(args, embarcadorID)
+	}
+
+	// Adiciona uma condição para cada filtro preenchido
+	if filter.Cidade != "" {
+		conditions = append(conditions, "cidade = ?")
+		args = append(args, filter.Cidade)
+	}
+	if filter.Estado != "" {
+		conditions = append(conditions, "estado = ?")
+		args = append(args, filter.Estado)
+	}
+	if filter.Bairro != "" {
+		conditions = append(conditions, "bairro = ?")
+		args = append(args, filter.Bairro)
+	}
+	if filter.Status != "" {
+		conditions = append(conditions, "status = ?")
+		args = append(args, filter.Status)
+	}
+	if filter.ClienteID != 0 {
+		conditions = append(conditions, "cliente_id = ?")
+		args = append(args, filter.ClienteID)
+	}
+	if filter.MotoristaID != 0 {
+		conditions = append(conditions, "motorista_id = ?")
+		args = append(args, filter.MotoristaID)
+	}
+	if filter.ZonaID != 0 {
+		conditions = append(conditions, "zona_id = ?")
+		args = append(args, filter.ZonaID)
+	}
+	if filter.ForaDeZona {
+		conditions = append(conditions, "zona_id IS NULL")
+	}
+	if filter.PesoMin != nil {
+		conditions = append(conditions, "peso >= ?")
+		args = append(args, *filter.PesoMin)
+	}
+	if filter.PesoMax != nil {
+		conditions = append(conditions, "peso <= ?")
+		args = append(args, *filter.PesoMax)
+	}
+	if filter.DataInicio != nil {
+		conditions = append(conditions, "data_cadastro >= ?")
+		args = append(args, *filter.DataInicio)
+	}
+	if filter.DataFim != nil {
+		conditions = append(conditions, "data_cadastro < ?")
+		args = append(args, *filter.DataFim)
+	}
+
+	if len(conditions) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// List retorna uma página de entregas que atendem aos filtros, junto com o total de entregas encontradas.
+func (r *DeliveryRepository) List(filter models.DeliveryFilter) ([]models.Delivery, int, error) {
+	where, args := buildDeliveryWhere(filter, r.EmbarcadorID)
+
+	// Conta o total de entregas que atendem aos filtros
+	var total int
+	if err := r.DB.QueryRow("SELECT COUNT(*) FROM Entrega"+where, args...).Scan(&total); err != nil {
+		return nil, 0, err // Retorna erro se a contagem falhar
+	}
+
+	// Apenas colunas conhecidas podem ser usadas na ordenação (evita SQL injection)
+	sort := "id"
+	if models.DeliverySortColumns[filter.Sort] {
+		sort = filter.Sort
+	}
+	order := " ASC"
+	if filter.Desc {
+		order = " DESC"
+	}
+
+	// Query SQL para selecionar a página de entregas, com o id como critério de desempate
+	query := "SELECT " + deliveryColumns + " FROM Entrega" + where + " ORDER BY " + sort + order + ", id" + order + " LIMIT ? OFFSET ?"
+	rows, err := r.DB.Query(query, append(args, filter.PageSize, filter.Offset())...)
 	if err != nil {
-		return nil, err // Retorna erro se a query falhar
+		return nil, 0, err // Retorna erro se a query falhar
 	}
 	defer rows.Close() // Garante que as linhas sejam fechadas após o uso
 
 	var deliveries []models.Delivery
 	// Itera sobre as linhas retornadas pela query
 	for rows.Next() {
-		var delivery models.Delivery
 		// Escaneia os valores da linha para a estrutura Delivery
-		err := rows.Scan(&delivery.ID, &delivery.ClienteID, &delivery.Peso, &delivery.Endereco, &delivery.Logradouro, &delivery.Numero, &delivery.Bairro, &delivery.Complemento, &delivery.Cidade, &delivery.Estado, &delivery.Pais, &delivery.Latitude, &delivery.Longitude)
+		delivery, err := scanDelivery(rows)
 		if err != nil {
-			return nil, err // Retorna erro se o scan falhar
+			return nil, 0, err // Retorna erro se o scan falhar
 		}
 		// Adiciona a entrega à lista
 		deliveries = append(deliveries, delivery)
 	}
-	return deliveries, nil
+	return deliveries, total, nil
 }
 
-// FindByID busca uma entrega pelo ID no banco de dados.
+// FindByID busca uma entrega do embarcador pelo ID no banco de dados.
 func (r *DeliveryRepository) FindByID(id int) (*models.Delivery, error) {
-	var delivery models.Delivery
 	// Query SQL para selecionar uma entrega pelo ID
-	query := "SELECT id, cliente_id, peso, endereco, logradouro, numero, bairro, complemento, cidade, estado, pais, latitude, longitude FROM Entrega WHERE id = ?"
+	scope, scopeArgs := tenantScope(r.EmbarcadorID)
+	query := "SELECT " + deliveryColumns + " FROM Entrega WHERE id = ?" + scope
 
 	// Executa a query e escaneia o resultado para a estrutura Delivery
-	err := r.DB.QueryRow(query, id).Scan(&delivery.ID, &delivery.ClienteID, &delivery.Peso, &delivery.Endereco, &delivery.Logradouro, &delivery.Numero, &delivery.Bairro, &delivery.Complemento, &delivery.Cidade, &delivery.Estado, &delivery.Pais, &delivery.Latitude, &delivery.Longitude)
+	delivery, err := scanDelivery(r.DB.QueryRow(query, append([]any{id}, scopeArgs...)...))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil // Retorna nil se a entrega não for encontrada
@@ -99,13 +224,31 @@ func (r *DeliveryRepository) FindByID(id int) (*models.Delivery, error) {
 	return &delivery, nil
 }
 
-// FindByCity busca entregas por cidade no banco de dados.
+// FindByTrackingCode busca uma entrega do embarcador pelo código de rastreio no banco de dados.
+func (r *DeliveryRepository) FindByTrackingCode(code string) (*models.Delivery, error) {
+	// Query SQL para selecionar uma entrega pelo código de rastreio
+	scope, scopeArgs := tenantScope(r.EmbarcadorID)
+	query := "SELECT " + deliveryColumns + " FROM Entrega WHERE codigo_rastreio = ?" + scope
+
+	// Executa a query e escaneia o resultado para a estrutura Delivery
+	delivery, err := scanDelivery(r.DB.QueryRow(query, append([]any{code}, scopeArgs...)...))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Retorna nil se a entrega não for encontrada
+		}
+		return nil, err // Retorna erro se houver outro problema
+	}
+	return &delivery, nil
+}
+
+// FindByCity busca as entregas do embarcador por cidade no banco de dados.
 func (r *DeliveryRepository) FindByCity(cidade string) ([]models.Delivery, error) {
 	// Query SQL para selecionar entregas por cidade
-	query := "SELECT id, cliente_id, peso, endereco, logradouro, numero, bairro, complemento, cidade, estado, pais, latitude, longitude FROM Entrega WHERE cidade = ?"
+	scope, scopeArgs := tenantScope(r.EmbarcadorID)
+	query := "SELECT " + deliveryColumns + " FROM Entrega WHERE cidade = ?" + scope
 
 	// Executa a query
-	rows, err := r.DB.Query(query, cidade)
+	rows, err := r.DB.Query(query, append([]any{cidade}, scopeArgs...)...)
 	if err != nil {
 		return nil, err // Retorna erro se a query falhar
 	}
@@ -114,9 +257,8 @@ func (r *DeliveryRepository) FindByCity(cidade string) ([]models.Delivery, error
 	var deliveries []models.Delivery
 	// Itera sobre as linhas retornadas pela query
 	for rows.Next() {
-		var delivery models.Delivery
 		// Escaneia os valores da linha para a estrutura Delivery
-		err := rows.Scan(&delivery.ID, &delivery.ClienteID, &delivery.Peso, &delivery.Endereco, &delivery.Logradouro, &delivery.Numero, &delivery.Bairro, &delivery.Complemento, &delivery.Cidade, &delivery.Estado, &delivery.Pais, &delivery.Latitude, &delivery.Longitude)
+		delivery, err := scanDelivery(rows)
 		if err != nil {
 			return nil, err // Retorna erro se o scan falhar
 		}
@@ -126,22 +268,110 @@ func (r *DeliveryRepository) FindByCity(cidade string) ([]models.Delivery, error
 	return deliveries, nil
 }
 
-// Update atualiza os dados de uma entrega no banco de dados.
+// FindInBoundingBox busca as entregas do embarcador cujas coordenadas estão dentro do retângulo informado
+// (usando o índice de latitude e longitude), opcionalmente filtrando pelo status.
+func (r *DeliveryRepository) FindInBoundingBox(box models.BoundingBox, status string) ([]models.Delivery, error) {
+	// Query SQL para selecionar as entregas dentro do retângulo
+	scope, args := tenantScope(r.EmbarcadorID)
+	query := "SELECT " + deliveryColumns + " FROM Entrega WHERE latitude BETWEEN ? AND ? AND longitude BETWEEN ? AND ?" + scope
+	args = append([]any{box.MinLat, box.MaxLat, box.MinLng, box.MaxLng}, args...)
+	if status != "" {
+		query += " AND status = ?"
+		args = append(args, status)
+	}
+
+	// Executa a query
+	rows, err := r.DB.Query(query, args...)
+	if err != nil {
+		return nil, err // Retorna erro se a query falhar
+	}
+	defer rows.Close() // Garante que as linhas sejam fechadas após o uso
+
+	deliveries := []models.Delivery{}
+	// Itera sobre as linhas retornadas pela query
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			return nil, err // Retorna erro se o scan falhar
+		}
+		// Adiciona a entrega à lista
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, rows.Err()
+}
+
+// Update atualiza os dados de uma entrega no banco de dados, retornando ErrNotFound se ela não existir no
+// embarcador e ErrForeignKey se o cliente (no embarcador) ou a zona não existirem. O status não é alterado
+// aqui; para isso utilize UpdateStatus.
 func (r *DeliveryRepository) Update(id int, delivery models.Delivery) error {
-	// Query SQL para atualizar uma entrega
-	query := `UPDATE Entrega SET cliente_id = ?, peso = ?, endereco = ?, logradouro = ?, numero = ?, bairro = ?, complemento = ?, cidade = ?, estado = ?, pais = ?, latitude = ?, longitude = ? WHERE id = ?`
+	// Verifica se o cliente pertence ao embarcador
+	embarcadorID, err := r.clientTenant(delivery.ClienteID)
+	if err != nil {
+		return err
+	}
+
+	// Query SQL para atualizar uma entrega (o embarcador acompanha o do cliente)
+	scope, scopeArgs := tenantScope(r.EmbarcadorID)
+	query := `UPDATE Entrega SET embarcador_id = ?, cliente_id = ?, zona_id = ?, peso = ?, endereco = ?, logradouro = ?, numero = ?, bairro = ?, complemento = ?, cep = ?, cidade = ?, estado = ?, pais = ?, latitude = ?, longitude = ? WHERE id = ?` + scope
 
 	// Executa a query com os valores atualizados da entrega
-	_, err := r.DB.Exec(query, delivery.ClienteID, delivery.Peso, delivery.Endereco, delivery.Logradouro, delivery.Numero, delivery.Bairro, delivery.Complemento, delivery.Cidade, delivery.Estado, delivery.Pais, delivery.Latitude, delivery.Longitude, id)
-	return err // Retorna erro se a execução falhar
+	args := append([]any{embarcadorID, delivery.ClienteID, delivery.ZonaID, delivery.Peso, delivery.Endereco, delivery.Logradouro, delivery.Numero, delivery.Bairro, delivery.Complemento, delivery.CEP, delivery.Cidade, delivery.Estado, delivery.Pais, delivery.Latitude, delivery.Longitude, id}, scopeArgs...)
+	return checkAffected(r.DB.Exec(query, args...))
+}
+
+// UpdateStatus altera o status de uma entrega de from para to no banco de dados. A alteração só é feita se o
+// status ainda for from, para que duas transições concorrentes não sejam aplicadas a partir do mesmo status:
+// retorna ErrConflict se o status mudou desde a leitura e ErrNotFound se a entrega não existir no embarcador.
+func (r *DeliveryRepository) UpdateStatus(id int, from, to string) error {
+	// Query SQL para atualizar o status da entrega, condicionada ao status atual
+	scope, scopeArgs := tenantScope(r.EmbarcadorID)
+	query := "UPDATE Entrega SET status = ? WHERE id = ? AND status = ?" + scope
+
+	// Executa a query com o novo status
+	err := checkAffected(r.DB.Exec(query, append([]any{to, id, from}, scopeArgs...)...))
+	if !errors.Is(err, ErrNotFound) {
+		return err
+	}
+
+	// Nenhuma linha alterada: a entrega não existe ou o status já foi alterado por outra operação
+	current, err := r.FindByID(id)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return ErrNotFound
+	}
+	return fmt.Errorf("%w: o status da entrega é '%s'", ErrConflict, current.Status)
+}
+
+// UpdateDriver atribui a entrega ao motorista informado, ou remove a atribuição se motoristaID for nil.
+// Retorna ErrNotFound se a entrega não existir no embarcador e ErrForeignKey se o motorista não existir.
+func (r *DeliveryRepository) UpdateDriver(id int, motoristaID *int) error {
+	// Query SQL para atualizar o motorista da entrega
+	scope, scopeArgs := tenantScope(r.EmbarcadorID)
+	query := "UPDATE Entrega SET motorista_id = ? WHERE id = ?" + scope
+
+	// Executa a query com o novo motorista
+	return checkAffected(r.DB.Exec(query, append([]any{motoristaID, id}, scopeArgs...)...))
+}
+
+// UpdateZone associa a entrega à zona informada, ou a marca como fora de todas as zonas se zonaID for nil.
+// Retorna ErrNotFound se a entrega não existir no embarcador e ErrForeignKey se a zona não existir.
+func (r *DeliveryRepository) UpdateZone(id int, zonaID *int) error {
+	// Query SQL para atualizar a zona da entrega
+	scope, scopeArgs := tenantScope(r.EmbarcadorID)
+	query := "UPDATE Entrega SET zona_id = ? WHERE id = ?" + scope
+
+	// Executa a query com a nova zona
+	return checkAffected(r.DB.Exec(query, append([]any{zonaID, id}, scopeArgs...)...))
 }
 
-// Delete remove uma entrega do banco de dados.
+// Delete remove uma entrega do banco de dados, retornando ErrNotFound se ela não existir no embarcador.
 func (r *DeliveryRepository) Delete(id int) error {
 	// Query SQL para deletar uma entrega
-	query := "DELETE FROM Entrega WHERE id = ?"
+	scope, scopeArgs := tenantScope(r.EmbarcadorID)
+	query := "DELETE FROM Entrega WHERE id = ?" + scope
 
 	// Executa a query
-	_, err := r.DB.Exec(query, id)
-	return err // Retorna erro se a execução falhar
+	return checkAffected(r.DB.Exec(query, append([]any{id}, scopeArgs...)...))
 }
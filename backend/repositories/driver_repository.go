@@ -0,0 +1,126 @@
+package repositories
+
+import (
+	"database/sql"
+	"meu-projeto/backend/models"
+)
+
+// driverColumns são as colunas da tabela Motorista lidas por scanDriver, na mesma ordem.
+const driverColumns = "id, nome, cpf, cnh_numero, cnh_categoria, COALESCE(telefone, ''), ativo, data_cadastro"
+
+// scanDriver escaneia uma linha com as colunas de driverColumns para a estrutura Motorista.
+func scanDriver(row rowScanner) (models.Motorista, error) {
+	var driver models.Motorista
+	err := row.Scan(&driver.ID, &driver.Nome, &driver.CPF, &driver.CNHNumero, &driver.CNHCategoria, &driver.Telefone, &driver.Ativo, &driver.DataCadastro)
+	return driver, err
+}
+
+// DriverRepository é uma estrutura que contém métodos para interagir com a tabela de motoristas no banco de dados.
+type DriverRepository struct {
+	DB *sql.DB // Conexão com o banco de dados
+}
+
+// Create insere um novo motorista no banco de dados.
+func (r *DriverRepository) Create(driver *models.Motorista) error {
+	// Query SQL para inserir um novo motorista
+	query := "INSERT INTO Motorista (nome, cpf, cnh_numero, cnh_categoria, telefone, ativo) VALUES (?, ?, ?, ?, ?, ?)"
+
+	// Executa a query com os valores do motorista
+	result, err := r.DB.Exec(query, driver.Nome, driver.CPF, driver.CNHNumero, driver.CNHCategoria, driver.Telefone, driver.Ativo)
+	if err != nil {
+		return translateError(err) // Retorna ErrConflict se o CPF ou a CNH já estiverem cadastrados
+	}
+
+	// Obtém o ID gerado para o novo motorista
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err // Retorna erro se não for possível obter o ID
+	}
+
+	// Busca o motorista recém-criado para obter a data de cadastro gerada pelo banco
+	created, err := r.FindByID(int(id))
+	if err != nil {
+		return err
+	}
+	*driver = *created
+	return nil
+}
+
+// List retorna uma página de motoristas, junto com o total de motoristas que atendem ao filtro.
+func (r *DriverRepository) List(filter models.DriverFilter) ([]models.Motorista, int, error) {
+	where := ""
+	var args []any
+	if filter.Ativo != nil {
+		where = " WHERE ativo = ?"
+		args = append(args, *filter.Ativo)
+	}
+
+	// Conta o total de motoristas que atendem ao filtro
+	var total int
+	if err := r.DB.QueryRow("SELECT COUNT(*) FROM Motorista"+where, args...).Scan(&total); err != nil {
+		return nil, 0, err // Retorna erro se a contagem falhar
+	}
+
+	// Query SQL para selecionar a página de motoristas, em ordem alfabética
+	rows, err := r.DB.Query("SELECT "+driverColumns+" FROM Motorista"+where+" ORDER BY nome, id LIMIT ? OFFSET ?", append(args, filter.PageSize, filter.Offset())...)
+	if err != nil {
+		return nil, 0, err // Retorna erro se a query falhar
+	}
+	defer rows.Close() // Garante que as linhas sejam fechadas após o uso
+
+	drivers := []models.Motorista{}
+	// Itera sobre as linhas retornadas pela query
+	for rows.Next() {
+		driver, err := scanDriver(rows)
+		if err != nil {
+			return nil, 0, err // Retorna erro se o scan falhar
+		}
+		// Adiciona o motorista à lista
+		drivers = append(drivers, driver)
+	}
+	return drivers, total, rows.Err()
+}
+
+// FindByID busca um motorista pelo ID no banco de dados.
+func (r *DriverRepository) FindByID(id int) (*models.Motorista, error) {
+	return r.findOne("id = ?", id)
+}
+
+// FindByCPF busca um motorista pelo CPF no banco de dados.
+func (r *DriverRepository) FindByCPF(cpf string) (*models.Motorista, error) {
+	return r.findOne("cpf = ?", cpf)
+}
+
+// FindByCNH busca um motorista pelo número da CNH no banco de dados.
+func (r *DriverRepository) FindByCNH(numero string) (*models.Motorista, error) {
+	return r.findOne("cnh_numero = ?", numero)
+}
+
+// findOne busca o motorista que atende à condição informada, retornando nil se ele não existir.
+func (r *DriverRepository) findOne(condition string, arg any) (*models.Motorista, error) {
+	driver, err := scanDriver(r.DB.QueryRow("SELECT "+driverColumns+" FROM Motorista WHERE "+condition, arg))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Retorna nil se o motorista não for encontrado
+		}
+		return nil, err // Retorna erro se houver outro problema
+	}
+	return &driver, nil
+}
+
+// Update atualiza os dados de um motorista no banco de dados, retornando ErrNotFound se ele não existir
+// e ErrConflict se o CPF ou a CNH pertencerem a outro motorista.
+func (r *DriverRepository) Update(driver *models.Motorista) error {
+	// Query SQL para atualizar um motorista
+	query := "UPDATE Motorista SET nome = ?, cpf = ?, cnh_numero = ?, cnh_categoria = ?, telefone = ?, ativo = ? WHERE id = ?"
+
+	// Executa a query com os valores atualizados do motorista
+	return checkAffected(r.DB.Exec(query, driver.Nome, driver.CPF, driver.CNHNumero, driver.CNHCategoria, driver.Telefone, driver.Ativo, driver.ID))
+}
+
+// Delete remove um motorista do banco de dados, retornando ErrNotFound se ele não existir. As entregas
+// atribuídas a ele ficam sem motorista (ON DELETE SET NULL).
+func (r *DriverRepository) Delete(id int) error {
+	// Executa a query para deletar o motorista pelo ID
+	return checkAffected(r.DB.Exec("DELETE FROM Motorista WHERE id = ?", id))
+}
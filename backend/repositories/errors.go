@@ -0,0 +1,92 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/go-sql-driver/mysql"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// Erros retornados pelos repositórios, independentes do banco de dados em uso.
+var (
+	ErrNotFound   = errors.New("registro não encontrado")
+	ErrConflict   = errors.New("registro duplicado")
+	ErrForeignKey = errors.New("registro relacionado inexistente ou em uso")
+)
+
+// Códigos de erro do MySQL tratados pelos repositórios.
+const (
+	mysqlDuplicateEntry    = 1062 // ER_DUP_ENTRY: violação de UNIQUE ou PRIMARY KEY
+	mysqlRowIsReferenced   = 1451 // ER_ROW_IS_REFERENCED_2: registro ainda referenciado por outra tabela
+	mysqlNoReferencedRow   = 1452 // ER_NO_REFERENCED_ROW_2: referência a um registro inexistente
+	mysqlRowIsReferencedV1 = 1217 // ER_ROW_IS_REFERENCED: variante antiga do 1451
+)
+
+// dbError é um erro do banco de dados traduzido para um dos erros dos repositórios. A mensagem é a do
+// erro do repositório, para que detalhes do banco (tabelas, índices, valores) não cheguem ao cliente;
+// o erro original continua acessível com errors.As.
+type dbError struct {
+	kind  error // ErrConflict ou ErrForeignKey
+	cause error // Erro original do driver
+}
+
+// Error implementa error.
+func (e *dbError) Error() string {
+	return e.kind.Error()
+}
+
+// Unwrap permite que errors.Is e errors.As encontrem tanto o erro do repositório quanto o erro do driver.
+func (e *dbError) Unwrap() []error {
+	return []error{e.kind, e.cause}
+}
+
+// translateError converte os erros de restrição do MySQL e do SQLite em ErrConflict e ErrForeignKey, e
+// sql.ErrNoRows em ErrNotFound. Os demais erros são retornados sem alteração.
+func translateError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		switch mysqlErr.Number {
+		case mysqlDuplicateEntry:
+			return &dbError{kind: ErrConflict, cause: err}
+		case mysqlRowIsReferenced, mysqlRowIsReferencedV1, mysqlNoReferencedRow:
+			return &dbError{kind: ErrForeignKey, cause: err}
+		}
+	}
+
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		switch sqliteErr.Code() {
+		case sqlite3.SQLITE_CONSTRAINT_UNIQUE, sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY:
+			return &dbError{kind: ErrConflict, cause: err}
+		case sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY:
+			return &dbError{kind: ErrForeignKey, cause: err}
+		}
+	}
+	return err
+}
+
+// checkAffected traduz o erro de um UPDATE ou DELETE e retorna ErrNotFound se nenhuma linha corresponder ao
+// WHERE. No MySQL, a conexão usa clientFoundRows=true para que linhas encontradas mas não alteradas (mesmos
+// valores) também sejam contadas.
+func checkAffected(result sql.Result, err error) error {
+	if err != nil {
+		return translateError(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
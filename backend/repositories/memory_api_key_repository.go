@@ -0,0 +1,91 @@
+package repositories
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"meu-projeto/backend/models"
+)
+
+// MemoryAPIKeyRepository implementa APIKeyStore mantendo as chaves de API em memória.
+type MemoryAPIKeyRepository struct {
+	DB *MemoryDB // Banco de dados em memória compartilhado
+}
+
+// Create insere uma nova chave de API, rejeitando prefixos duplicados como a restrição UNIQUE da tabela.
+func (r *MemoryAPIKeyRepository) Create(key *models.ChaveAPI) error {
+	r.DB.mu.Lock()
+	defer r.DB.mu.Unlock()
+
+	for _, other := range r.DB.apiKeys {
+		if other.Prefixo == key.Prefixo {
+			return fmt.Errorf("%w: prefixo já cadastrado", ErrConflict)
+		}
+	}
+	if key.UsuarioID != nil {
+		if _, ok := r.DB.users[*key.UsuarioID]; !ok {
+			return fmt.Errorf("%w: usuário não encontrado", ErrForeignKey)
+		}
+	}
+
+	key.ID = r.DB.nextID("ChaveAPI")
+	key.EmbarcadorID = tenantOf(0, key.EmbarcadorID)
+	key.Permissoes = slices.Clone(key.Permissoes)
+	key.DataCadastro = time.Now()
+	r.DB.apiKeys[key.ID] = *key
+	return nil
+}
+
+// List retorna todas as chaves de API, da mais recente para a mais antiga.
+func (r *MemoryAPIKeyRepository) List() ([]models.ChaveAPI, error) {
+	r.DB.mu.RLock()
+	defer r.DB.mu.RUnlock()
+
+	keys := make([]models.ChaveAPI, 0, len(r.DB.apiKeys))
+	for _, key := range r.DB.apiKeys {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].ID > keys[j].ID })
+	return keys, nil
+}
+
+// FindByPrefix busca uma chave de API pelo prefixo, retornando nil se ela não existir.
+func (r *MemoryAPIKeyRepository) FindByPrefix(prefix string) (*models.ChaveAPI, error) {
+	r.DB.mu.RLock()
+	defer r.DB.mu.RUnlock()
+
+	for _, key := range r.DB.apiKeys {
+		if key.Prefixo == prefix {
+			return &key, nil
+		}
+	}
+	return nil, nil
+}
+
+// Touch registra o último uso da chave de API, retornando ErrNotFound se ela não existir.
+func (r *MemoryAPIKeyRepository) Touch(id int, usedAt time.Time) error {
+	r.DB.mu.Lock()
+	defer r.DB.mu.Unlock()
+
+	key, ok := r.DB.apiKeys[id]
+	if !ok {
+		return ErrNotFound
+	}
+	key.UltimoUso = &usedAt
+	r.DB.apiKeys[id] = key
+	return nil
+}
+
+// Delete remove uma chave de API, retornando ErrNotFound se ela não existir.
+func (r *MemoryAPIKeyRepository) Delete(id int) error {
+	r.DB.mu.Lock()
+	defer r.DB.mu.Unlock()
+
+	if _, ok := r.DB.apiKeys[id]; !ok {
+		return ErrNotFound
+	}
+	delete(r.DB.apiKeys, id)
+	return nil
+}
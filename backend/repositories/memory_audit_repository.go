@@ -0,0 +1,60 @@
+package repositories
+
+import (
+	"fmt"
+
+	"meu-projeto/backend/models"
+)
+
+// MemoryAuditRepository implementa AuditStore mantendo o registro de auditoria em memória.
+type MemoryAuditRepository struct {
+	DB *MemoryDB // Banco de dados em memória compartilhado
+}
+
+// Create adiciona um novo registro de auditoria, retornando ErrForeignKey se o embarcador não existir.
+func (r *MemoryAuditRepository) Create(entry *models.RegistroAuditoria) error {
+	r.DB.mu.Lock()
+	defer r.DB.mu.Unlock()
+
+	entry.EmbarcadorID = tenantOf(0, entry.EmbarcadorID)
+	if _, ok := r.DB.shippers[entry.EmbarcadorID]; !ok {
+		return fmt.Errorf("%w: embarcador não encontrado", ErrForeignKey)
+	}
+	entry.ID = r.DB.nextID("Auditoria")
+	entry.DataHora = entry.DataHora.UTC()
+	r.DB.audit = append(r.DB.audit, *entry)
+	return nil
+}
+
+// List retorna uma página dos registros de auditoria que atendem ao filtro, do mais recente para o mais
+// antigo, e o total de registros encontrados.
+func (r *MemoryAuditRepository) List(filter models.AuditFilter) ([]models.RegistroAuditoria, int, error) {
+	r.DB.mu.RLock()
+	defer r.DB.mu.RUnlock()
+
+	// Os registros são mantidos em ordem de inserção, que é a ordem cronológica
+	var matched []models.RegistroAuditoria
+	for i := len(r.DB.audit) - 1; i >= 0; i-- {
+		if matchesAuditFilter(r.DB.audit[i], filter) {
+			matched = append(matched, r.DB.audit[i])
+		}
+	}
+	return paginate(matched, filter.Offset(), filter.PageSize), len(matched), nil
+}
+
+// matchesAuditFilter informa se o registro de auditoria atende a todos os filtros informados.
+func matchesAuditFilter(entry models.RegistroAuditoria, filter models.AuditFilter) bool {
+	switch {
+	case !inTenant(filter.EmbarcadorID, entry.EmbarcadorID):
+		return false
+	case filter.Entidade != "" && entry.Entidade != filter.Entidade:
+		return false
+	case filter.EntidadeID != 0 && entry.EntidadeID != filter.EntidadeID:
+		return false
+	case filter.De != nil && entry.DataHora.Before(*filter.De):
+		return false
+	case filter.Ate != nil && !entry.DataHora.Before(*filter.Ate):
+		return false
+	}
+	return true
+}
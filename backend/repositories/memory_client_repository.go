@@ -0,0 +1,160 @@
+package repositories
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/utils"
+)
+
+// MemoryClientRepository implementa ClientStore mantendo os clientes em memória.
+type MemoryClientRepository struct {
+	DB           *MemoryDB // Banco de dados em memória compartilhado
+	EmbarcadorID int       // Embarcador ao qual as consultas são restritas (0 para todos)
+}
+
+// ForTenant retorna uma cópia do repositório restrita aos clientes do embarcador informado.
+func (repo *MemoryClientRepository) ForTenant(embarcadorID int) ClientStore {
+	return &MemoryClientRepository{DB: repo.DB, EmbarcadorID: embarcadorID}
+}
+
+// Create insere um novo cliente no embarcador do repositório, rejeitando CPFs duplicados no embarcador
+// (ErrConflict) como a restrição UNIQUE da tabela.
+func (repo *MemoryClientRepository) Create(client *models.Cliente) error {
+	repo.DB.mu.Lock()
+	defer repo.DB.mu.Unlock()
+
+	client.EmbarcadorID = tenantOf(repo.EmbarcadorID, client.EmbarcadorID)
+	if repo.DB.findClientByCPF(client.CPF, client.EmbarcadorID) != nil {
+		return fmt.Errorf("%w: CPF já cadastrado", ErrConflict)
+	}
+
+	client.ID = repo.DB.nextID("Cliente")
+	repo.DB.clients[client.ID] = *client
+	return nil
+}
+
+// List retorna uma página de clientes que atendem à busca, junto com o total de clientes encontrados.
+func (repo *MemoryClientRepository) List(filter models.ClientFilter) ([]models.Cliente, int, error) {
+	repo.DB.mu.RLock()
+	defer repo.DB.mu.RUnlock()
+
+	// Seleciona os clientes que atendem à busca
+	var clients []models.Cliente
+	for _, client := range repo.DB.clients {
+		if inTenant(repo.EmbarcadorID, client.EmbarcadorID) && matchesClientQuery(client, filter.Q) {
+			clients = append(clients, client)
+		}
+	}
+
+	// Ordena pela coluna solicitada, com o ID como critério de desempate
+	sort.Slice(clients, func(i, j int) bool {
+		a, b := clients[i], clients[j]
+		if filter.Desc {
+			a, b = b, a
+		}
+		var cmp int
+		switch filter.Sort {
+		case "nome":
+			cmp = strings.Compare(utils.Fold(a.Nome), utils.Fold(b.Nome))
+		case "cpf":
+			cmp = strings.Compare(a.CPF, b.CPF)
+		case "email":
+			cmp = strings.Compare(utils.Fold(a.Email), utils.Fold(b.Email))
+		}
+		if cmp != 0 {
+			return cmp < 0
+		}
+		return a.ID < b.ID
+	})
+
+	return paginate(clients, filter.Offset(), filter.PageSize), len(clients), nil
+}
+
+// matchesClientQuery aplica a mesma regra de busca do ClientRepository: parcial e sem acentos
+// em nome, e-mail e telefone, e exata nos dígitos do CPF.
+func matchesClientQuery(client models.Cliente, q string) bool {
+	if q == "" {
+		return true
+	}
+	folded := utils.Fold(q)
+	if strings.Contains(utils.Fold(client.Nome), folded) || strings.Contains(utils.Fold(client.Email), folded) || strings.Contains(utils.Fold(client.Telefone), folded) {
+		return true
+	}
+	digits := utils.OnlyDigits(q)
+	return len(digits) == 11 && utils.OnlyDigits(client.CPF) == digits
+}
+
+// FindByID busca um cliente pelo ID, retornando ErrNotFound se ele não existir no embarcador.
+func (repo *MemoryClientRepository) FindByID(id int) (*models.Cliente, error) {
+	repo.DB.mu.RLock()
+	defer repo.DB.mu.RUnlock()
+
+	client, ok := repo.DB.clients[id]
+	if !ok || !inTenant(repo.EmbarcadorID, client.EmbarcadorID) {
+		return nil, ErrNotFound
+	}
+	return &client, nil
+}
+
+// Update atualiza os dados de um cliente existente, retornando ErrNotFound se ele não existir no embarcador e
+// ErrConflict se o CPF pertencer a outro cliente do embarcador. O embarcador não é alterado.
+func (repo *MemoryClientRepository) Update(client *models.Cliente) error {
+	repo.DB.mu.Lock()
+	defer repo.DB.mu.Unlock()
+
+	current, ok := repo.DB.clients[client.ID]
+	if !ok || !inTenant(repo.EmbarcadorID, current.EmbarcadorID) {
+		return ErrNotFound
+	}
+	client.EmbarcadorID = current.EmbarcadorID
+	if other := repo.DB.findClientByCPF(client.CPF, client.EmbarcadorID); other != nil && other.ID != client.ID {
+		return fmt.Errorf("%w: CPF já cadastrado", ErrConflict)
+	}
+	repo.DB.clients[client.ID] = *client
+	return nil
+}
+
+// Delete remove um cliente e suas entregas associadas (e o histórico dessas entregas), retornando
+// ErrNotFound se ele não existir no embarcador.
+func (repo *MemoryClientRepository) Delete(clientID int) error {
+	repo.DB.mu.Lock()
+	defer repo.DB.mu.Unlock()
+
+	if client, ok := repo.DB.clients[clientID]; !ok || !inTenant(repo.EmbarcadorID, client.EmbarcadorID) {
+		return ErrNotFound
+	}
+
+	for id, delivery := range repo.DB.deliveries {
+		if delivery.ClienteID == clientID {
+			repo.DB.deleteDelivery(id)
+		}
+	}
+	delete(repo.DB.clients, clientID)
+	return nil
+}
+
+// findClientByCPF busca um cliente do embarcador pelo CPF (em todos os embarcadores se embarcadorID for 0).
+// Deve ser chamado com o mutex bloqueado.
+func (db *MemoryDB) findClientByCPF(cpf string, embarcadorID int) *models.Cliente {
+	for _, client := range db.clients {
+		if client.CPF == cpf && inTenant(embarcadorID, client.EmbarcadorID) {
+			return &client
+		}
+	}
+	return nil
+}
+
+// paginate retorna a fatia de itens correspondente à página solicitada.
+func paginate[T any](items []T, offset, limit int) []T {
+	if offset >= len(items) {
+		return nil
+	}
+	end := offset + limit
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[offset:end]
+}
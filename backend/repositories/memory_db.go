@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"sync"
+	"time"
+
+	"meu-projeto/backend/models"
+)
+
+// MemoryDB guarda em memória os dados das tabelas, protegidos por um mutex para permitir acesso concorrente.
+// É compartilhado pelos repositórios em memória para que, como no banco de dados, uma entrega
+// enxergue os clientes criados pelo repositório de clientes e vice-versa.
+type MemoryDB struct {
+	mu         sync.RWMutex               // Protege todos os campos abaixo
+	clients    map[int]models.Cliente     // Tabela Cliente, indexada pelo ID
+	deliveries map[int]models.Delivery    // Tabela Entrega, indexada pelo ID
+	events     []models.TrackingEvent     // Tabela EventoRastreamento, em ordem de inserção
+	vehicles   map[int]models.Vehicle     // Tabela Veiculo, indexada pelo ID
+	drivers    map[int]models.Motorista   // Tabela Motorista, indexada pelo ID
+	zones      map[int]models.Zona        // Tabela Zona, indexada pelo ID
+	users      map[int]models.Usuario     // Tabela Usuario, indexada pelo ID
+	apiKeys    map[int]models.ChaveAPI    // Tabela ChaveAPI, indexada pelo ID
+	shippers   map[int]models.Embarcador  // Tabela Embarcador, indexada pelo ID
+	audit      []models.RegistroAuditoria // Tabela Auditoria, em ordem de inserção
+	lastIDs    map[string]int             // Último ID gerado por tabela (equivalente ao AUTO_INCREMENT)
+}
+
+// NewMemoryDB cria um banco de dados em memória vazio, apenas com o embarcador padrão (como a migração).
+func NewMemoryDB() *MemoryDB {
+	db := &MemoryDB{
+		clients:    make(map[int]models.Cliente),
+		deliveries: make(map[int]models.Delivery),
+		vehicles:   make(map[int]models.Vehicle),
+		drivers:    make(map[int]models.Motorista),
+		zones:      make(map[int]models.Zona),
+		users:      make(map[int]models.Usuario),
+		apiKeys:    make(map[int]models.ChaveAPI),
+		shippers:   make(map[int]models.Embarcador),
+		lastIDs:    make(map[string]int),
+	}
+	db.shippers[models.EmbarcadorPadrao] = models.Embarcador{ID: models.EmbarcadorPadrao, Nome: "Padrão", DataCadastro: time.Now()}
+	db.lastIDs["Embarcador"] = models.EmbarcadorPadrao
+	return db
+}
+
+// nextID gera o próximo ID da tabela informada. Deve ser chamado com o mutex bloqueado para escrita.
+func (db *MemoryDB) nextID(table string) int {
+	db.lastIDs[table]++
+	return db.lastIDs[table]
+}
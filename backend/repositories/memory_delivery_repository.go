@@ -0,0 +1,350 @@
+package repositories
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/utils"
+)
+
+// MemoryDeliveryRepository implementa DeliveryStore mantendo as entregas em memória.
+type MemoryDeliveryRepository struct {
+	DB           *MemoryDB // Banco de dados em memória compartilhado
+	EmbarcadorID int       // Embarcador ao qual as consultas são restritas (0 para todos)
+}
+
+// ForTenant retorna uma cópia do repositório restrita às entregas (e aos clientes) do embarcador informado.
+func (r *MemoryDeliveryRepository) ForTenant(embarcadorID int) DeliveryStore {
+	return &MemoryDeliveryRepository{DB: r.DB, EmbarcadorID: embarcadorID}
+}
+
+// FindByCPF busca um cliente do embarcador pelo CPF, retornando nil se ele não existir.
+func (r *MemoryDeliveryRepository) FindByCPF(cpf string) (*models.Cliente, error) {
+	r.DB.mu.RLock()
+	defer r.DB.mu.RUnlock()
+
+	return r.DB.findClientByCPF(cpf, r.EmbarcadorID), nil
+}
+
+// CreateCliente insere um novo cliente no embarcador do repositório e retorna o ID gerado.
+func (r *MemoryDeliveryRepository) CreateCliente(cliente models.Cliente) (int64, error) {
+	if err := (&MemoryClientRepository{DB: r.DB, EmbarcadorID: r.EmbarcadorID}).Create(&cliente); err != nil {
+		return 0, err
+	}
+	return int64(cliente.ID), nil
+}
+
+// Create insere uma nova entrega, no embarcador do seu cliente, e retorna o ID gerado.
+func (r *MemoryDeliveryRepository) Create(delivery models.Delivery) (int64, error) {
+	r.DB.mu.Lock()
+	defer r.DB.mu.Unlock()
+
+	// Respeita a chave estrangeira para Cliente (no embarcador) e o código de rastreio único
+	embarcadorID, err := r.clientTenant(delivery.ClienteID)
+	if err != nil {
+		return 0, err
+	}
+	if delivery.CodigoRastreio != "" && r.DB.findDeliveryByTrackingCode(delivery.CodigoRastreio) != nil {
+		return 0, fmt.Errorf("%w: código de rastreio já cadastrado", ErrConflict)
+	}
+	if err := r.DB.checkZoneExists(delivery.ZonaID); err != nil {
+		return 0, err
+	}
+
+	delivery.ID = r.DB.nextID("Entrega")
+	delivery.EmbarcadorID = embarcadorID
+	delivery.DataCadastro = time.Now()
+	delivery.MotoristaID = nil // A atribuição é feita apenas por UpdateDriver
+	delivery.ForaDeZona = delivery.ZonaID == nil
+	delivery.UltimoEvento = nil
+	r.DB.deliveries[delivery.ID] = delivery
+	return int64(delivery.ID), nil
+}
+
+// List retorna uma página de entregas que atendem aos filtros, junto com o total de entregas encontradas.
+func (r *MemoryDeliveryRepository) List(filter models.DeliveryFilter) ([]models.Delivery, int, error) {
+	r.DB.mu.RLock()
+	defer r.DB.mu.RUnlock()
+
+	// Seleciona as entregas que atendem aos filtros
+	var deliveries []models.Delivery
+	for _, delivery := range r.DB.deliveries {
+		if inTenant(r.EmbarcadorID, delivery.EmbarcadorID) && matchesDeliveryFilter(delivery, filter) {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+
+	// Ordena pela coluna solicitada, com o ID como critério de desempate
+	sort.Slice(deliveries, func(i, j int) bool {
+		a, b := deliveries[i], deliveries[j]
+		if filter.Desc {
+			a, b = b, a
+		}
+		if cmp := compareDeliveries(a, b, filter.Sort); cmp != 0 {
+			return cmp < 0
+		}
+		return a.ID < b.ID
+	})
+
+	return paginate(deliveries, filter.Offset(), filter.PageSize), len(deliveries), nil
+}
+
+// matchesDeliveryFilter aplica os mesmos filtros de buildDeliveryWhere. Os textos são comparados
+// sem acentos e maiúsculas, como a collation utf8mb4_unicode_ci da tabela Entrega.
+func matchesDeliveryFilter(delivery models.Delivery, filter models.DeliveryFilter) bool {
+	switch {
+	case filter.Cidade != "" && utils.Fold(delivery.Cidade) != utils.Fold(filter.Cidade):
+		return false
+	case filter.Estado != "" && utils.Fold(delivery.Estado) != utils.Fold(filter.Estado):
+		return false
+	case filter.Bairro != "" && utils.Fold(delivery.Bairro) != utils.Fold(filter.Bairro):
+		return false
+	case filter.Status != "" && delivery.Status != filter.Status:
+		return false
+	case filter.ClienteID != 0 && delivery.ClienteID != filter.ClienteID:
+		return false
+	case filter.MotoristaID != 0 && (delivery.MotoristaID == nil || *delivery.MotoristaID != filter.MotoristaID):
+		return false
+	case filter.ZonaID != 0 && (delivery.ZonaID == nil || *delivery.ZonaID != filter.ZonaID):
+		return false
+	case filter.ForaDeZona && delivery.ZonaID != nil:
+		return false
+	case filter.PesoMin != nil && delivery.Peso < *filter.PesoMin:
+		return false
+	case filter.PesoMax != nil && delivery.Peso > *filter.PesoMax:
+		return false
+	case filter.DataInicio != nil && delivery.DataCadastro.Before(*filter.DataInicio):
+		return false
+	case filter.DataFim != nil && !delivery.DataCadastro.Before(*filter.DataFim):
+		return false
+	}
+	return true
+}
+
+// compareDeliveries compara duas entregas pela coluna de ordenação informada.
+func compareDeliveries(a, b models.Delivery, column string) int {
+	switch column {
+	case "cliente_id":
+		return a.ClienteID - b.ClienteID
+	case "peso":
+		return compareFloats(a.Peso, b.Peso)
+	case "cidade":
+		return strings.Compare(utils.Fold(a.Cidade), utils.Fold(b.Cidade))
+	case "estado":
+		return strings.Compare(utils.Fold(a.Estado), utils.Fold(b.Estado))
+	case "bairro":
+		return strings.Compare(utils.Fold(a.Bairro), utils.Fold(b.Bairro))
+	case "status":
+		return strings.Compare(a.Status, b.Status)
+	case "data_cadastro":
+		return a.DataCadastro.Compare(b.DataCadastro)
+	}
+	return 0
+}
+
+// compareFloats compara dois números, retornando -1, 0 ou 1.
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// FindByID busca uma entrega do embarcador pelo ID, retornando nil se ela não existir.
+func (r *MemoryDeliveryRepository) FindByID(id int) (*models.Delivery, error) {
+	r.DB.mu.RLock()
+	defer r.DB.mu.RUnlock()
+
+	delivery, ok := r.find(id)
+	if !ok {
+		return nil, nil
+	}
+	return &delivery, nil
+}
+
+// FindByTrackingCode busca uma entrega do embarcador pelo código de rastreio, retornando nil se ela não existir.
+func (r *MemoryDeliveryRepository) FindByTrackingCode(code string) (*models.Delivery, error) {
+	r.DB.mu.RLock()
+	defer r.DB.mu.RUnlock()
+
+	delivery := r.DB.findDeliveryByTrackingCode(code)
+	if delivery == nil || !inTenant(r.EmbarcadorID, delivery.EmbarcadorID) {
+		return nil, nil
+	}
+	return delivery, nil
+}
+
+// FindByCity busca as entregas do embarcador por cidade, ignorando acentos e maiúsculas.
+func (r *MemoryDeliveryRepository) FindByCity(cidade string) ([]models.Delivery, error) {
+	r.DB.mu.RLock()
+	defer r.DB.mu.RUnlock()
+
+	var deliveries []models.Delivery
+	for _, delivery := range r.DB.deliveries {
+		if inTenant(r.EmbarcadorID, delivery.EmbarcadorID) && utils.Fold(delivery.Cidade) == utils.Fold(cidade) {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+	sort.Slice(deliveries, func(i, j int) bool { return deliveries[i].ID < deliveries[j].ID })
+	return deliveries, nil
+}
+
+// FindInBoundingBox busca as entregas do embarcador cujas coordenadas estão dentro do retângulo informado,
+// opcionalmente filtrando pelo status.
+func (r *MemoryDeliveryRepository) FindInBoundingBox(box models.BoundingBox, status string) ([]models.Delivery, error) {
+	r.DB.mu.RLock()
+	defer r.DB.mu.RUnlock()
+
+	deliveries := []models.Delivery{}
+	for _, delivery := range r.DB.deliveries {
+		inside := delivery.Latitude >= box.MinLat && delivery.Latitude <= box.MaxLat && delivery.Longitude >= box.MinLng && delivery.Longitude <= box.MaxLng
+		if inside && inTenant(r.EmbarcadorID, delivery.EmbarcadorID) && (status == "" || delivery.Status == status) {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+	return deliveries, nil
+}
+
+// Update atualiza os dados de uma entrega, retornando ErrNotFound se ela não existir no embarcador e
+// ErrForeignKey se o cliente (no embarcador) ou a zona não existirem. O status e o código de rastreio não são
+// alterados; o embarcador acompanha o do cliente.
+func (r *MemoryDeliveryRepository) Update(id int, delivery models.Delivery) error {
+	r.DB.mu.Lock()
+	defer r.DB.mu.Unlock()
+
+	current, ok := r.find(id)
+	if !ok {
+		return ErrNotFound
+	}
+	embarcadorID, err := r.clientTenant(delivery.ClienteID)
+	if err != nil {
+		return err
+	}
+	if err := r.DB.checkZoneExists(delivery.ZonaID); err != nil {
+		return err
+	}
+	delivery.ID = id
+	delivery.EmbarcadorID = embarcadorID
+	delivery.CodigoRastreio = current.CodigoRastreio
+	delivery.Status = current.Status
+	delivery.MotoristaID = current.MotoristaID
+	delivery.ForaDeZona = delivery.ZonaID == nil
+	delivery.DataCadastro = current.DataCadastro
+	delivery.UltimoEvento = nil
+	r.DB.deliveries[id] = delivery
+	return nil
+}
+
+// UpdateStatus altera o status de uma entrega de from para to, retornando ErrConflict se o status atual não
+// for from e ErrNotFound se a entrega não existir no embarcador.
+func (r *MemoryDeliveryRepository) UpdateStatus(id int, from, to string) error {
+	r.DB.mu.Lock()
+	defer r.DB.mu.Unlock()
+
+	delivery, ok := r.find(id)
+	if !ok {
+		return ErrNotFound
+	}
+	if delivery.Status != from {
+		return fmt.Errorf("%w: o status da entrega é '%s'", ErrConflict, delivery.Status)
+	}
+	delivery.Status = to
+	r.DB.deliveries[id] = delivery
+	return nil
+}
+
+// UpdateDriver atribui a entrega ao motorista informado, ou remove a atribuição se motoristaID for nil.
+func (r *MemoryDeliveryRepository) UpdateDriver(id int, motoristaID *int) error {
+	r.DB.mu.Lock()
+	defer r.DB.mu.Unlock()
+
+	// Respeita a chave estrangeira para Motorista
+	if motoristaID != nil {
+		if _, ok := r.DB.drivers[*motoristaID]; !ok {
+			return fmt.Errorf("%w: motorista não encontrado", ErrForeignKey)
+		}
+	}
+	delivery, ok := r.find(id)
+	if !ok {
+		return ErrNotFound
+	}
+	delivery.MotoristaID = motoristaID
+	r.DB.deliveries[id] = delivery
+	return nil
+}
+
+// UpdateZone associa a entrega à zona informada, ou a marca como fora de todas as zonas se zonaID for nil.
+func (r *MemoryDeliveryRepository) UpdateZone(id int, zonaID *int) error {
+	r.DB.mu.Lock()
+	defer r.DB.mu.Unlock()
+
+	if err := r.DB.checkZoneExists(zonaID); err != nil {
+		return err
+	}
+	delivery, ok := r.find(id)
+	if !ok {
+		return ErrNotFound
+	}
+	delivery.ZonaID = zonaID
+	delivery.ForaDeZona = zonaID == nil
+	r.DB.deliveries[id] = delivery
+	return nil
+}
+
+// Delete remove uma entrega e o seu histórico de rastreamento, retornando ErrNotFound se ela não existir no
+// embarcador.
+func (r *MemoryDeliveryRepository) Delete(id int) error {
+	r.DB.mu.Lock()
+	defer r.DB.mu.Unlock()
+
+	if _, ok := r.find(id); !ok {
+		return ErrNotFound
+	}
+	r.DB.deleteDelivery(id)
+	return nil
+}
+
+// find busca uma entrega do embarcador pelo ID. Deve ser chamado com o mutex bloqueado.
+func (r *MemoryDeliveryRepository) find(id int) (models.Delivery, bool) {
+	delivery, ok := r.DB.deliveries[id]
+	return delivery, ok && inTenant(r.EmbarcadorID, delivery.EmbarcadorID)
+}
+
+// clientTenant retorna o embarcador do cliente, ou ErrForeignKey se o cliente não existir no embarcador do
+// repositório. Deve ser chamado com o mutex bloqueado.
+func (r *MemoryDeliveryRepository) clientTenant(clienteID int) (int, error) {
+	client, ok := r.DB.clients[clienteID]
+	if !ok || !inTenant(r.EmbarcadorID, client.EmbarcadorID) {
+		return 0, fmt.Errorf("%w: cliente não encontrado", ErrForeignKey)
+	}
+	return client.EmbarcadorID, nil
+}
+
+// findDeliveryByTrackingCode busca uma entrega pelo código de rastreio. Deve ser chamado com o mutex bloqueado.
+func (db *MemoryDB) findDeliveryByTrackingCode(code string) *models.Delivery {
+	for _, delivery := range db.deliveries {
+		if delivery.CodigoRastreio == code {
+			return &delivery
+		}
+	}
+	return nil
+}
+
+// deleteDelivery remove uma entrega e, como o ON DELETE CASCADE, os seus eventos. Deve ser chamado com o mutex bloqueado para escrita.
+func (db *MemoryDB) deleteDelivery(id int) {
+	delete(db.deliveries, id)
+
+	events := db.events[:0]
+	for _, event := range db.events {
+		if event.EntregaID != id {
+			events = append(events, event)
+		}
+	}
+	db.events = events
+}
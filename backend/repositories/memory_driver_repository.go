@@ -0,0 +1,150 @@
+package repositories
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/utils"
+)
+
+// MemoryDriverRepository implementa DriverStore mantendo os motoristas em memória.
+type MemoryDriverRepository struct {
+	DB *MemoryDB // Banco de dados em memória compartilhado
+}
+
+// Create insere um novo motorista, rejeitando CPF e CNH duplicados como as restrições UNIQUE da tabela.
+func (r *MemoryDriverRepository) Create(driver *models.Motorista) error {
+	r.DB.mu.Lock()
+	defer r.DB.mu.Unlock()
+
+	if err := r.DB.checkDriverUnique(*driver); err != nil {
+		return err
+	}
+
+	driver.ID = r.DB.nextID("Motorista")
+	driver.DataCadastro = time.Now()
+	r.DB.drivers[driver.ID] = *driver
+	return nil
+}
+
+// List retorna uma página de motoristas em ordem alfabética, junto com o total que atende ao filtro.
+func (r *MemoryDriverRepository) List(filter models.DriverFilter) ([]models.Motorista, int, error) {
+	r.DB.mu.RLock()
+	defer r.DB.mu.RUnlock()
+
+	drivers := []models.Motorista{}
+	for _, driver := range r.DB.drivers {
+		if filter.Ativo == nil || driver.Ativo == *filter.Ativo {
+			drivers = append(drivers, driver)
+		}
+	}
+	sort.Slice(drivers, func(i, j int) bool {
+		if cmp := strings.Compare(utils.Fold(drivers[i].Nome), utils.Fold(drivers[j].Nome)); cmp != 0 {
+			return cmp < 0
+		}
+		return drivers[i].ID < drivers[j].ID
+	})
+
+	page := paginate(drivers, filter.Offset(), filter.PageSize)
+	if page == nil {
+		page = []models.Motorista{}
+	}
+	return page, len(drivers), nil
+}
+
+// FindByID busca um motorista pelo ID, retornando nil se ele não existir.
+func (r *MemoryDriverRepository) FindByID(id int) (*models.Motorista, error) {
+	r.DB.mu.RLock()
+	defer r.DB.mu.RUnlock()
+
+	driver, ok := r.DB.drivers[id]
+	if !ok {
+		return nil, nil
+	}
+	return &driver, nil
+}
+
+// FindByCPF busca um motorista pelo CPF, retornando nil se ele não existir.
+func (r *MemoryDriverRepository) FindByCPF(cpf string) (*models.Motorista, error) {
+	return r.findOne(func(driver models.Motorista) bool { return driver.CPF == cpf })
+}
+
+// FindByCNH busca um motorista pelo número da CNH, retornando nil se ele não existir.
+func (r *MemoryDriverRepository) FindByCNH(numero string) (*models.Motorista, error) {
+	return r.findOne(func(driver models.Motorista) bool { return driver.CNHNumero == numero })
+}
+
+// findOne busca o primeiro motorista que atende à condição informada.
+func (r *MemoryDriverRepository) findOne(match func(models.Motorista) bool) (*models.Motorista, error) {
+	r.DB.mu.RLock()
+	defer r.DB.mu.RUnlock()
+
+	for _, driver := range r.DB.drivers {
+		if match(driver) {
+			return &driver, nil
+		}
+	}
+	return nil, nil
+}
+
+// Update atualiza os dados de um motorista existente, preservando a data de cadastro.
+func (r *MemoryDriverRepository) Update(driver *models.Motorista) error {
+	r.DB.mu.Lock()
+	defer r.DB.mu.Unlock()
+
+	existing, ok := r.DB.drivers[driver.ID]
+	if !ok {
+		return ErrNotFound
+	}
+	if err := r.DB.checkDriverUnique(*driver); err != nil {
+		return err
+	}
+	updated := *driver
+	updated.DataCadastro = existing.DataCadastro
+	r.DB.drivers[driver.ID] = updated
+	return nil
+}
+
+// Delete remove um motorista e desfaz a atribuição das suas entregas e o vínculo dos seus usuários (como o
+// ON DELETE SET NULL), retornando ErrNotFound se ele não existir.
+func (r *MemoryDriverRepository) Delete(id int) error {
+	r.DB.mu.Lock()
+	defer r.DB.mu.Unlock()
+
+	if _, ok := r.DB.drivers[id]; !ok {
+		return ErrNotFound
+	}
+	for deliveryID, delivery := range r.DB.deliveries {
+		if delivery.MotoristaID != nil && *delivery.MotoristaID == id {
+			delivery.MotoristaID = nil
+			r.DB.deliveries[deliveryID] = delivery
+		}
+	}
+	for userID, user := range r.DB.users {
+		if user.MotoristaID != nil && *user.MotoristaID == id {
+			user.MotoristaID = nil
+			r.DB.users[userID] = user
+		}
+	}
+	delete(r.DB.drivers, id)
+	return nil
+}
+
+// checkDriverUnique verifica se o CPF e a CNH não pertencem a outro motorista. Deve ser chamado com o mutex bloqueado.
+func (db *MemoryDB) checkDriverUnique(driver models.Motorista) error {
+	for _, other := range db.drivers {
+		if other.ID == driver.ID {
+			continue
+		}
+		if other.CPF == driver.CPF {
+			return fmt.Errorf("%w: CPF já cadastrado", ErrConflict)
+		}
+		if other.CNHNumero == driver.CNHNumero {
+			return fmt.Errorf("%w: CNH já cadastrada", ErrConflict)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/utils"
+)
+
+// MemoryShipperRepository implementa ShipperStore mantendo os embarcadores em memória.
+type MemoryShipperRepository struct {
+	DB *MemoryDB // Banco de dados em memória compartilhado
+}
+
+// Create insere um novo embarcador, rejeitando nomes duplicados (sem diferenciar acentos e maiúsculas) como a
+// restrição UNIQUE da tabela.
+func (r *MemoryShipperRepository) Create(shipper *models.Embarcador) error {
+	r.DB.mu.Lock()
+	defer r.DB.mu.Unlock()
+
+	for _, other := range r.DB.shippers {
+		if utils.Fold(other.Nome) == utils.Fold(shipper.Nome) {
+			return fmt.Errorf("%w: embarcador já cadastrado", ErrConflict)
+		}
+	}
+
+	shipper.ID = r.DB.nextID("Embarcador")
+	shipper.DataCadastro = time.Now()
+	r.DB.shippers[shipper.ID] = *shipper
+	return nil
+}
+
+// List retorna todos os embarcadores, em ordem de cadastro.
+func (r *MemoryShipperRepository) List() ([]models.Embarcador, error) {
+	r.DB.mu.RLock()
+	defer r.DB.mu.RUnlock()
+
+	shippers := []models.Embarcador{}
+	for _, shipper := range r.DB.shippers {
+		shippers = append(shippers, shipper)
+	}
+	sort.Slice(shippers, func(i, j int) bool { return shippers[i].ID < shippers[j].ID })
+	return shippers, nil
+}
+
+// FindByID busca um embarcador pelo ID, retornando nil se ele não existir.
+func (r *MemoryShipperRepository) FindByID(id int) (*models.Embarcador, error) {
+	r.DB.mu.RLock()
+	defer r.DB.mu.RUnlock()
+
+	shipper, ok := r.DB.shippers[id]
+	if !ok {
+		return nil, nil
+	}
+	return &shipper, nil
+}
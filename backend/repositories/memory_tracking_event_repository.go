@@ -0,0 +1,54 @@
+package repositories
+
+import (
+	"fmt"
+
+	"meu-projeto/backend/models"
+)
+
+// MemoryTrackingEventRepository implementa TrackingEventStore mantendo o histórico em memória.
+type MemoryTrackingEventRepository struct {
+	DB *MemoryDB // Banco de dados em memória compartilhado
+}
+
+// Create adiciona um novo evento ao histórico, retornando ErrForeignKey se a entrega não existir.
+func (r *MemoryTrackingEventRepository) Create(event *models.TrackingEvent) error {
+	r.DB.mu.Lock()
+	defer r.DB.mu.Unlock()
+
+	if _, ok := r.DB.deliveries[event.EntregaID]; !ok {
+		return fmt.Errorf("%w: entrega não encontrada", ErrForeignKey)
+	}
+	event.ID = r.DB.nextID("EventoRastreamento")
+	r.DB.events = append(r.DB.events, *event)
+	return nil
+}
+
+// ListByEntrega retorna todos os eventos de uma entrega, do mais antigo para o mais recente.
+func (r *MemoryTrackingEventRepository) ListByEntrega(entregaID int) ([]models.TrackingEvent, error) {
+	r.DB.mu.RLock()
+	defer r.DB.mu.RUnlock()
+
+	// Os eventos são mantidos em ordem de inserção, que é a ordem cronológica
+	events := []models.TrackingEvent{}
+	for _, event := range r.DB.events {
+		if event.EntregaID == entregaID {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+// Latest retorna o evento mais recente de uma entrega, ou nil se ela não tiver eventos.
+func (r *MemoryTrackingEventRepository) Latest(entregaID int) (*models.TrackingEvent, error) {
+	r.DB.mu.RLock()
+	defer r.DB.mu.RUnlock()
+
+	for i := len(r.DB.events) - 1; i >= 0; i-- {
+		if r.DB.events[i].EntregaID == entregaID {
+			event := r.DB.events[i]
+			return &event, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,75 @@
+package repositories
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"meu-projeto/backend/models"
+)
+
+// MemoryUserRepository implementa UserStore mantendo os usuários em memória.
+type MemoryUserRepository struct {
+	DB *MemoryDB // Banco de dados em memória compartilhado
+}
+
+// Create insere um novo usuário, rejeitando e-mails duplicados e motoristas inexistentes como as restrições
+// da tabela.
+func (r *MemoryUserRepository) Create(user *models.Usuario) error {
+	r.DB.mu.Lock()
+	defer r.DB.mu.Unlock()
+
+	if r.DB.findUserByEmail(user.Email) != nil {
+		return fmt.Errorf("%w: e-mail já cadastrado", ErrConflict)
+	}
+	if user.MotoristaID != nil {
+		if _, ok := r.DB.drivers[*user.MotoristaID]; !ok {
+			return fmt.Errorf("%w: motorista não encontrado", ErrForeignKey)
+		}
+	}
+
+	user.ID = r.DB.nextID("Usuario")
+	user.EmbarcadorID = tenantOf(0, user.EmbarcadorID)
+	user.DataCadastro = time.Now()
+	r.DB.users[user.ID] = *user
+	return nil
+}
+
+// Count retorna o total de usuários cadastrados.
+func (r *MemoryUserRepository) Count() (int, error) {
+	r.DB.mu.RLock()
+	defer r.DB.mu.RUnlock()
+
+	return len(r.DB.users), nil
+}
+
+// FindByID busca um usuário pelo ID, retornando nil se ele não existir.
+func (r *MemoryUserRepository) FindByID(id int) (*models.Usuario, error) {
+	r.DB.mu.RLock()
+	defer r.DB.mu.RUnlock()
+
+	user, ok := r.DB.users[id]
+	if !ok {
+		return nil, nil
+	}
+	return &user, nil
+}
+
+// FindByEmail busca um usuário pelo e-mail, retornando nil se ele não existir.
+func (r *MemoryUserRepository) FindByEmail(email string) (*models.Usuario, error) {
+	r.DB.mu.RLock()
+	defer r.DB.mu.RUnlock()
+
+	return r.DB.findUserByEmail(email), nil
+}
+
+// findUserByEmail busca um usuário pelo e-mail, sem diferenciar maiúsculas e minúsculas como a collation da
+// tabela. Deve ser chamado com o mutex bloqueado.
+func (db *MemoryDB) findUserByEmail(email string) *models.Usuario {
+	for _, user := range db.users {
+		if strings.EqualFold(user.Email, email) {
+			return &user
+		}
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package repositories
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"meu-projeto/backend/models"
+)
+
+// MemoryVehicleRepository implementa VehicleStore mantendo os veículos em memória.
+type MemoryVehicleRepository struct {
+	DB *MemoryDB // Banco de dados em memória compartilhado
+}
+
+// Create insere um novo veículo, rejeitando placas duplicadas como a restrição UNIQUE da tabela.
+func (r *MemoryVehicleRepository) Create(vehicle *models.Vehicle) error {
+	r.DB.mu.Lock()
+	defer r.DB.mu.Unlock()
+
+	if r.DB.findVehicleByPlate(vehicle.Placa) != nil {
+		return fmt.Errorf("%w: placa já cadastrada", ErrConflict)
+	}
+
+	vehicle.ID = r.DB.nextID("Veiculo")
+	vehicle.DataCadastro = time.Now()
+	r.DB.vehicles[vehicle.ID] = *vehicle
+	return nil
+}
+
+// List retorna uma página de veículos, junto com o total de veículos que atendem ao filtro.
+func (r *MemoryVehicleRepository) List(filter models.VehicleFilter) ([]models.Vehicle, int, error) {
+	r.DB.mu.RLock()
+	defer r.DB.mu.RUnlock()
+
+	vehicles := []models.Vehicle{}
+	for _, vehicle := range r.DB.vehicles {
+		if filter.Ativo == nil || vehicle.Ativo == *filter.Ativo {
+			vehicles = append(vehicles, vehicle)
+		}
+	}
+	sort.Slice(vehicles, func(i, j int) bool { return vehicles[i].ID < vehicles[j].ID })
+
+	page := paginate(vehicles, filter.Offset(), filter.PageSize)
+	if page == nil {
+		page = []models.Vehicle{}
+	}
+	return page, len(vehicles), nil
+}
+
+// FindByID busca um veículo pelo ID, retornando nil se ele não existir.
+func (r *MemoryVehicleRepository) FindByID(id int) (*models.Vehicle, error) {
+	r.DB.mu.RLock()
+	defer r.DB.mu.RUnlock()
+
+	vehicle, ok := r.DB.vehicles[id]
+	if !ok {
+		return nil, nil
+	}
+	return &vehicle, nil
+}
+
+// FindByPlate busca um veículo pela placa, retornando nil se ele não existir.
+func (r *MemoryVehicleRepository) FindByPlate(placa string) (*models.Vehicle, error) {
+	r.DB.mu.RLock()
+	defer r.DB.mu.RUnlock()
+
+	return r.DB.findVehicleByPlate(placa), nil
+}
+
+// Update atualiza os dados de um veículo existente, preservando a data de cadastro. Retorna ErrNotFound se
+// ele não existir e ErrConflict se a placa pertencer a outro veículo.
+func (r *MemoryVehicleRepository) Update(vehicle *models.Vehicle) error {
+	r.DB.mu.Lock()
+	defer r.DB.mu.Unlock()
+
+	existing, ok := r.DB.vehicles[vehicle.ID]
+	if !ok {
+		return ErrNotFound
+	}
+	if other := r.DB.findVehicleByPlate(vehicle.Placa); other != nil && other.ID != vehicle.ID {
+		return fmt.Errorf("%w: placa já cadastrada", ErrConflict)
+	}
+	updated := *vehicle
+	updated.DataCadastro = existing.DataCadastro
+	r.DB.vehicles[vehicle.ID] = updated
+	return nil
+}
+
+// Delete remove um veículo, retornando ErrNotFound se ele não existir.
+func (r *MemoryVehicleRepository) Delete(id int) error {
+	r.DB.mu.Lock()
+	defer r.DB.mu.Unlock()
+
+	if _, ok := r.DB.vehicles[id]; !ok {
+		return ErrNotFound
+	}
+	delete(r.DB.vehicles, id)
+	return nil
+}
+
+// findVehicleByPlate busca um veículo pela placa. Deve ser chamado com o mutex bloqueado.
+func (db *MemoryDB) findVehicleByPlate(placa string) *models.Vehicle {
+	for _, vehicle := range db.vehicles {
+		if vehicle.Placa == placa {
+			return &vehicle
+		}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package repositories
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/utils"
+)
+
+// MemoryZoneRepository implementa ZoneStore mantendo as zonas em memória.
+type MemoryZoneRepository struct {
+	DB *MemoryDB // Banco de dados em memória compartilhado
+}
+
+// Create insere uma nova zona, rejeitando nomes duplicados como a restrição UNIQUE da tabela.
+func (r *MemoryZoneRepository) Create(zone *models.Zona) error {
+	r.DB.mu.Lock()
+	defer r.DB.mu.Unlock()
+
+	if r.DB.findZoneByName(zone.Nome) != nil {
+		return fmt.Errorf("%w: nome de zona já cadastrado", ErrConflict)
+	}
+
+	zone.ID = r.DB.nextID("Zona")
+	zone.DataCadastro = time.Now()
+	r.DB.zones[zone.ID] = *zone
+	return nil
+}
+
+// List retorna todas as zonas, em ordem de cadastro.
+func (r *MemoryZoneRepository) List() ([]models.Zona, error) {
+	r.DB.mu.RLock()
+	defer r.DB.mu.RUnlock()
+
+	zones := []models.Zona{}
+	for _, zone := range r.DB.zones {
+		zones = append(zones, zone)
+	}
+	sort.Slice(zones, func(i, j int) bool { return zones[i].ID < zones[j].ID })
+	return zones, nil
+}
+
+// FindByID busca uma zona pelo ID, retornando nil se ela não existir.
+func (r *MemoryZoneRepository) FindByID(id int) (*models.Zona, error) {
+	r.DB.mu.RLock()
+	defer r.DB.mu.RUnlock()
+
+	zone, ok := r.DB.zones[id]
+	if !ok {
+		return nil, nil
+	}
+	return &zone, nil
+}
+
+// FindByName busca uma zona pelo nome, ignorando acentos e maiúsculas, retornando nil se ela não existir.
+func (r *MemoryZoneRepository) FindByName(nome string) (*models.Zona, error) {
+	r.DB.mu.RLock()
+	defer r.DB.mu.RUnlock()
+
+	return r.DB.findZoneByName(nome), nil
+}
+
+// Update atualiza o nome e o polígono de uma zona existente, preservando a data de cadastro. Retorna
+// ErrNotFound se ela não existir e ErrConflict se o nome pertencer a outra zona.
+func (r *MemoryZoneRepository) Update(zone *models.Zona) error {
+	r.DB.mu.Lock()
+	defer r.DB.mu.Unlock()
+
+	existing, ok := r.DB.zones[zone.ID]
+	if !ok {
+		return ErrNotFound
+	}
+	if other := r.DB.findZoneByName(zone.Nome); other != nil && other.ID != zone.ID {
+		return fmt.Errorf("%w: nome de zona já cadastrado", ErrConflict)
+	}
+	updated := *zone
+	updated.DataCadastro = existing.DataCadastro
+	r.DB.zones[zone.ID] = updated
+	return nil
+}
+
+// Delete remove uma zona, retornando ErrNotFound se ela não existir. Como o ON DELETE SET NULL, as entregas
+// da zona ficam sem zona.
+func (r *MemoryZoneRepository) Delete(id int) error {
+	r.DB.mu.Lock()
+	defer r.DB.mu.Unlock()
+
+	if _, ok := r.DB.zones[id]; !ok {
+		return ErrNotFound
+	}
+	for deliveryID, delivery := range r.DB.deliveries {
+		if delivery.ZonaID != nil && *delivery.ZonaID == id {
+			delivery.ZonaID = nil
+			delivery.ForaDeZona = true
+			r.DB.deliveries[deliveryID] = delivery
+		}
+	}
+	delete(r.DB.zones, id)
+	return nil
+}
+
+// findZoneByName busca uma zona pelo nome, como a collation da tabela. Deve ser chamado com o mutex bloqueado.
+func (db *MemoryDB) findZoneByName(nome string) *models.Zona {
+	for _, zone := range db.zones {
+		if utils.Fold(zone.Nome) == utils.Fold(nome) {
+			return &zone
+		}
+	}
+	return nil
+}
+
+// checkZoneExists respeita a chave estrangeira de Entrega para Zona. Deve ser chamado com o mutex bloqueado.
+func (db *MemoryDB) checkZoneExists(zonaID *int) error {
+	if zonaID != nil {
+		if _, ok := db.zones[*zonaID]; !ok {
+			return fmt.Errorf("%w: zona não encontrada", ErrForeignKey)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package repositories
+
+import (
+	"database/sql"
+	"meu-projeto/backend/models"
+)
+
+// ShipperRepository é uma estrutura que contém métodos para interagir com a tabela de embarcadores no banco de dados.
+type ShipperRepository struct {
+	DB *sql.DB // Conexão com o banco de dados
+}
+
+// Create insere um novo embarcador no banco de dados.
+func (r *ShipperRepository) Create(shipper *models.Embarcador) error {
+	// Executa a query para inserir o novo embarcador
+	result, err := r.DB.Exec("INSERT INTO Embarcador (nome) VALUES (?)", shipper.Nome)
+	if err != nil {
+		return translateError(err) // Retorna ErrConflict se o nome já estiver cadastrado
+	}
+
+	// Obtém o ID gerado para o novo embarcador
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err // Retorna erro se não for possível obter o ID
+	}
+
+	// Busca o embarcador recém-criado para obter a data de cadastro gerada pelo banco
+	created, err := r.FindByID(int(id))
+	if err != nil {
+		return err
+	}
+	*shipper = *created
+	return nil
+}
+
+// List retorna todos os embarcadores, em ordem de cadastro.
+func (r *ShipperRepository) List() ([]models.Embarcador, error) {
+	// Query SQL para selecionar todos os embarcadores
+	rows, err := r.DB.Query("SELECT id, nome, data_cadastro FROM Embarcador ORDER BY id")
+	if err != nil {
+		return nil, err // Retorna erro se a query falhar
+	}
+	defer rows.Close() // Garante que as linhas sejam fechadas após o uso
+
+	shippers := []models.Embarcador{}
+	// Itera sobre as linhas retornadas pela query
+	for rows.Next() {
+		var shipper models.Embarcador
+		if err := rows.Scan(&shipper.ID, &shipper.Nome, &shipper.DataCadastro); err != nil {
+			return nil, err // Retorna erro se o scan falhar
+		}
+		// Adiciona o embarcador à lista
+		shippers = append(shippers, shipper)
+	}
+	return shippers, rows.Err()
+}
+
+// FindByID busca um embarcador pelo ID no banco de dados, retornando nil se ele não existir.
+func (r *ShipperRepository) FindByID(id int) (*models.Embarcador, error) {
+	var shipper models.Embarcador
+	err := r.DB.QueryRow("SELECT id, nome, data_cadastro FROM Embarcador WHERE id = ?", id).Scan(&shipper.ID, &shipper.Nome, &shipper.DataCadastro)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Retorna nil se o embarcador não for encontrado
+		}
+		return nil, err // Retorna erro se houver outro problema
+	}
+	return &shipper, nil
+}
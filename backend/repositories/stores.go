@@ -0,0 +1,153 @@
+package repositories
+
+import (
+	"database/sql"
+	"time"
+
+	"meu-projeto/backend/models"
+)
+
+// DeliveryStore define as operações de persistência de entregas usadas pelos serviços. As operações são
+// restritas ao embarcador do repositório (ver ForTenant).
+type DeliveryStore interface {
+	ForTenant(embarcadorID int) DeliveryStore
+	FindByCPF(cpf string) (*models.Cliente, error)
+	CreateCliente(cliente models.Cliente) (int64, error)
+	Create(delivery models.Delivery) (int64, error)
+	List(filter models.DeliveryFilter) ([]models.Delivery, int, error)
+	FindByID(id int) (*models.Delivery, error)
+	FindByTrackingCode(code string) (*models.Delivery, error)
+	FindByCity(cidade string) ([]models.Delivery, error)
+	FindInBoundingBox(box models.BoundingBox, status string) ([]models.Delivery, error)
+	Update(id int, delivery models.Delivery) error
+	UpdateStatus(id int, from, to string) error
+	UpdateDriver(id int, motoristaID *int) error
+	UpdateZone(id int, zonaID *int) error
+	Delete(id int) error
+}
+
+// ClientStore define as operações de persistência de clientes usadas pelos serviços. As operações são
+// restritas ao embarcador do repositório (ver ForTenant).
+type ClientStore interface {
+	ForTenant(embarcadorID int) ClientStore
+	Create(client *models.Cliente) error
+	List(filter models.ClientFilter) ([]models.Cliente, int, error)
+	FindByID(id int) (*models.Cliente, error)
+	Update(client *models.Cliente) error
+	Delete(clientID int) error
+}
+
+// TrackingEventStore define as operações de persistência do histórico de rastreamento.
+type TrackingEventStore interface {
+	Create(event *models.TrackingEvent) error
+	ListByEntrega(entregaID int) ([]models.TrackingEvent, error)
+	Latest(entregaID int) (*models.TrackingEvent, error)
+}
+
+// VehicleStore define as operações de persistência dos veículos da frota.
+type VehicleStore interface {
+	Create(vehicle *models.Vehicle) error
+	List(filter models.VehicleFilter) ([]models.Vehicle, int, error)
+	FindByID(id int) (*models.Vehicle, error)
+	FindByPlate(placa string) (*models.Vehicle, error)
+	Update(vehicle *models.Vehicle) error
+	Delete(id int) error
+}
+
+// DriverStore define as operações de persistência dos motoristas.
+type DriverStore interface {
+	Create(driver *models.Motorista) error
+	List(filter models.DriverFilter) ([]models.Motorista, int, error)
+	FindByID(id int) (*models.Motorista, error)
+	FindByCPF(cpf string) (*models.Motorista, error)
+	FindByCNH(numero string) (*models.Motorista, error)
+	Update(driver *models.Motorista) error
+	Delete(id int) error
+}
+
+// ZoneStore define as operações de persistência das zonas de entrega.
+type ZoneStore interface {
+	Create(zone *models.Zona) error
+	List() ([]models.Zona, error)
+	FindByID(id int) (*models.Zona, error)
+	FindByName(nome string) (*models.Zona, error)
+	Update(zone *models.Zona) error
+	Delete(id int) error
+}
+
+// UserStore define as operações de persistência dos usuários da API.
+type UserStore interface {
+	Create(user *models.Usuario) error
+	Count() (int, error)
+	FindByID(id int) (*models.Usuario, error)
+	FindByEmail(email string) (*models.Usuario, error)
+}
+
+// APIKeyStore define as operações de persistência das chaves de API.
+type APIKeyStore interface {
+	Create(key *models.ChaveAPI) error
+	List() ([]models.ChaveAPI, error)
+	FindByPrefix(prefix string) (*models.ChaveAPI, error)
+	Touch(id int, usedAt time.Time) error
+	Delete(id int) error
+}
+
+// ShipperStore define as operações de persistência dos embarcadores.
+type ShipperStore interface {
+	Create(shipper *models.Embarcador) error
+	List() ([]models.Embarcador, error)
+	FindByID(id int) (*models.Embarcador, error)
+}
+
+// AuditStore define as operações de persistência do registro de auditoria, que é apenas inserido e consultado.
+type AuditStore interface {
+	Create(entry *models.RegistroAuditoria) error
+	List(filter models.AuditFilter) ([]models.RegistroAuditoria, int, error)
+}
+
+// Stores agrupa as implementações de armazenamento usadas pela aplicação.
+type Stores struct {
+	Deliveries DeliveryStore      // Armazenamento de entregas
+	Clients    ClientStore        // Armazenamento de clientes
+	Events     TrackingEventStore // Armazenamento do histórico de rastreamento
+	Vehicles   VehicleStore       // Armazenamento dos veículos da frota
+	Drivers    DriverStore        // Armazenamento dos motoristas
+	Zones      ZoneStore          // Armazenamento das zonas de entrega
+	Users      UserStore          // Armazenamento dos usuários da API
+	APIKeys    APIKeyStore        // Armazenamento das chaves de API das integrações
+	Shippers   ShipperStore       // Armazenamento dos embarcadores
+	Audit      AuditStore         // Armazenamento do registro de auditoria
+}
+
+// NewSQLStores cria os repositórios que persistem os dados no banco de dados informado.
+func NewSQLStores(db *sql.DB) Stores {
+	return Stores{
+		Deliveries: &DeliveryRepository{DB: db},
+		Clients:    &ClientRepository{DB: db},
+		Events:     &TrackingEventRepository{DB: db},
+		Vehicles:   &VehicleRepository{DB: db},
+		Drivers:    &DriverRepository{DB: db},
+		Zones:      &ZoneRepository{DB: db},
+		Users:      &UserRepository{DB: db},
+		APIKeys:    &APIKeyRepository{DB: db},
+		Shippers:   &ShipperRepository{DB: db},
+		Audit:      &AuditRepository{DB: db},
+	}
+}
+
+// NewMemoryStores cria repositórios que mantêm os dados apenas em memória (testes e modo de demonstração).
+func NewMemoryStores() Stores {
+	db := NewMemoryDB()
+	return Stores{
+		Deliveries: &MemoryDeliveryRepository{DB: db},
+		Clients:    &MemoryClientRepository{DB: db},
+		Events:     &MemoryTrackingEventRepository{DB: db},
+		Vehicles:   &MemoryVehicleRepository{DB: db},
+		Drivers:    &MemoryDriverRepository{DB: db},
+		Zones:      &MemoryZoneRepository{DB: db},
+		Users:      &MemoryUserRepository{DB: db},
+		APIKeys:    &MemoryAPIKeyRepository{DB: db},
+		Shippers:   &MemoryShipperRepository{DB: db},
+		Audit:      &MemoryAuditRepository{DB: db},
+	}
+}
@@ -0,0 +1,34 @@
+package repositories
+
+import "meu-projeto/backend/models"
+
+// Os repositórios de clientes e de entregas são restritos a um embarcador pelo campo EmbarcadorID (ver
+// ForTenant): todas as consultas filtram pelo embarcador, de modo que os registros de outro embarcador são
+// tratados como inexistentes. O EmbarcadorID 0 enxerga todos os embarcadores e é usado apenas nas operações
+// internas que os atravessam, como o rastreio público pelo código e a reatribuição das zonas.
+
+// tenantScope retorna a condição que restringe a consulta ao embarcador (a ser acrescentada a um WHERE) e o seu
+// argumento, ou nada se embarcadorID for 0.
+func tenantScope(embarcadorID int) (string, []any) {
+	if embarcadorID == 0 {
+		return "", nil
+	}
+	return " AND embarcador_id = ?", []any{embarcadorID}
+}
+
+// tenantOf retorna o embarcador de um registro inserido: o do repositório ou, no repositório sem embarcador, o
+// informado no registro (o embarcador padrão se não houver).
+func tenantOf(scoped, informed int) int {
+	switch {
+	case scoped != 0:
+		return scoped
+	case informed != 0:
+		return informed
+	}
+	return models.EmbarcadorPadrao
+}
+
+// inTenant informa se um registro do embarcador informado é visível no repositório restrito a embarcadorID.
+func inTenant(embarcadorID, recordTenant int) bool {
+	return embarcadorID == 0 || embarcadorID == recordTenant
+}
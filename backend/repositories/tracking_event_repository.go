@@ -0,0 +1,79 @@
+package repositories
+
+import (
+	"database/sql"
+	"meu-projeto/backend/models"
+)
+
+// TrackingEventRepository é uma estrutura que contém métodos para interagir com a tabela de eventos de rastreamento no banco de dados.
+type TrackingEventRepository struct {
+	DB *sql.DB // Conexão com o banco de dados
+}
+
+// Create insere um novo evento de rastreamento no banco de dados.
+// Os eventos são apenas inseridos, nunca alterados, formando um histórico imutável.
+func (r *TrackingEventRepository) Create(event *models.TrackingEvent) error {
+	// Query SQL para inserir um novo evento
+	query := `INSERT INTO EventoRastreamento (entrega_id, data_hora, status, latitude, longitude, observacao, responsavel)
+              VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	// Executa a query com os valores do evento
+	result, err := r.DB.Exec(query, event.EntregaID, event.DataHora, event.Status, event.Latitude, event.Longitude, event.Observacao, event.Responsavel)
+	if err != nil {
+		return translateError(err) // Retorna ErrForeignKey se a entrega não existir
+	}
+
+	// Obtém o ID gerado para o novo evento
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err // Retorna erro se não for possível obter o ID
+	}
+
+	// Atribui o ID gerado ao evento
+	event.ID = int(id)
+	return nil
+}
+
+// ListByEntrega retorna todos os eventos de uma entrega, do mais antigo para o mais recente.
+func (r *TrackingEventRepository) ListByEntrega(entregaID int) ([]models.TrackingEvent, error) {
+	// Query SQL para selecionar os eventos da entrega em ordem cronológica
+	query := "SELECT id, entrega_id, data_hora, status, latitude, longitude, observacao, responsavel FROM EventoRastreamento WHERE entrega_id = ? ORDER BY data_hora, id"
+
+	// Executa a query
+	rows, err := r.DB.Query(query, entregaID)
+	if err != nil {
+		return nil, err // Retorna erro se a query falhar
+	}
+	defer rows.Close() // Garante que as linhas sejam fechadas após o uso
+
+	events := []models.TrackingEvent{}
+	// Itera sobre as linhas retornadas pela query
+	for rows.Next() {
+		var event models.TrackingEvent
+		// Escaneia os valores da linha para a estrutura TrackingEvent
+		err := rows.Scan(&event.ID, &event.EntregaID, &event.DataHora, &event.Status, &event.Latitude, &event.Longitude, &event.Observacao, &event.Responsavel)
+		if err != nil {
+			return nil, err // Retorna erro se o scan falhar
+		}
+		// Adiciona o evento à lista
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// Latest retorna o evento mais recente de uma entrega.
+func (r *TrackingEventRepository) Latest(entregaID int) (*models.TrackingEvent, error) {
+	var event models.TrackingEvent
+	// Query SQL para selecionar o último evento da entrega
+	query := "SELECT id, entrega_id, data_hora, status, latitude, longitude, observacao, responsavel FROM EventoRastreamento WHERE entrega_id = ? ORDER BY data_hora DESC, id DESC LIMIT 1"
+
+	// Executa a query e escaneia o resultado para a estrutura TrackingEvent
+	err := r.DB.QueryRow(query, entregaID).Scan(&event.ID, &event.EntregaID, &event.DataHora, &event.Status, &event.Latitude, &event.Longitude, &event.Observacao, &event.Responsavel)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Retorna nil se a entrega ainda não tiver eventos
+		}
+		return nil, err // Retorna erro se houver outro problema
+	}
+	return &event, nil
+}
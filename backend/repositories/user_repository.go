@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"database/sql"
+	"meu-projeto/backend/models"
+)
+
+// userColumns são as colunas da tabela Usuario lidas por scanUser, na mesma ordem.
+const userColumns = "id, nome, email, senha_hash, papel, motorista_id, embarcador_id, ativo, data_cadastro"
+
+// scanUser escaneia uma linha com as colunas de userColumns para a estrutura Usuario.
+func scanUser(row rowScanner) (models.Usuario, error) {
+	var user models.Usuario
+	var motoristaID sql.NullInt64
+	err := row.Scan(&user.ID, &user.Nome, &user.Email, &user.SenhaHash, &user.Papel, &motoristaID, &user.EmbarcadorID, &user.Ativo, &user.DataCadastro)
+	if motoristaID.Valid {
+		id := int(motoristaID.Int64)
+		user.MotoristaID = &id
+	}
+	return user, err
+}
+
+// UserRepository é uma estrutura que contém métodos para interagir com a tabela de usuários no banco de dados.
+type UserRepository struct {
+	DB *sql.DB // Conexão com o banco de dados
+}
+
+// Create insere um novo usuário no banco de dados.
+func (r *UserRepository) Create(user *models.Usuario) error {
+	// Query SQL para inserir um novo usuário
+	query := "INSERT INTO Usuario (nome, email, senha_hash, papel, motorista_id, embarcador_id, ativo) VALUES (?, ?, ?, ?, ?, ?, ?)"
+
+	// Executa a query com os valores do usuário
+	result, err := r.DB.Exec(query, user.Nome, user.Email, user.SenhaHash, user.Papel, user.MotoristaID, tenantOf(0, user.EmbarcadorID), user.Ativo)
+	if err != nil {
+		return translateError(err) // Retorna ErrConflict se o e-mail já estiver cadastrado ou ErrForeignKey se o motorista não existir
+	}
+
+	// Obtém o ID gerado para o novo usuário
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err // Retorna erro se não for possível obter o ID
+	}
+
+	// Busca o usuário recém-criado para obter a data de cadastro gerada pelo banco
+	created, err := r.FindByID(int(id))
+	if err != nil {
+		return err
+	}
+	*user = *created
+	return nil
+}
+
+// Count retorna o total de usuários cadastrados.
+func (r *UserRepository) Count() (int, error) {
+	var total int
+	err := r.DB.QueryRow("SELECT COUNT(*) FROM Usuario").Scan(&total)
+	return total, err
+}
+
+// FindByID busca um usuário pelo ID no banco de dados.
+func (r *UserRepository) FindByID(id int) (*models.Usuario, error) {
+	return r.findOne("id = ?", id)
+}
+
+// FindByEmail busca um usuário pelo e-mail no banco de dados.
+func (r *UserRepository) FindByEmail(email string) (*models.Usuario, error) {
+	return r.findOne("email = ?", email)
+}
+
+// findOne busca o usuário que atende à condição, retornando nil se ele não existir.
+func (r *UserRepository) findOne(where string, arg any) (*models.Usuario, error) {
+	user, err := scanUser(r.DB.QueryRow("SELECT "+userColumns+" FROM Usuario WHERE "+where, arg))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Retorna nil se o usuário não for encontrado
+		}
+		return nil, err // Retorna erro se houver outro problema
+	}
+	return &user, nil
+}
@@ -0,0 +1,122 @@
+package repositories
+
+import (
+	"database/sql"
+	"meu-projeto/backend/models"
+)
+
+// vehicleColumns são as colunas da tabela Veiculo lidas por scanVehicle, na mesma ordem.
+const vehicleColumns = "id, placa, tipo, capacidade_peso, capacidade_volume, ativo, data_cadastro"
+
+// scanVehicle escaneia uma linha com as colunas de vehicleColumns para a estrutura Vehicle.
+func scanVehicle(row rowScanner) (models.Vehicle, error) {
+	var vehicle models.Vehicle
+	err := row.Scan(&vehicle.ID, &vehicle.Placa, &vehicle.Tipo, &vehicle.CapacidadePeso, &vehicle.CapacidadeVolume, &vehicle.Ativo, &vehicle.DataCadastro)
+	return vehicle, err
+}
+
+// VehicleRepository é uma estrutura que contém métodos para interagir com a tabela de veículos no banco de dados.
+type VehicleRepository struct {
+	DB *sql.DB // Conexão com o banco de dados
+}
+
+// Create insere um novo veículo no banco de dados.
+func (r *VehicleRepository) Create(vehicle *models.Vehicle) error {
+	// Query SQL para inserir um novo veículo
+	query := "INSERT INTO Veiculo (placa, tipo, capacidade_peso, capacidade_volume, ativo) VALUES (?, ?, ?, ?, ?)"
+
+	// Executa a query com os valores do veículo
+	result, err := r.DB.Exec(query, vehicle.Placa, vehicle.Tipo, vehicle.CapacidadePeso, vehicle.CapacidadeVolume, vehicle.Ativo)
+	if err != nil {
+		return translateError(err) // Retorna ErrConflict se a placa já estiver cadastrada
+	}
+
+	// Obtém o ID gerado para o novo veículo
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err // Retorna erro se não for possível obter o ID
+	}
+
+	// Busca o veículo recém-criado para obter a data de cadastro gerada pelo banco
+	created, err := r.FindByID(int(id))
+	if err != nil {
+		return err
+	}
+	*vehicle = *created
+	return nil
+}
+
+// List retorna uma página de veículos, junto com o total de veículos que atendem ao filtro.
+func (r *VehicleRepository) List(filter models.VehicleFilter) ([]models.Vehicle, int, error) {
+	where := ""
+	var args []any
+	if filter.Ativo != nil {
+		where = " WHERE ativo = ?"
+		args = append(args, *filter.Ativo)
+	}
+
+	// Conta o total de veículos que atendem ao filtro
+	var total int
+	if err := r.DB.QueryRow("SELECT COUNT(*) FROM Veiculo"+where, args...).Scan(&total); err != nil {
+		return nil, 0, err // Retorna erro se a contagem falhar
+	}
+
+	// Query SQL para selecionar a página de veículos
+	rows, err := r.DB.Query("SELECT "+vehicleColumns+" FROM Veiculo"+where+" ORDER BY id LIMIT ? OFFSET ?", append(args, filter.PageSize, filter.Offset())...)
+	if err != nil {
+		return nil, 0, err // Retorna erro se a query falhar
+	}
+	defer rows.Close() // Garante que as linhas sejam fechadas após o uso
+
+	vehicles := []models.Vehicle{}
+	// Itera sobre as linhas retornadas pela query
+	for rows.Next() {
+		vehicle, err := scanVehicle(rows)
+		if err != nil {
+			return nil, 0, err // Retorna erro se o scan falhar
+		}
+		// Adiciona o veículo à lista
+		vehicles = append(vehicles, vehicle)
+	}
+	return vehicles, total, rows.Err()
+}
+
+// FindByID busca um veículo pelo ID no banco de dados.
+func (r *VehicleRepository) FindByID(id int) (*models.Vehicle, error) {
+	vehicle, err := scanVehicle(r.DB.QueryRow("SELECT "+vehicleColumns+" FROM Veiculo WHERE id = ?", id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Retorna nil se o veículo não for encontrado
+		}
+		return nil, err // Retorna erro se houver outro problema
+	}
+	return &vehicle, nil
+}
+
+// FindByPlate busca um veículo pela placa no banco de dados.
+func (r *VehicleRepository) FindByPlate(placa string) (*models.Vehicle, error) {
+	vehicle, err := scanVehicle(r.DB.QueryRow("SELECT "+vehicleColumns+" FROM Veiculo WHERE placa = ?", placa))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Retorna nil se o veículo não for encontrado
+		}
+		return nil, err // Retorna erro se houver outro problema
+	}
+	return &vehicle, nil
+}
+
+// Update atualiza os dados de um veículo no banco de dados, retornando ErrNotFound se ele não existir
+// e ErrConflict se a placa pertencer a outro veículo.
+func (r *VehicleRepository) Update(vehicle *models.Vehicle) error {
+	// Query SQL para atualizar um veículo
+	query := "UPDATE Veiculo SET placa = ?, tipo = ?, capacidade_peso = ?, capacidade_volume = ?, ativo = ? WHERE id = ?"
+
+	// Executa a query com os valores atualizados do veículo
+	return checkAffected(r.DB.Exec(query, vehicle.Placa, vehicle.Tipo, vehicle.CapacidadePeso, vehicle.CapacidadeVolume, vehicle.Ativo, vehicle.ID))
+}
+
+// Delete remove um veículo do banco de dados, retornando ErrNotFound se ele não existir.
+func (r *VehicleRepository) Delete(id int) error {
+	// Executa a query para deletar o veículo pelo ID
+	return checkAffected(r.DB.Exec("DELETE FROM Veiculo WHERE id = ?", id))
+}
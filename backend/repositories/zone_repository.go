@@ -0,0 +1,120 @@
+package repositories
+
+import (
+	"database/sql"
+	"encoding/json"
+	"meu-projeto/backend/models"
+)
+
+// zoneColumns são as colunas da tabela Zona lidas por scanZone, na mesma ordem.
+const zoneColumns = "id, nome, poligono, data_cadastro"
+
+// scanZone escaneia uma linha com as colunas de zoneColumns para a estrutura Zona.
+// O polígono é armazenado como texto GeoJSON e decodificado aqui.
+func scanZone(row rowScanner) (models.Zona, error) {
+	var zone models.Zona
+	var poligono string
+	if err := row.Scan(&zone.ID, &zone.Nome, &poligono, &zone.DataCadastro); err != nil {
+		return zone, err
+	}
+	err := json.Unmarshal([]byte(poligono), &zone.Poligono)
+	return zone, err
+}
+
+// ZoneRepository é uma estrutura que contém métodos para interagir com a tabela de zonas no banco de dados.
+type ZoneRepository struct {
+	DB *sql.DB // Conexão com o banco de dados
+}
+
+// Create insere uma nova zona no banco de dados.
+func (r *ZoneRepository) Create(zone *models.Zona) error {
+	// Serializa o polígono em GeoJSON
+	poligono, err := json.Marshal(zone.Poligono)
+	if err != nil {
+		return err
+	}
+
+	// Executa a query para inserir a nova zona
+	result, err := r.DB.Exec("INSERT INTO Zona (nome, poligono) VALUES (?, ?)", zone.Nome, string(poligono))
+	if err != nil {
+		return translateError(err) // Retorna ErrConflict se o nome já estiver cadastrado
+	}
+
+	// Obtém o ID gerado para a nova zona
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err // Retorna erro se não for possível obter o ID
+	}
+
+	// Busca a zona recém-criada para obter a data de cadastro gerada pelo banco
+	created, err := r.FindByID(int(id))
+	if err != nil {
+		return err
+	}
+	*zone = *created
+	return nil
+}
+
+// List retorna todas as zonas, em ordem de cadastro.
+func (r *ZoneRepository) List() ([]models.Zona, error) {
+	// Query SQL para selecionar todas as zonas
+	rows, err := r.DB.Query("SELECT " + zoneColumns + " FROM Zona ORDER BY id")
+	if err != nil {
+		return nil, err // Retorna erro se a query falhar
+	}
+	defer rows.Close() // Garante que as linhas sejam fechadas após o uso
+
+	zones := []models.Zona{}
+	// Itera sobre as linhas retornadas pela query
+	for rows.Next() {
+		zone, err := scanZone(rows)
+		if err != nil {
+			return nil, err // Retorna erro se o scan falhar
+		}
+		// Adiciona a zona à lista
+		zones = append(zones, zone)
+	}
+	return zones, rows.Err()
+}
+
+// FindByID busca uma zona pelo ID no banco de dados.
+func (r *ZoneRepository) FindByID(id int) (*models.Zona, error) {
+	return r.findOne("id = ?", id)
+}
+
+// FindByName busca uma zona pelo nome no banco de dados.
+func (r *ZoneRepository) FindByName(nome string) (*models.Zona, error) {
+	return r.findOne("nome = ?", nome)
+}
+
+// findOne busca a zona que atende à condição informada, retornando nil se ela não existir.
+func (r *ZoneRepository) findOne(condition string, arg any) (*models.Zona, error) {
+	zone, err := scanZone(r.DB.QueryRow("SELECT "+zoneColumns+" FROM Zona WHERE "+condition, arg))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Retorna nil se a zona não for encontrada
+		}
+		return nil, err // Retorna erro se houver outro problema
+	}
+	return &zone, nil
+}
+
+// Update atualiza o nome e o polígono de uma zona no banco de dados, retornando ErrNotFound se ela não existir
+// e ErrConflict se o nome pertencer a outra zona.
+func (r *ZoneRepository) Update(zone *models.Zona) error {
+	// Serializa o polígono em GeoJSON
+	poligono, err := json.Marshal(zone.Poligono)
+	if err != nil {
+		return err
+	}
+
+	// Executa a query com os valores atualizados da zona
+	return checkAffected(r.DB.Exec("UPDATE Zona SET nome = ?, poligono = ? WHERE id = ?", zone.Nome, string(poligono), zone.ID))
+}
+
+// Delete remove uma zona do banco de dados, retornando ErrNotFound se ela não existir. As entregas da zona
+// ficam sem zona (ON DELETE SET NULL).
+func (r *ZoneRepository) Delete(id int) error {
+	// Executa a query para deletar a zona pelo ID
+	return checkAffected(r.DB.Exec("DELETE FROM Zona WHERE id = ?", id))
+}
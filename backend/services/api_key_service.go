@@ -0,0 +1,151 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"meu-projeto/backend/auth"
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/validation"
+)
+
+// Erros retornados pelo APIKeyService.
+var (
+	ErrChaveAPIInvalida      = errors.New("dados da chave de API inválidos")
+	ErrChaveAPINaoEncontrada = errors.New("chave de API não encontrada")
+	ErrChaveAPIRecusada      = errors.New("chave de API inválida, expirada ou removida")
+)
+
+// apiKeyTouchInterval é o intervalo mínimo entre os registros de último uso de uma chave, para que uma
+// integração com muitas requisições não gere uma escrita no banco a cada uma delas.
+const apiKeyTouchInterval = time.Minute
+
+// APIKeyService é uma estrutura que contém métodos para lidar com as chaves de API das integrações: cadastro,
+// listagem, remoção e autenticação das requisições com o cabeçalho X-API-Key.
+type APIKeyService struct {
+	Keys     repositories.APIKeyStore  // Repositório das chaves de API
+	Shippers repositories.ShipperStore // Repositório dos embarcadores, usado para validar o embarcador das novas chaves (opcional)
+}
+
+// Create valida e cadastra uma nova chave de API para o usuário autenticado, retornando a chave completa,
+// que não pode ser consultada depois. Sem embarcador informado, a chave é do embarcador do usuário.
+func (s *APIKeyService) Create(key *models.ChaveAPI, principal *auth.Principal) (*models.ChaveAPICriada, error) {
+	// Valida os dados da chave, reunindo os erros de todos os campos
+	key.Nome = strings.TrimSpace(key.Nome)
+	v := validation.New()
+	if v.Required("nome", key.Nome) {
+		v.MaxLength("nome", key.Nome, 100)
+	}
+	var permissoes []string
+	for _, permission := range key.Permissoes {
+		permission = strings.ToLower(strings.TrimSpace(permission))
+		if !v.Check(auth.ValidPermission(permission), "permissoes", validation.CodeInvalidValue, fmt.Sprintf("Permissão '%s' inválida", permission)) {
+			continue
+		}
+		// As chaves não gerenciam usuários nem outras chaves
+		if v.Check(permission != auth.PermUsersManage, "permissoes", validation.CodeInvalidValue, fmt.Sprintf("A permissão '%s' não pode ser concedida a uma chave de API", permission)) && !slices.Contains(permissoes, permission) {
+			permissoes = append(permissoes, permission)
+		}
+	}
+	v.Check(len(key.Permissoes) > 0, "permissoes", validation.CodeRequired, "Informe pelo menos uma permissão")
+	if key.ExpiraEm != nil {
+		v.Check(key.ExpiraEm.After(time.Now()), "expira_em", validation.CodeOutOfRange, "A data de expiração deve estar no futuro")
+	}
+	if key.EmbarcadorID == 0 {
+		key.EmbarcadorID = models.EmbarcadorPadrao
+		if principal != nil && principal.EmbarcadorID != 0 {
+			key.EmbarcadorID = principal.EmbarcadorID
+		}
+	}
+	if err := validateShipper(v, s.Shippers, key.EmbarcadorID); err != nil {
+		return nil, err
+	}
+	if err := v.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrChaveAPIInvalida, err)
+	}
+
+	// Gera a chave e guarda apenas o prefixo e o hash
+	secret, prefix, hash, err := auth.NewAPIKey()
+	if err != nil {
+		return nil, err
+	}
+	key.Prefixo, key.Hash, key.Permissoes, key.UltimoUso = prefix, hash, permissoes, nil
+	key.UsuarioID = nil
+	if principal != nil && principal.UserID != 0 {
+		key.UsuarioID = &principal.UserID
+	}
+	if err := s.Keys.Create(key); err != nil {
+		return nil, err
+	}
+	return &models.ChaveAPICriada{ChaveAPI: *key, Chave: secret}, nil
+}
+
+// List retorna as chaves de API do embarcador informado (sem as chaves completas), ou todas as chaves se o
+// embarcador for 0.
+func (s *APIKeyService) List(embarcadorID int) ([]models.ChaveAPI, error) {
+	keys, err := s.Keys.List()
+	if err != nil || embarcadorID == 0 {
+		return keys, err
+	}
+	var filtered []models.ChaveAPI
+	for _, key := range keys {
+		if key.EmbarcadorID == embarcadorID {
+			filtered = append(filtered, key)
+		}
+	}
+	return filtered, nil
+}
+
+// Delete remove uma chave de API do embarcador informado (de qualquer embarcador, se ele for 0), que deixa de
+// ser aceita imediatamente. As chaves de outros embarcadores são tratadas como inexistentes.
+func (s *APIKeyService) Delete(id, embarcadorID int) error {
+	if embarcadorID != 0 {
+		keys, err := s.List(embarcadorID)
+		if err != nil {
+			return err
+		}
+		if !slices.ContainsFunc(keys, func(key models.ChaveAPI) bool { return key.ID == id }) {
+			return ErrChaveAPINaoEncontrada
+		}
+	}
+	if err := s.Keys.Delete(id); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrChaveAPINaoEncontrada
+		}
+		return err
+	}
+	return nil
+}
+
+// Authenticate valida a chave de API e retorna a integração autenticada, com as permissões e o embarcador da
+// chave. O último uso é registrado no máximo uma vez por minuto.
+func (s *APIKeyService) Authenticate(rawKey string) (*auth.Principal, error) {
+	prefix, ok := auth.APIKeyPrefix(rawKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: %w", ErrNaoAutenticado, ErrChaveAPIRecusada)
+	}
+	key, err := s.Keys.FindByPrefix(prefix)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil || !auth.CheckAPIKey(rawKey, key.Hash) {
+		return nil, fmt.Errorf("%w: %w", ErrNaoAutenticado, ErrChaveAPIRecusada)
+	}
+
+	now := time.Now()
+	if key.ExpiraEm != nil && !now.Before(*key.ExpiraEm) {
+		return nil, fmt.Errorf("%w: %w: a chave %s expirou em %s", ErrNaoAutenticado, ErrChaveAPIRecusada, key.Prefixo, key.ExpiraEm.Format(time.RFC3339))
+	}
+	if key.UltimoUso == nil || now.Sub(*key.UltimoUso) >= apiKeyTouchInterval {
+		if err := s.Keys.Touch(key.ID, now); errors.Is(err, repositories.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %w", ErrNaoAutenticado, ErrChaveAPIRecusada) // Chave removida durante a requisição
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	return &auth.Principal{APIKeyID: key.ID, APIKey: key.Prefixo, Permissions: key.Permissoes, EmbarcadorID: key.EmbarcadorID}, nil
+}
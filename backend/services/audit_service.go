@@ -0,0 +1,132 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+)
+
+// ErrAuditoriaInvalida é retornado quando os filtros da consulta da auditoria são inválidos.
+var ErrAuditoriaInvalida = errors.New("filtros da auditoria inválidos")
+
+// auditSystemActor é o ator registrado nas operações feitas sem usuário autenticado (ex: pelos testes ou por
+// rotinas internas).
+const auditSystemActor = "sistema"
+
+// AuditService é uma estrutura que contém métodos para registrar e consultar a auditoria das operações de
+// escrita nas entregas e nos clientes.
+type AuditService struct {
+	Repository repositories.AuditStore // Repositório do registro de auditoria
+}
+
+// Record registra uma operação na entidade informada, com as diferenças entre os valores antes e depois dela:
+// no cadastro, before é nil, e na remoção, after. O embarcador do registro é o do registro auditado. Sem
+// auditoria configurada (s nil), não registra nada.
+func (s *AuditService) Record(actor, entity string, id, embarcadorID int, action string, before, after any) error {
+	if s == nil {
+		return nil
+	}
+	changes, err := auditChanges(before, after)
+	if err != nil {
+		return err
+	}
+	if actor == "" {
+		actor = auditSystemActor
+	}
+	entry := models.RegistroAuditoria{
+		EmbarcadorID: embarcadorID,
+		DataHora:     time.Now(),
+		Ator:         actor,
+		Entidade:     entity,
+		EntidadeID:   id,
+		Acao:         action,
+		Alteracoes:   changes,
+	}
+	return s.Repository.Create(&entry)
+}
+
+// recordBestEffort registra a operação como Record, mas apenas loga as falhas. A auditoria é gravada depois
+// que a operação já foi confirmada no banco, e uma falha ao registrá-la não deve fazer a operação parecer
+// não realizada para quem a fez.
+func (s *AuditService) recordBestEffort(actor, entity string, id, embarcadorID int, action string, before, after any) {
+	if err := s.Record(actor, entity, id, embarcadorID, action, before, after); err != nil {
+		logAuditFailure(entity, id, action, err)
+	}
+}
+
+// logAuditFailure loga a falha ao registrar uma operação na auditoria.
+func logAuditFailure(entity string, id int, action string, err error) {
+	log.Printf("Falha ao registrar na auditoria a operação '%s' em %s %d: %v", action, entity, id, err)
+}
+
+// List retorna uma página dos registros de auditoria que atendem ao filtro, do mais recente para o mais antigo.
+func (s *AuditService) List(filter models.AuditFilter) (models.Page[models.RegistroAuditoria], error) {
+	// Verifica a entidade e o período informados
+	if filter.Entidade != "" && !models.AuditEntities[filter.Entidade] {
+		return models.Page[models.RegistroAuditoria]{}, fmt.Errorf("%w: entidade '%s' desconhecida", ErrAuditoriaInvalida, filter.Entidade)
+	}
+	if filter.EntidadeID != 0 && filter.Entidade == "" {
+		return models.Page[models.RegistroAuditoria]{}, fmt.Errorf("%w: informe a entidade junto com o ID", ErrAuditoriaInvalida)
+	}
+	if filter.De != nil && filter.Ate != nil && !filter.De.Before(*filter.Ate) {
+		return models.Page[models.RegistroAuditoria]{}, fmt.Errorf("%w: o início do período deve ser anterior ao fim", ErrAuditoriaInvalida)
+	}
+
+	entries, total, err := s.Repository.List(filter)
+	if err != nil {
+		return models.Page[models.RegistroAuditoria]{}, err
+	}
+	return models.NewPage(entries, total, filter.Page, filter.PageSize), nil
+}
+
+// auditChanges compara os campos JSON dos valores antes e depois da operação, retornando apenas os campos
+// alterados. Um valor nil (cadastro ou remoção) não tem nenhum campo.
+func auditChanges(before, after any) (map[string]models.Alteracao, error) {
+	beforeFields, err := auditFields(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := auditFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := map[string]models.Alteracao{}
+	for field, value := range beforeFields {
+		if !reflect.DeepEqual(value, afterFields[field]) {
+			changes[field] = models.Alteracao{Antes: value, Depois: afterFields[field]}
+		}
+	}
+	for field, value := range afterFields {
+		if _, ok := beforeFields[field]; !ok && value != nil {
+			changes[field] = models.Alteracao{Antes: nil, Depois: value}
+		}
+	}
+	return changes, nil
+}
+
+// auditFields converte o valor nos seus campos JSON, para que a auditoria registre os mesmos nomes e formatos
+// das respostas da API.
+func auditFields(value any) (map[string]any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Pointer && v.IsNil() {
+		return nil, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
@@ -0,0 +1,200 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"meu-projeto/backend/auth"
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/validation"
+)
+
+// Erros retornados pelo AuthService.
+var (
+	ErrCredenciaisInvalidas = errors.New("e-mail ou senha incorretos")
+	ErrNaoAutenticado       = errors.New("autenticação necessária")
+	ErrUsuarioInvalido      = errors.New("dados do usuário inválidos")
+	ErrUsuarioDuplicado     = errors.New("e-mail já cadastrado")
+)
+
+// AuthService é uma estrutura que contém métodos para lidar com a autenticação dos usuários da API:
+// cadastro, login, renovação dos tokens e validação dos tokens de acesso.
+type AuthService struct {
+	Users    repositories.UserStore    // Repositório dos usuários
+	Tokens   *auth.Tokens              // Emissor e validador dos tokens JWT
+	Shippers repositories.ShipperStore // Repositório dos embarcadores, usado para validar o embarcador dos novos usuários (opcional)
+}
+
+// CreateUser valida e cadastra um novo usuário, guardando apenas o hash da senha. Sem papel informado, o
+// usuário recebe o papel viewer (apenas consulta); sem embarcador, o embarcador padrão.
+func (s *AuthService) CreateUser(user *models.Usuario) error {
+	// Valida os dados do usuário, reunindo os erros de todos os campos
+	user.Nome = strings.TrimSpace(user.Nome)
+	user.Email = strings.ToLower(strings.TrimSpace(user.Email))
+	user.Papel = strings.ToLower(strings.TrimSpace(user.Papel))
+	if user.Papel == "" {
+		user.Papel = auth.RoleViewer
+	}
+	if user.EmbarcadorID == 0 {
+		user.EmbarcadorID = models.EmbarcadorPadrao
+	}
+	v := validation.New()
+	if v.Required("nome", user.Nome) {
+		v.MaxLength("nome", user.Nome, 100)
+	}
+	if v.Required("email", user.Email) && v.Check(emailPattern.MatchString(user.Email), "email", validation.CodeInvalidFormat, "E-mail '"+user.Email+"' inválido") {
+		v.MaxLength("email", user.Email, 100)
+	}
+	v.Check(len(user.Senha) >= auth.MinPasswordLength, "senha", validation.CodeOutOfRange, fmt.Sprintf("A senha deve ter pelo menos %d caracteres", auth.MinPasswordLength))
+	v.Check(len(user.Senha) <= 72, "senha", validation.CodeTooLong, "A senha deve ter no máximo 72 caracteres") // Limite do bcrypt
+	if v.Check(auth.ValidRole(user.Papel), "papel", validation.CodeInvalidValue, fmt.Sprintf("Papel '%s' inválido (use %s)", user.Papel, strings.Join(auth.Roles, ", "))) {
+		// Apenas os motoristas são vinculados a um motorista cadastrado, cujas entregas eles podem acessar
+		if user.Papel == auth.RoleDriver {
+			v.Check(user.MotoristaID != nil && *user.MotoristaID > 0, "motorista_id", validation.CodeRequired, "O campo 'motorista_id' é obrigatório no papel driver")
+		} else {
+			v.Check(user.MotoristaID == nil, "motorista_id", validation.CodeInvalidValue, "O campo 'motorista_id' só é aceito no papel driver")
+		}
+	}
+	if err := validateShipper(v, s.Shippers, user.EmbarcadorID); err != nil {
+		return err
+	}
+	if err := v.Err(); err != nil {
+		return fmt.Errorf("%w: %w", ErrUsuarioInvalido, err)
+	}
+
+	hash, err := auth.HashPassword(user.Senha)
+	if err != nil {
+		return err
+	}
+	user.SenhaHash = hash
+	user.Senha = "" // A senha em texto puro não é guardada nem retornada
+	user.Ativo = true
+
+	if err := s.Users.Create(user); err != nil {
+		if errors.Is(err, repositories.ErrConflict) {
+			return fmt.Errorf("%w: %s", ErrUsuarioDuplicado, user.Email)
+		}
+		if errors.Is(err, repositories.ErrForeignKey) && user.MotoristaID != nil {
+			v.Add("motorista_id", validation.CodeInvalidValue, fmt.Sprintf("Motorista %d não encontrado", *user.MotoristaID))
+			return fmt.Errorf("%w: %w", ErrUsuarioInvalido, v.Err())
+		}
+		return err
+	}
+	return nil
+}
+
+// EnsureAdmin cadastra o primeiro usuário, com o papel admin e o e-mail e a senha informados, se ainda não
+// houver nenhum usuário, retornando true se ele foi criado.
+func (s *AuthService) EnsureAdmin(email, senha string) (bool, error) {
+	count, err := s.Users.Count()
+	if err != nil || count > 0 {
+		return false, err
+	}
+	if err := s.CreateUser(&models.Usuario{Nome: "Administrador", Email: email, Senha: senha, Papel: auth.RoleAdmin}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Login verifica o e-mail e a senha e emite um novo par de tokens.
+func (s *AuthService) Login(email, senha string) (*models.TokenPair, error) {
+	user, err := s.Users.FindByEmail(strings.ToLower(strings.TrimSpace(email)))
+	if err != nil {
+		return nil, err
+	}
+
+	// A senha é verificada mesmo se o usuário não existir, para que o tempo de resposta seja o mesmo
+	hash := ""
+	if user != nil {
+		hash = user.SenhaHash
+	}
+	if err := auth.CheckPassword(hash, senha); err != nil || !user.Ativo {
+		return nil, ErrCredenciaisInvalidas
+	}
+	return s.issue(user)
+}
+
+// Refresh troca um token de renovação válido por um novo par de tokens, desde que o usuário ainda esteja ativo.
+func (s *AuthService) Refresh(refreshToken string) (*models.TokenPair, error) {
+	claims, err := s.Tokens.Parse(refreshToken, auth.TokenRefresh)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrNaoAutenticado, err)
+	}
+	user, err := s.findActiveUser(claims)
+	if err != nil {
+		return nil, err
+	}
+	return s.issue(user)
+}
+
+// Authenticate valida o token de acesso e retorna o usuário autenticado. O usuário é consultado a cada
+// requisição, de modo que usuários desativados perdem o acesso e mudanças de papel valem de imediato, sem
+// esperar o token expirar.
+func (s *AuthService) Authenticate(accessToken string) (*auth.Principal, error) {
+	claims, err := s.Tokens.Parse(accessToken, auth.TokenAccess)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrNaoAutenticado, err)
+	}
+	user, err := s.findActiveUser(claims)
+	if err != nil {
+		return nil, err
+	}
+	principal := principalOf(user)
+	return &principal, nil
+}
+
+// CurrentUser retorna os dados do usuário autenticado.
+func (s *AuthService) CurrentUser(principal *auth.Principal) (*models.Usuario, error) {
+	if principal == nil {
+		return nil, ErrNaoAutenticado
+	}
+	user, err := s.Users.FindByID(principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrNaoAutenticado
+	}
+	return user, nil
+}
+
+// findActiveUser busca o usuário do token, recusando usuários removidos ou inativos.
+func (s *AuthService) findActiveUser(claims *auth.Claims) (*models.Usuario, error) {
+	id, err := claims.UserID()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrNaoAutenticado, err)
+	}
+	user, err := s.Users.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || !user.Ativo {
+		return nil, fmt.Errorf("%w: usuário inexistente ou inativo", ErrNaoAutenticado)
+	}
+	return user, nil
+}
+
+// issue emite o par de tokens de acesso e de renovação do usuário.
+func (s *AuthService) issue(user *models.Usuario) (*models.TokenPair, error) {
+	principal := principalOf(user)
+	access, ttl, err := s.Tokens.Issue(principal, auth.TokenAccess)
+	if err != nil {
+		return nil, err
+	}
+	refresh, _, err := s.Tokens.Issue(principal, auth.TokenRefresh)
+	if err != nil {
+		return nil, err
+	}
+	return &models.TokenPair{AccessToken: access, RefreshToken: refresh, TokenType: "Bearer", ExpiresIn: int(ttl.Seconds())}, nil
+}
+
+// principalOf monta o usuário autenticado com o papel, o embarcador e o motorista atuais do usuário.
+func principalOf(user *models.Usuario) auth.Principal {
+	principal := auth.Principal{UserID: user.ID, Email: user.Email, Role: user.Papel, EmbarcadorID: user.EmbarcadorID}
+	if user.MotoristaID != nil {
+		principal.MotoristaID = *user.MotoristaID
+	}
+	return principal
+}
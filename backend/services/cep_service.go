@@ -0,0 +1,39 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"meu-projeto/backend/geocoding"
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/utils"
+)
+
+// Erros retornados pela consulta de CEP.
+var (
+	ErrCEPInvalido      = errors.New("CEP inválido")
+	ErrCEPNaoEncontrado = errors.New("CEP não encontrado")
+	ErrConsultaCEP      = errors.New("serviço de consulta de CEP indisponível")
+)
+
+// CEPService é uma estrutura que contém métodos para consultar o endereço de um CEP.
+type CEPService struct {
+	Provider geocoding.CEPProvider // Provedor de CEPs (tabela local e, opcionalmente, um serviço HTTP)
+}
+
+// Lookup retorna o endereço do CEP informado (com ou sem pontuação).
+func (s *CEPService) Lookup(cep string) (*models.CEPAddress, error) {
+	if !utils.ValidateCEP(cep) {
+		return nil, fmt.Errorf("%w: '%s'", ErrCEPInvalido, cep)
+	}
+	cep = utils.FormatCEP(cep)
+
+	address, err := s.Provider.LookupCEP(cep)
+	if errors.Is(err, geocoding.ErrNotFound) {
+		return nil, fmt.Errorf("%w: %s", ErrCEPNaoEncontrado, cep)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrConsultaCEP, err)
+	}
+	return &models.CEPAddress{CEP: cep, Logradouro: address.Logradouro, Bairro: address.Bairro, Cidade: address.Cidade, Estado: address.Estado, Pais: address.Pais}, nil
+}
@@ -1,41 +1,206 @@
 package services
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
 	"meu-projeto/backend/models"
 	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/utils"
+	"meu-projeto/backend/validation"
+)
+
+// Erros retornados pelo ClientService.
+var (
+	ErrClienteInvalido      = errors.New("dados do cliente inválidos")
+	ErrClienteNaoEncontrado = errors.New("cliente não encontrado")
+	ErrClienteDuplicado     = errors.New("CPF já cadastrado")
 )
 
+// emailPattern é uma verificação simples do formato do e-mail (usuario@dominio.tld).
+var emailPattern = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
 // ClientService é uma estrutura que contém métodos para lidar com a lógica de negócio relacionada a clientes.
 type ClientService struct {
-	Repository *repositories.ClientRepository // Repositório para interagir com o banco de dados
+	Repository repositories.ClientStore   // Repositório para interagir com o banco de dados
+	Deliveries repositories.DeliveryStore // Repositório das entregas, para auditar as removidas junto com o cliente (opcional)
+	Audit      *AuditService              // Auditoria das operações de escrita nos clientes (opcional)
+	Actor      string                     // Quem faz as operações, registrado na auditoria (veja ForActor)
+}
+
+// ForTenant retorna uma cópia do serviço restrita aos clientes do embarcador informado. O CPF é único em cada
+// embarcador, e os clientes de outros embarcadores são tratados como inexistentes.
+func (service *ClientService) ForTenant(embarcadorID int) *ClientService {
+	scoped := *service
+	scoped.Repository = service.Repository.ForTenant(embarcadorID)
+	if service.Deliveries != nil {
+		scoped.Deliveries = service.Deliveries.ForTenant(embarcadorID)
+	}
+	return &scoped
+}
+
+// ForActor retorna uma cópia do serviço que registra as operações de escrita na auditoria em nome do ator
+// informado (o e-mail do usuário ou o prefixo da chave de API).
+func (service *ClientService) ForActor(actor string) *ClientService {
+	scoped := *service
+	scoped.Actor = actor
+	return &scoped
+}
+
+// snapshot retorna o cliente como está no banco de dados, para a auditoria (nil se ele não existir). Sem
+// auditoria configurada, retorna nil sem consultar o banco.
+func (service *ClientService) snapshot(id int) (*models.Cliente, error) {
+	if service.Audit == nil {
+		return nil, nil
+	}
+	client, err := service.Repository.FindByID(id)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return nil, nil // A própria operação retorna ErrClienteNaoEncontrado
+	}
+	return client, err
+}
+
+// auditClient registra a operação no cliente na auditoria, com os valores antes e depois dela. A operação já
+// foi confirmada no banco: uma falha ao registrá-la é apenas logada. Sem auditoria configurada, não registra
+// nada.
+func (service *ClientService) auditClient(action string, before, after *models.Cliente) {
+	record := after
+	if record == nil {
+		record = before
+	}
+	if record == nil {
+		return // Sem auditoria configurada, snapshot não retorna o cliente
+	}
+	service.Audit.recordBestEffort(service.Actor, models.EntidadeCliente, record.ID, record.EmbarcadorID, action, before, after)
 }
 
-// Create cria um novo cliente no banco de dados.
+// Create valida e cria um novo cliente no banco de dados.
 func (service *ClientService) Create(client *models.Cliente) error {
+	// Valida os dados do cliente, reunindo os erros de todos os campos
+	v := validation.New()
+	validateCliente(v, client)
+	if err := v.Err(); err != nil {
+		return fmt.Errorf("%w: %w", ErrClienteInvalido, err)
+	}
+
 	// Chama o método Create do repositório para inserir o cliente no banco de dados
-	return service.Repository.Create(client)
+	if err := service.Repository.Create(client); err != nil {
+		return clientError(err, client)
+	}
+
+	// Registra o cadastro na auditoria
+	service.auditClient(models.AcaoCriacao, nil, client)
+	return nil
 }
 
-// List retorna uma lista de todos os clientes cadastrados no banco de dados.
-func (service *ClientService) List() ([]models.Cliente, error) {
-	// Chama o método List do repositório para obter a lista de clientes
-	return service.Repository.List()
+// List retorna uma página de clientes de acordo com a busca, a ordenação e a paginação informadas.
+func (service *ClientService) List(filter models.ClientFilter) (models.Page[models.Cliente], error) {
+	// Chama o método List do repositório para obter a página de clientes
+	clients, total, err := service.Repository.List(filter)
+	if err != nil {
+		return models.Page[models.Cliente]{}, err
+	}
+	return models.NewPage(clients, total, filter.Page, filter.PageSize), nil
 }
 
 // FindByID busca um cliente pelo ID no banco de dados.
 func (service *ClientService) FindByID(id int) (*models.Cliente, error) {
 	// Chama o método FindByID do repositório para buscar o cliente pelo ID
-	return service.Repository.FindByID(id)
+	client, err := service.Repository.FindByID(id)
+	if err != nil {
+		return nil, clientError(err, nil)
+	}
+	return client, nil
 }
 
-// Update atualiza os dados de um cliente no banco de dados.
+// Update valida e atualiza os dados de um cliente no banco de dados.
 func (service *ClientService) Update(client *models.Cliente) error {
+	// Valida os dados do cliente, reunindo os erros de todos os campos
+	v := validation.New()
+	v.Check(client.ID > 0, "id", validation.CodeRequired, "O campo 'id' é obrigatório")
+	validateCliente(v, client)
+	if err := v.Err(); err != nil {
+		return fmt.Errorf("%w: %w", ErrClienteInvalido, err)
+	}
+
+	// Guarda os valores anteriores para a auditoria
+	before, err := service.snapshot(client.ID)
+	if err != nil {
+		return err
+	}
+
 	// Chama o método Update do repositório para atualizar o cliente no banco de dados
-	return service.Repository.Update(client)
+	if err := service.Repository.Update(client); err != nil {
+		return clientError(err, client)
+	}
+
+	// Registra a alteração na auditoria
+	after, err := service.snapshot(client.ID)
+	if err != nil {
+		logAuditFailure(models.EntidadeCliente, client.ID, models.AcaoAtualizacao, err)
+		return nil
+	}
+	service.auditClient(models.AcaoAtualizacao, before, after)
+	return nil
 }
 
-// Delete remove um cliente do banco de dados.
+// Delete remove um cliente do banco de dados, junto com as suas entregas. A remoção do cliente e de cada
+// entrega é registrada na auditoria.
 func (service *ClientService) Delete(id int) error {
+	// Guarda os valores anteriores do cliente e das entregas para a auditoria
+	before, err := service.snapshot(id)
+	if err != nil {
+		return err
+	}
+	var deliveries []models.Delivery
+	if before != nil && service.Deliveries != nil {
+		if deliveries, err = listAllDeliveries(service.Deliveries, models.DeliveryFilter{ClienteID: id}); err != nil {
+			return err
+		}
+	}
+
 	// Chama o método Delete do repositório para deletar o cliente pelo ID
-	return service.Repository.Delete(id)
+	if err := service.Repository.Delete(id); err != nil {
+		return clientError(err, nil)
+	}
+
+	// Registra a remoção do cliente e das entregas na auditoria
+	service.auditClient(models.AcaoRemocao, before, nil)
+	for _, delivery := range deliveries {
+		service.Audit.recordBestEffort(service.Actor, models.EntidadeEntrega, delivery.ID, delivery.EmbarcadorID, models.AcaoRemocao, &delivery, nil)
+	}
+	return nil
+}
+
+// clientError converte os erros do repositório nos erros do ClientService: registro inexistente em
+// ErrClienteNaoEncontrado e CPF repetido em ErrClienteDuplicado. Os demais erros são retornados sem alteração.
+func clientError(err error, client *models.Cliente) error {
+	switch {
+	case errors.Is(err, repositories.ErrNotFound):
+		return ErrClienteNaoEncontrado
+	case errors.Is(err, repositories.ErrConflict) && client != nil:
+		return fmt.Errorf("%w: %s", ErrClienteDuplicado, client.CPF)
+	}
+	return err
+}
+
+// validateCliente remove os espaços extras e verifica os campos do cliente.
+func validateCliente(v *validation.Validator, client *models.Cliente) {
+	client.Nome = strings.TrimSpace(client.Nome)
+	client.CPF = strings.TrimSpace(client.CPF)
+	client.Email = strings.TrimSpace(client.Email)
+	client.Telefone = strings.TrimSpace(client.Telefone)
+
+	if v.Required("nome", client.Nome) {
+		v.MaxLength("nome", client.Nome, 100)
+	}
+	if v.Required("cpf", client.CPF) {
+		v.Check(utils.ValidateCPF(client.CPF), "cpf", validation.CodeInvalidFormat, "CPF '"+client.CPF+"' inválido")
+	}
+	if client.Email != "" && v.Check(emailPattern.MatchString(client.Email), "email", validation.CodeInvalidFormat, "E-mail '"+client.Email+"' inválido") {
+		v.MaxLength("email", client.Email, 100)
+	}
+	v.MaxLength("telefone", client.Telefone, 20)
 }
@@ -1,17 +1,145 @@
 package services
 
 import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"meu-projeto/backend/geocoding"
 	"meu-projeto/backend/models"
 	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/utils"
+	"meu-projeto/backend/validation"
+)
+
+// maxTrackingCodeAttempts é o número máximo de tentativas para gerar um código de rastreio ainda não utilizado.
+const maxTrackingCodeAttempts = 5
+
+// Erros retornados pelo DeliveryService ao alterar o status de uma entrega.
+var (
+	ErrEntregaNaoEncontrada = errors.New("entrega não encontrada")
+	ErrStatusInvalido       = errors.New("status inválido")
+	ErrTransicaoInvalida    = errors.New("transição de status não permitida")
+	ErrBuscaInvalida        = errors.New("parâmetros de busca inválidos")
+	ErrEntregaInvalida      = errors.New("dados da entrega inválidos")
+	ErrEventoInvalido       = errors.New("dados do evento inválidos")
+
+	ErrEnderecoNaoEncontrado = errors.New("não foi possível obter as coordenadas do endereço")
+	ErrGeocodificacao        = errors.New("serviço de geocodificação indisponível")
 )
 
 // DeliveryService é uma estrutura que contém métodos para lidar com a lógica de negócio relacionada a entregas.
 type DeliveryService struct {
-	Repository *repositories.DeliveryRepository // Repositório para interagir com o banco de dados
+	Repository repositories.DeliveryStore      // Repositório para interagir com o banco de dados
+	Events     repositories.TrackingEventStore // Repositório do histórico de rastreamento das entregas
+	Zones      repositories.ZoneStore          // Repositório das zonas, usado para associar cada entrega à sua zona
+	Geocoder   geocoding.Geocoder              // Provedor de coordenadas para entregas cadastradas sem latitude e longitude (opcional)
+	CEPs       *CEPService                     // Consulta de CEP, usada para completar o endereço das entregas cadastradas apenas com CEP e número (opcional)
+	Audit      *AuditService                   // Auditoria das operações de escrita nas entregas e nos clientes (opcional)
+	Actor      string                          // Quem faz as operações, registrado na auditoria (veja ForActor)
+}
+
+// ForTenant retorna uma cópia do serviço restrita às entregas e aos clientes do embarcador informado: as
+// entregas de outros embarcadores são tratadas como inexistentes.
+func (s *DeliveryService) ForTenant(embarcadorID int) *DeliveryService {
+	scoped := *s
+	scoped.Repository = s.Repository.ForTenant(embarcadorID)
+	return &scoped
+}
+
+// ForActor retorna uma cópia do serviço que registra as operações de escrita na auditoria em nome do ator
+// informado (o e-mail do usuário ou o prefixo da chave de API).
+func (s *DeliveryService) ForActor(actor string) *DeliveryService {
+	scoped := *s
+	scoped.Actor = actor
+	return &scoped
+}
+
+// snapshot retorna a entrega como está no banco de dados, para a auditoria. Sem auditoria configurada,
+// retorna nil sem consultar o banco.
+func (s *DeliveryService) snapshot(id int) (*models.Delivery, error) {
+	if s.Audit == nil {
+		return nil, nil
+	}
+	return s.Repository.FindByID(id)
+}
+
+// auditDelivery registra a operação na entrega na auditoria, com os valores antes e depois dela. A operação
+// já foi confirmada no banco: uma falha ao registrá-la é apenas logada. Sem auditoria configurada, não
+// registra nada.
+func (s *DeliveryService) auditDelivery(action string, before, after *models.Delivery) {
+	record := after
+	if record == nil {
+		record = before
+	}
+	if record == nil {
+		return // Sem auditoria configurada, snapshot não retorna a entrega
+	}
+	s.Audit.recordBestEffort(s.Actor, models.EntidadeEntrega, record.ID, record.EmbarcadorID, action, before, after)
 }
 
-// Create cria uma nova entrega no banco de dados.
+// auditDeliveryChange registra na auditoria a operação na entrega com os valores anteriores informados e os
+// valores atuais dela, lidos do banco. Como em auditDelivery, as falhas são apenas logadas.
+func (s *DeliveryService) auditDeliveryChange(action string, id int, before *models.Delivery) {
+	after, err := s.snapshot(id)
+	if err != nil {
+		logAuditFailure(models.EntidadeEntrega, id, action, err)
+		return
+	}
+	s.auditDelivery(action, before, after)
+}
+
+// updateDriver atribui a entrega ao motorista informado, ou remove a atribuição se motoristaID for nil, e
+// registra a alteração na auditoria. Retorna a entrega com o novo motorista.
+func (s *DeliveryService) updateDriver(delivery *models.Delivery, motoristaID *int) (*models.Delivery, error) {
+	if err := s.Repository.UpdateDriver(delivery.ID, motoristaID); err != nil {
+		return nil, err
+	}
+	updated := *delivery
+	updated.MotoristaID = motoristaID
+	s.auditDelivery(models.AcaoAtualizacao, delivery, &updated)
+	return &updated, nil
+}
+
+// updateZone associa a entrega à zona informada, ou a marca como fora de zona se zonaID for nil, e registra
+// a alteração na auditoria.
+func (s *DeliveryService) updateZone(delivery models.Delivery, zonaID *int) error {
+	if err := s.Repository.UpdateZone(delivery.ID, zonaID); err != nil {
+		return err
+	}
+	updated := delivery
+	updated.ZonaID = zonaID
+	updated.ForaDeZona = zonaID == nil
+	s.auditDelivery(models.AcaoAtualizacao, &delivery, &updated)
+	return nil
+}
+
+// Create valida e cria uma nova entrega no banco de dados. Os erros de validação da entrega e do cliente são
+// retornados juntos (validation.Errors), com os campos prefixados por "delivery." e "cliente.".
 func (s *DeliveryService) Create(delivery models.Delivery, cliente models.Cliente) (int64, error) {
+	// Valida os dados da entrega e do cliente, reunindo os erros de todos os campos
+	v := validation.New()
+	validateDelivery(v.With("delivery."), &delivery, s.CEPs != nil)
+	validateCliente(v.With("cliente."), &cliente)
+	if err := v.Err(); err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrEntregaInvalida, err)
+	}
+
+	// Completa o endereço a partir do CEP
+	if err := s.fillFromCEP(&delivery); err != nil {
+		return 0, err
+	}
+
+	// Obtém as coordenadas a partir do endereço, se não foram informadas
+	if delivery.Latitude == 0 && delivery.Longitude == 0 && s.Geocoder != nil {
+		if err := s.geocode(&delivery); err != nil {
+			return 0, err
+		}
+	}
+
 	// Verifica se o cliente já existe pelo CPF
 	existingCliente, err := s.Repository.FindByCPF(cliente.CPF)
 	if err != nil {
@@ -25,6 +153,15 @@ func (s *DeliveryService) Create(delivery models.Delivery, cliente models.Client
 		if err != nil {
 			return 0, err // Retorna erro se houver problema ao criar o cliente
 		}
+
+		// Registra o cadastro do cliente na auditoria
+		if s.Audit != nil {
+			if created, err := s.Repository.FindByCPF(cliente.CPF); err != nil {
+				logAuditFailure(models.EntidadeCliente, int(clienteID), models.AcaoCriacao, err)
+			} else if created != nil {
+				s.Audit.recordBestEffort(s.Actor, models.EntidadeCliente, created.ID, created.EmbarcadorID, models.AcaoCriacao, nil, created)
+			}
+		}
 	} else {
 		// Cliente já existe, usa o ID existente
 		clienteID = int64(existingCliente.ID)
@@ -33,36 +170,398 @@ func (s *DeliveryService) Create(delivery models.Delivery, cliente models.Client
 	// Associa o cliente à entrega
 	delivery.ClienteID = int(clienteID)
 
+	// Toda entrega nova começa como pendente
+	delivery.Status = models.StatusPendente
+
+	// Gera o código público de rastreio da entrega
+	delivery.CodigoRastreio, err = s.newTrackingCode()
+	if err != nil {
+		return 0, err
+	}
+
+	// Associa a entrega à zona que contém as suas coordenadas
+	if delivery.ZonaID, err = s.locateZone(delivery); err != nil {
+		return 0, err
+	}
+
 	// Cria a entrega no banco de dados
-	return s.Repository.Create(delivery)
+	id, err := s.Repository.Create(delivery)
+	if err != nil {
+		return 0, err
+	}
+
+	// Registra o primeiro evento do histórico de rastreamento. Se o registro falhar, a entrega é removida, para
+	// que não fique cadastrada sem histórico (o cliente cadastrado acima é mantido, já que existe por si só)
+	event := models.TrackingEvent{EntregaID: int(id), DataHora: time.Now(), Status: delivery.Status, Observacao: "Entrega cadastrada", Responsavel: s.Actor}
+	if err := s.Events.Create(&event); err != nil {
+		if rollbackErr := s.Repository.Delete(int(id)); rollbackErr != nil {
+			return 0, errors.Join(err, rollbackErr)
+		}
+		return 0, err
+	}
+
+	// Registra o cadastro da entrega na auditoria
+	s.auditDeliveryChange(models.AcaoCriacao, int(id), nil)
+	return id, nil
 }
 
-// List retorna uma lista de todas as entregas cadastradas no banco de dados.
-func (s *DeliveryService) List() ([]models.Delivery, error) {
-	// Chama o método List do repositório para obter a lista de entregas
-	return s.Repository.List()
+// validateDelivery padroniza o endereço da entrega e verifica os seus campos. Se cepFillsAddress for verdadeiro,
+// o CEP dispensa o endereço e a cidade, que são preenchidos a partir dele. Para endereços no Brasil (ou sem país),
+// rejeita estados desconhecidos e coordenadas fora do território brasileiro (latitude e longitude zeradas indicam
+// coordenadas não informadas).
+func validateDelivery(v *validation.Validator, delivery *models.Delivery, cepFillsAddress bool) {
+	normalizeAddress(delivery)
+
+	v.Positive("peso", delivery.Peso)
+	if delivery.CEP != "" && v.Check(utils.ValidateCEP(delivery.CEP), "cep", validation.CodeInvalidFormat, "CEP '"+delivery.CEP+"' inválido (ex: 01310-100)") {
+		delivery.CEP = utils.FormatCEP(delivery.CEP)
+	}
+	if delivery.CEP == "" || !cepFillsAddress {
+		v.Required("endereco", delivery.Endereco)
+		v.Required("cidade", delivery.Cidade)
+	}
+
+	// Tamanhos máximos das colunas da tabela Entrega
+	v.MaxLength("endereco", delivery.Endereco, 255)
+	v.MaxLength("logradouro", delivery.Logradouro, 100)
+	v.MaxLength("numero", delivery.Numero, 10)
+	v.MaxLength("bairro", delivery.Bairro, 100)
+	v.MaxLength("complemento", delivery.Complemento, 100)
+	v.MaxLength("cidade", delivery.Cidade, 100)
+	v.MaxLength("estado", delivery.Estado, 50)
+	v.MaxLength("pais", delivery.Pais, 50)
+
+	// Estado e coordenadas
+	brazil := delivery.Pais == "" || utils.IsBrazil(delivery.Pais)
+	if brazil && delivery.Estado != "" {
+		v.Check(utils.BrazilStates[delivery.Estado] != "", "estado", validation.CodeInvalidValue, "Estado '"+delivery.Estado+"' desconhecido (informe a sigla da UF, ex: SP)")
+	}
+	switch {
+	case !utils.ValidCoordinates(delivery.Latitude, delivery.Longitude):
+		v.Add("latitude", validation.CodeOutOfRange, "As coordenadas estão fora dos limites (latitude entre -90 e 90, longitude entre -180 e 180)")
+	case brazil && (delivery.Latitude != 0 || delivery.Longitude != 0) && !utils.InBrazil(delivery.Latitude, delivery.Longitude):
+		v.Add("latitude", validation.CodeOutOfRange, fmt.Sprintf("As coordenadas (%v, %v) estão fora do Brasil", delivery.Latitude, delivery.Longitude))
+	}
+}
+
+// fillFromCEP preenche os campos vazios do endereço (logradouro, bairro, cidade e estado) com o endereço do CEP.
+// Sem endereço completo, usa "logradouro, número".
+func (s *DeliveryService) fillFromCEP(delivery *models.Delivery) error {
+	// Consulta o CEP apenas se faltar algum campo do endereço
+	complete := delivery.Logradouro != "" && delivery.Bairro != "" && delivery.Cidade != "" && delivery.Estado != ""
+	if delivery.CEP == "" || complete || s.CEPs == nil {
+		return nil
+	}
+	address, err := s.CEPs.Lookup(delivery.CEP)
+	if err != nil {
+		return err
+	}
+
+	// Preenche apenas os campos vazios, mantendo os informados na requisição
+	fillEmpty(&delivery.Logradouro, address.Logradouro)
+	fillEmpty(&delivery.Bairro, address.Bairro)
+	fillEmpty(&delivery.Cidade, address.Cidade)
+	fillEmpty(&delivery.Estado, address.Estado)
+	fillEmpty(&delivery.Pais, address.Pais)
+	if delivery.Endereco == "" && delivery.Logradouro != "" {
+		delivery.Endereco = delivery.Logradouro
+		if delivery.Numero != "" {
+			delivery.Endereco += ", " + delivery.Numero
+		}
+	}
+
+	// CEPs gerais de cidade não têm logradouro: o endereço precisa ser informado
+	v := validation.New().With("delivery.")
+	v.Check(delivery.Endereco != "", "endereco", validation.CodeRequired, "O CEP "+delivery.CEP+" não tem logradouro: informe o campo 'endereco'")
+	if err := v.Err(); err != nil {
+		return fmt.Errorf("%w: %w", ErrEntregaInvalida, err)
+	}
+	return nil
+}
+
+// normalizeAddress padroniza o endereço da entrega: remove espaços extras, ajusta a caixa dos nomes e, para
+// endereços no Brasil (ou sem país), converte o estado na sigla oficial (UF) e a cidade no nome oficial do IBGE.
+func normalizeAddress(delivery *models.Delivery) {
+	delivery.Endereco = strings.Join(strings.Fields(delivery.Endereco), " ")
+	delivery.Logradouro = utils.NormalizeName(delivery.Logradouro)
+	delivery.Numero = strings.TrimSpace(delivery.Numero)
+	delivery.Bairro = utils.NormalizeName(delivery.Bairro)
+	delivery.Complemento = strings.TrimSpace(delivery.Complemento)
+	delivery.Pais = utils.NormalizeName(delivery.Pais)
+	delivery.Estado = strings.TrimSpace(delivery.Estado)
+
+	// Endereços fora do Brasil têm apenas os nomes padronizados
+	if delivery.Pais != "" && !utils.IsBrazil(delivery.Pais) {
+		delivery.Estado = utils.NormalizeName(delivery.Estado)
+		delivery.Cidade = utils.NormalizeName(delivery.Cidade)
+		return
+	}
+	if delivery.Pais != "" {
+		delivery.Pais = "Brasil"
+	}
+
+	// Converte o estado na UF e a cidade no nome oficial (estados desconhecidos são mantidos para a validação)
+	if uf, ok := utils.NormalizeUF(delivery.Estado); ok {
+		delivery.Estado = uf
+	}
+	delivery.Cidade = utils.NormalizeCity(delivery.Cidade, delivery.Estado)
+}
+
+// fillEmpty atribui value ao campo apenas se ele estiver vazio.
+func fillEmpty(field *string, value string) {
+	if *field == "" {
+		*field = value
+	}
+}
+
+// geocode preenche a latitude e a longitude da entrega a partir do endereço. Sem logradouro,
+// usa o início do endereço completo (até a primeira vírgula).
+func (s *DeliveryService) geocode(delivery *models.Delivery) error {
+	address := geocoding.Address{Logradouro: delivery.Logradouro, Numero: delivery.Numero, Bairro: delivery.Bairro, Cidade: delivery.Cidade, Estado: delivery.Estado, Pais: delivery.Pais}
+	if address.Logradouro == "" {
+		address.Logradouro = geocoding.StreetFromEndereco(delivery.Endereco)
+	}
+
+	result, err := s.Geocoder.Geocode(address)
+	if errors.Is(err, geocoding.ErrNotFound) {
+		return fmt.Errorf("%w: informe a latitude e a longitude (%v)", ErrEnderecoNaoEncontrado, err)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrGeocodificacao, err)
+	}
+	delivery.Latitude, delivery.Longitude = result.Latitude, result.Longitude
+	return nil
+}
+
+// newTrackingCode gera um código de rastreio que ainda não pertence a nenhuma entrega, de nenhum embarcador.
+func (s *DeliveryService) newTrackingCode() (string, error) {
+	for i := 0; i < maxTrackingCodeAttempts; i++ {
+		code, err := utils.GenerateTrackingCode()
+		if err != nil {
+			return "", err
+		}
+
+		// Verifica se o código já está em uso. O código é único entre todos os embarcadores, então a busca é
+		// feita sem restringir ao embarcador do serviço
+		existing, err := s.Repository.ForTenant(0).FindByTrackingCode(code)
+		if err != nil {
+			return "", err
+		}
+		if existing == nil {
+			return code, nil
+		}
+	}
+	return "", errors.New("não foi possível gerar um código de rastreio único")
+}
+
+// List retorna uma página de entregas de acordo com os filtros, a ordenação e a paginação informados.
+func (s *DeliveryService) List(filter models.DeliveryFilter) (models.Page[models.Delivery], error) {
+	// Aceita o nome do estado no filtro (ex: "São Paulo" -> "SP")
+	if uf, ok := utils.NormalizeUF(filter.Estado); ok {
+		filter.Estado = uf
+	}
+
+	// Chama o método List do repositório para obter a página de entregas
+	deliveries, total, err := s.Repository.List(filter)
+	if err != nil {
+		return models.Page[models.Delivery]{}, err
+	}
+	return models.NewPage(deliveries, total, filter.Page, filter.PageSize), nil
 }
 
 // FindByID busca uma entrega pelo ID no banco de dados.
 func (s *DeliveryService) FindByID(id int) (*models.Delivery, error) {
 	// Chama o método FindByID do repositório para buscar a entrega pelo ID
-	return s.Repository.FindByID(id)
+	delivery, err := s.Repository.FindByID(id)
+	if err != nil || delivery == nil {
+		return delivery, err
+	}
+
+	// Inclui o evento de rastreamento mais recente na resposta
+	delivery.UltimoEvento, err = s.Events.Latest(id)
+	if err != nil {
+		return nil, err
+	}
+	return delivery, nil
 }
 
 // FindByCity busca entregas por cidade no banco de dados.
 func (s *DeliveryService) FindByCity(cidade string) ([]models.Delivery, error) {
-	// Chama o método FindByCity do repositório para buscar entregas por cidade
-	return s.Repository.FindByCity(cidade)
+	// Chama o método FindByCity do repositório com o nome oficial da cidade (ex: "sao paulo" -> "São Paulo")
+	return s.Repository.FindByCity(utils.NormalizeCity(cidade, ""))
 }
 
-// Update atualiza os dados de uma entrega no banco de dados.
+// Nearby busca as entregas a até radiusKm do ponto informado, da mais próxima para a mais distante.
+// Sem status, retorna apenas as entregas em aberto (status não final). As candidatas são pré-filtradas
+// por um retângulo de coordenadas no banco e a distância exata é calculada com Haversine. Com motoristaID
+// diferente de zero, considera apenas as entregas atribuídas ao motorista.
+func (s *DeliveryService) Nearby(lat, lng, radiusKm float64, status string, motoristaID, limit int) ([]models.NearbyDelivery, error) {
+	// Valida os parâmetros da busca
+	if !utils.ValidCoordinates(lat, lng) {
+		return nil, fmt.Errorf("%w: coordenadas fora dos limites", ErrBuscaInvalida)
+	}
+	if radiusKm <= 0 || radiusKm > models.MaxNearbyRadiusKm {
+		return nil, fmt.Errorf("%w: o raio deve ser maior que zero e no máximo %.0f km", ErrBuscaInvalida, models.MaxNearbyRadiusKm)
+	}
+	if status != "" && !models.IsValidStatus(status) {
+		return nil, fmt.Errorf("%w: '%s'", ErrStatusInvalido, status)
+	}
+
+	// Busca as candidatas dentro do retângulo que contém o círculo
+	var box models.BoundingBox
+	box.MinLat, box.MaxLat, box.MinLng, box.MaxLng = utils.BoundingBox(lat, lng, radiusKm)
+	candidates, err := s.Repository.FindInBoundingBox(box, status)
+	if err != nil {
+		return nil, err
+	}
+
+	// Calcula a distância exata e descarta as entregas fora do círculo
+	nearby := []models.NearbyDelivery{}
+	for _, delivery := range candidates {
+		if status == "" && models.IsFinalStatus(delivery.Status) {
+			continue
+		}
+		if motoristaID != 0 && (delivery.MotoristaID == nil || *delivery.MotoristaID != motoristaID) {
+			continue
+		}
+		distance := utils.Haversine(lat, lng, delivery.Latitude, delivery.Longitude)
+		if distance <= radiusKm {
+			nearby = append(nearby, models.NearbyDelivery{Delivery: delivery, DistanciaKm: math.Round(distance*1000) / 1000})
+		}
+	}
+
+	// Ordena da mais próxima para a mais distante (o ID desempata) e aplica o limite
+	sort.Slice(nearby, func(i, j int) bool {
+		if nearby[i].DistanciaKm != nearby[j].DistanciaKm {
+			return nearby[i].DistanciaKm < nearby[j].DistanciaKm
+		}
+		return nearby[i].ID < nearby[j].ID
+	})
+	if len(nearby) > limit {
+		nearby = nearby[:limit]
+	}
+	return nearby, nil
+}
+
+// Update atualiza os dados de uma entrega no banco de dados, padronizando o endereço e recalculando a sua zona.
 func (s *DeliveryService) Update(id int, delivery models.Delivery) error {
+	// Valida os dados da entrega, reunindo os erros de todos os campos
+	v := validation.New()
+	v.Check(delivery.ClienteID > 0, "cliente_id", validation.CodeRequired, "O campo 'cliente_id' é obrigatório")
+	validateDelivery(v, &delivery, false)
+	if err := v.Err(); err != nil {
+		return fmt.Errorf("%w: %w", ErrEntregaInvalida, err)
+	}
+
+	// Associa a entrega à zona que contém as novas coordenadas
+	var err error
+	if delivery.ZonaID, err = s.locateZone(delivery); err != nil {
+		return err
+	}
+
+	// Guarda os valores anteriores para a auditoria
+	before, err := s.snapshot(id)
+	if err != nil {
+		return err
+	}
+
 	// Chama o método Update do repositório para atualizar a entrega
-	return s.Repository.Update(id, delivery)
+	if err := s.Repository.Update(id, delivery); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrEntregaNaoEncontrada
+		}
+		return err
+	}
+
+	// Registra a alteração na auditoria
+	s.auditDeliveryChange(models.AcaoAtualizacao, id, before)
+	return nil
+}
+
+// locateZone retorna o ID da zona que contém as coordenadas da entrega, ou nil se ela estiver fora de todas.
+func (s *DeliveryService) locateZone(delivery models.Delivery) (*int, error) {
+	zones, err := s.Zones.List()
+	if err != nil {
+		return nil, err
+	}
+	return locateZone(zones, delivery.Latitude, delivery.Longitude), nil
+}
+
+// UpdateStatus altera o status de uma entrega, permitindo apenas as transições
+// definidas em models.StatusTransitions. A mudança é registrada no histórico de
+// rastreamento usando os dados do evento informado (localização e observação), em nome
+// do ator do serviço.
+func (s *DeliveryService) UpdateStatus(id int, event models.TrackingEvent) (*models.Delivery, error) {
+	// Verifica o status informado e os dados do evento
+	v := validation.New()
+	validateTrackingEvent(v, &event, true)
+	if err := v.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrEventoInvalido, err)
+	}
+
+	// Busca a entrega para conhecer o status atual
+	delivery, err := s.Repository.FindByID(id)
+	if err != nil {
+		return nil, err // Retorna erro se houver problema ao buscar a entrega
+	}
+	if delivery == nil {
+		return nil, ErrEntregaNaoEncontrada
+	}
+
+	// Verifica se a transição do status atual para o novo status é permitida
+	if !models.CanTransition(delivery.Status, event.Status) {
+		return nil, fmt.Errorf("%w: de '%s' para '%s'", ErrTransicaoInvalida, delivery.Status, event.Status)
+	}
+
+	// Persiste o novo status, desde que o status não tenha sido alterado por outra operação desde a leitura
+	if err := s.Repository.UpdateStatus(id, delivery.Status, event.Status); err != nil {
+		switch {
+		case errors.Is(err, repositories.ErrConflict):
+			return nil, fmt.Errorf("%w: o status da entrega foi alterado por outra operação", ErrTransicaoInvalida)
+		case errors.Is(err, repositories.ErrNotFound):
+			return nil, ErrEntregaNaoEncontrada
+		}
+		return nil, err
+	}
+
+	// Registra a mudança de status no histórico de rastreamento. Se o registro falhar, o status anterior é
+	// restaurado, para que nenhuma transição fique sem histórico
+	event.EntregaID = id
+	event.DataHora = time.Now()
+	event.Responsavel = s.Actor
+	if err := s.Events.Create(&event); err != nil {
+		if rollbackErr := s.Repository.UpdateStatus(id, event.Status, delivery.Status); rollbackErr != nil {
+			return nil, errors.Join(err, rollbackErr)
+		}
+		return nil, err
+	}
+
+	// Registra a mudança de status na auditoria
+	updated := *delivery
+	updated.Status = event.Status
+	s.auditDelivery(models.AcaoAtualizacao, delivery, &updated)
+
+	updated.UltimoEvento = &event
+	return &updated, nil
 }
 
 // Delete remove uma entrega do banco de dados.
 func (s *DeliveryService) Delete(id int) error {
+	// Guarda os valores anteriores para a auditoria
+	before, err := s.snapshot(id)
+	if err != nil {
+		return err
+	}
+
 	// Chama o método Delete do repositório para deletar a entrega
-	return s.Repository.Delete(id)
+	if err := s.Repository.Delete(id); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrEntregaNaoEncontrada
+		}
+		return err
+	}
+
+	// Registra a remoção na auditoria
+	s.auditDelivery(models.AcaoRemocao, before, nil)
+	return nil
 }
@@ -0,0 +1,257 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/utils"
+	"meu-projeto/backend/validation"
+)
+
+// Erros retornados pelo DriverService.
+var (
+	ErrMotoristaNaoEncontrado = errors.New("motorista não encontrado")
+	ErrMotoristaInvalido      = errors.New("motorista inválido")
+	ErrMotoristaDuplicado     = errors.New("motorista já cadastrado")
+	ErrAtribuicaoInvalida     = errors.New("atribuição de entrega não permitida")
+)
+
+// DriverService é uma estrutura que contém métodos para lidar com a lógica de negócio relacionada
+// aos motoristas e à atribuição de entregas a eles.
+type DriverService struct {
+	Repository repositories.DriverStore // Repositório para interagir com o banco de dados
+	Deliveries *DeliveryService         // Serviço de entregas (usado na atribuição e no histórico)
+}
+
+// ForTenant retorna uma cópia do serviço em que a atribuição e a carga de trabalho consideram apenas as
+// entregas do embarcador informado. Os motoristas são compartilhados por todos os embarcadores.
+func (s *DriverService) ForTenant(embarcadorID int) *DriverService {
+	return &DriverService{Repository: s.Repository, Deliveries: s.Deliveries.ForTenant(embarcadorID)}
+}
+
+// ForActor retorna uma cópia do serviço que registra as atribuições na auditoria das entregas em nome do ator
+// informado (o e-mail do usuário ou o prefixo da chave de API).
+func (s *DriverService) ForActor(actor string) *DriverService {
+	return &DriverService{Repository: s.Repository, Deliveries: s.Deliveries.ForActor(actor)}
+}
+
+// Create valida e cadastra um novo motorista.
+func (s *DriverService) Create(driver *models.Motorista) error {
+	if err := s.validate(driver); err != nil {
+		return err
+	}
+	return s.Repository.Create(driver)
+}
+
+// List retorna uma página de motoristas de acordo com o filtro e a paginação informados.
+func (s *DriverService) List(filter models.DriverFilter) (models.Page[models.Motorista], error) {
+	// Chama o método List do repositório para obter a página de motoristas
+	drivers, total, err := s.Repository.List(filter)
+	if err != nil {
+		return models.Page[models.Motorista]{}, err
+	}
+	return models.NewPage(drivers, total, filter.Page, filter.PageSize), nil
+}
+
+// FindByID busca um motorista pelo ID.
+func (s *DriverService) FindByID(id int) (*models.Motorista, error) {
+	driver, err := s.Repository.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if driver == nil {
+		return nil, ErrMotoristaNaoEncontrado
+	}
+	return driver, nil
+}
+
+// Update valida e atualiza os dados de um motorista existente.
+func (s *DriverService) Update(driver *models.Motorista) error {
+	// Verifica se o motorista existe
+	existing, err := s.FindByID(driver.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.validate(driver); err != nil {
+		return err
+	}
+	if err := s.Repository.Update(driver); err != nil {
+		return err
+	}
+	driver.DataCadastro = existing.DataCadastro
+	return nil
+}
+
+// Delete remove um motorista. As entregas atribuídas a ele ficam sem motorista, o que é registrado na
+// auditoria de cada uma.
+func (s *DriverService) Delete(id int) error {
+	// Verifica se o motorista existe
+	if _, err := s.FindByID(id); err != nil {
+		return err
+	}
+
+	// Guarda as entregas atribuídas ao motorista para a auditoria. Os motoristas são compartilhados, e a
+	// remoção afeta as entregas de todos os embarcadores
+	var deliveries []models.Delivery
+	if s.Deliveries != nil && s.Deliveries.Audit != nil {
+		var err error
+		if deliveries, err = listAllDeliveries(s.Deliveries.Repository.ForTenant(0), models.DeliveryFilter{MotoristaID: id}); err != nil {
+			return err
+		}
+	}
+
+	if err := s.Repository.Delete(id); err != nil {
+		return err
+	}
+
+	// Registra na auditoria a remoção do motorista das entregas
+	for _, delivery := range deliveries {
+		updated := delivery
+		updated.MotoristaID = nil
+		s.Deliveries.auditDelivery(models.AcaoAtualizacao, &delivery, &updated)
+	}
+	return nil
+}
+
+// Assign atribui uma entrega ao motorista e registra a atribuição no histórico da entrega.
+// Se a entrega estiver com outro motorista, ela é transferida.
+func (s *DriverService) Assign(driverID, deliveryID int) (*models.Delivery, error) {
+	driver, delivery, err := s.findDriverAndDelivery(driverID, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apenas motoristas ativos recebem entregas, e entregas finalizadas não podem ser atribuídas
+	if !driver.Ativo {
+		return nil, fmt.Errorf("%w: o motorista %s está inativo", ErrAtribuicaoInvalida, driver.Nome)
+	}
+	if models.IsFinalStatus(delivery.Status) {
+		return nil, fmt.Errorf("%w: a entrega está com status final '%s'", ErrAtribuicaoInvalida, delivery.Status)
+	}
+
+	// A entrega já está com este motorista: nada a fazer
+	if delivery.MotoristaID != nil && *delivery.MotoristaID == driverID {
+		return delivery, nil
+	}
+
+	observacao := "Entrega atribuída ao motorista " + driver.Nome
+	if delivery.MotoristaID != nil {
+		if previous, err := s.Repository.FindByID(*delivery.MotoristaID); err == nil && previous != nil {
+			observacao = "Entrega transferida do motorista " + previous.Nome + " para o motorista " + driver.Nome
+		}
+	}
+
+	delivery, err = s.Deliveries.updateDriver(delivery, &driverID)
+	if err != nil {
+		return nil, err
+	}
+	return s.recordAssignment(delivery, observacao)
+}
+
+// Unassign remove a entrega do motorista e registra a remoção no histórico da entrega.
+func (s *DriverService) Unassign(driverID, deliveryID int) (*models.Delivery, error) {
+	driver, delivery, err := s.findDriverAndDelivery(driverID, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A entrega precisa estar atribuída a este motorista
+	if delivery.MotoristaID == nil || *delivery.MotoristaID != driverID {
+		return nil, fmt.Errorf("%w: a entrega não está atribuída ao motorista %s", ErrAtribuicaoInvalida, driver.Nome)
+	}
+
+	delivery, err = s.Deliveries.updateDriver(delivery, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.recordAssignment(delivery, "Entrega removida do motorista "+driver.Nome)
+}
+
+// Workload retorna as entregas em aberto (status não final) atribuídas ao motorista.
+func (s *DriverService) Workload(driverID int) ([]models.Delivery, error) {
+	// Verifica se o motorista existe
+	if _, err := s.FindByID(driverID); err != nil {
+		return nil, err
+	}
+
+	deliveries, err := listAllDeliveries(s.Deliveries.Repository, models.DeliveryFilter{MotoristaID: driverID, Sort: "data_cadastro"})
+	if err != nil {
+		return nil, err
+	}
+	return openDeliveries(deliveries), nil
+}
+
+// findDriverAndDelivery busca o motorista e a entrega envolvidos em uma atribuição.
+func (s *DriverService) findDriverAndDelivery(driverID, deliveryID int) (*models.Motorista, *models.Delivery, error) {
+	driver, err := s.FindByID(driverID)
+	if err != nil {
+		return nil, nil, err
+	}
+	delivery, err := s.Deliveries.Repository.FindByID(deliveryID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if delivery == nil {
+		return nil, nil, ErrEntregaNaoEncontrada
+	}
+	return driver, delivery, nil
+}
+
+// recordAssignment registra a mudança de motorista no histórico de rastreamento, mantendo o status atual. O
+// responsável pelo evento é quem fez a atribuição (o ator do serviço de entregas), não o motorista.
+func (s *DriverService) recordAssignment(delivery *models.Delivery, observacao string) (*models.Delivery, error) {
+	event := models.TrackingEvent{EntregaID: delivery.ID, DataHora: time.Now(), Status: delivery.Status, Observacao: observacao, Responsavel: s.Deliveries.Actor}
+	if err := s.Deliveries.Events.Create(&event); err != nil {
+		return nil, err
+	}
+	delivery.UltimoEvento = &event
+	return delivery, nil
+}
+
+// validate normaliza e verifica os campos do motorista, incluindo a unicidade do CPF e da CNH.
+func (s *DriverService) validate(driver *models.Motorista) error {
+	driver.Nome = strings.TrimSpace(driver.Nome)
+	driver.CNHNumero = strings.TrimSpace(driver.CNHNumero)
+	driver.CNHCategoria = strings.ToUpper(strings.TrimSpace(driver.CNHCategoria))
+
+	// Verifica os campos, reunindo os erros de todos eles
+	v := validation.New()
+	if v.Required("nome", driver.Nome) {
+		v.MaxLength("nome", driver.Nome, 100)
+	}
+	if v.Required("cpf", driver.CPF) && v.Check(utils.ValidateCPF(driver.CPF), "cpf", validation.CodeInvalidFormat, "CPF '"+driver.CPF+"' inválido") {
+		driver.CPF = utils.FormatCPF(driver.CPF)
+	}
+	if v.Required("cnh_numero", driver.CNHNumero) {
+		v.Check(utils.ValidateCNH(driver.CNHNumero), "cnh_numero", validation.CodeInvalidFormat, "O número da CNH deve ter 11 dígitos")
+	}
+	if v.Required("cnh_categoria", driver.CNHCategoria) {
+		v.Check(models.CNHCategories[driver.CNHCategoria], "cnh_categoria", validation.CodeInvalidValue, "Categoria de CNH '"+driver.CNHCategoria+"' desconhecida (use A, B, C, D, E, AB, AC, AD ou AE)")
+	}
+	v.MaxLength("telefone", driver.Telefone, 20)
+	if err := v.Err(); err != nil {
+		return fmt.Errorf("%w: %w", ErrMotoristaInvalido, err)
+	}
+
+	// O CPF e a CNH devem ser únicos entre os motoristas
+	existing, err := s.Repository.FindByCPF(driver.CPF)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != driver.ID {
+		return fmt.Errorf("%w: CPF %s", ErrMotoristaDuplicado, driver.CPF)
+	}
+	existing, err = s.Repository.FindByCNH(driver.CNHNumero)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != driver.ID {
+		return fmt.Errorf("%w: CNH %s", ErrMotoristaDuplicado, driver.CNHNumero)
+	}
+	return nil
+}
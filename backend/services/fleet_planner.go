@@ -0,0 +1,103 @@
+package services
+
+import (
+	"math"
+	"sort"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/utils"
+)
+
+// capacityTolerance evita que erros de arredondamento na soma dos pesos excluam uma entrega que cabe exatamente.
+const capacityTolerance = 1e-6
+
+// sweepAssign distribui as entregas entre os veículos pelo método de varredura (sweep), uma heurística
+// clássica para o problema de roteamento de veículos com capacidade (CVRP):
+//
+//  1. as entregas são ordenadas pelo ângulo em relação ao depósito, começando após o maior intervalo
+//     entre ângulos consecutivos, para não separar entregas vizinhas;
+//  2. os veículos, do maior para o menor, recebem as entregas na ordem da varredura até que a
+//     próxima não caiba;
+//  3. as entregas que sobrarem vão para o veículo com capacidade livre que tiver a parada mais próxima.
+//
+// Retorna as entregas de cada veículo (no mesmo índice de vehicles) e as entregas que não couberam.
+func sweepAssign(depotLat, depotLng float64, deliveries []models.Delivery, vehicles []models.Vehicle) ([][]models.Delivery, []models.Delivery) {
+	loads := make([][]models.Delivery, len(vehicles))
+	weights := make([]float64, len(vehicles))
+	pending := sweepOrder(depotLat, depotLng, deliveries)
+
+	// Veículos em ordem decrescente de capacidade (o ID desempata)
+	order := make([]int, len(vehicles))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return vehicles[order[a]].CapacidadePeso > vehicles[order[b]].CapacidadePeso
+	})
+
+	// Primeira passada: cada veículo recebe entregas consecutivas da varredura
+	for _, v := range order {
+		taken := 0
+		for taken < len(pending) && weights[v]+pending[taken].Peso <= vehicles[v].CapacidadePeso+capacityTolerance {
+			weights[v] += pending[taken].Peso
+			loads[v] = append(loads[v], pending[taken])
+			taken++
+		}
+		pending = pending[taken:]
+	}
+
+	// Segunda passada: encaixa as entregas restantes no veículo com folga mais próximo
+	var unassigned []models.Delivery
+	for _, delivery := range pending {
+		best, bestDistance := -1, math.Inf(1)
+		for v, vehicle := range vehicles {
+			if weights[v]+delivery.Peso > vehicle.CapacidadePeso+capacityTolerance {
+				continue // Não cabe neste veículo
+			}
+			distance := utils.Haversine(depotLat, depotLng, delivery.Latitude, delivery.Longitude)
+			for _, stop := range loads[v] {
+				distance = math.Min(distance, utils.Haversine(stop.Latitude, stop.Longitude, delivery.Latitude, delivery.Longitude))
+			}
+			if distance < bestDistance {
+				best, bestDistance = v, distance
+			}
+		}
+
+		if best == -1 {
+			unassigned = append(unassigned, delivery) // Não cabe em nenhum veículo
+			continue
+		}
+		weights[best] += delivery.Peso
+		loads[best] = append(loads[best], delivery)
+	}
+	return loads, unassigned
+}
+
+// sweepOrder ordena as entregas pelo ângulo em relação ao depósito, começando logo após o maior
+// intervalo angular entre duas entregas consecutivas.
+func sweepOrder(depotLat, depotLng float64, deliveries []models.Delivery) []models.Delivery {
+	sorted := append([]models.Delivery(nil), deliveries...)
+	if len(sorted) < 2 {
+		return sorted
+	}
+
+	angle := func(delivery models.Delivery) float64 {
+		return math.Atan2(delivery.Latitude-depotLat, delivery.Longitude-depotLng)
+	}
+	sort.SliceStable(sorted, func(i, j int) bool { return angle(sorted[i]) < angle(sorted[j]) })
+
+	// Procura o maior intervalo, incluindo o que fecha o círculo (da última para a primeira)
+	start := 0
+	largest := angle(sorted[0]) + 2*math.Pi - angle(sorted[len(sorted)-1])
+	for i := 1; i < len(sorted); i++ {
+		if gap := angle(sorted[i]) - angle(sorted[i-1]); gap > largest {
+			start, largest = i, gap
+		}
+	}
+	return append(append([]models.Delivery{}, sorted[start:]...), sorted[:start]...)
+}
+
+// roundKg arredonda um peso para duas casas decimais.
+func roundKg(kg float64) float64 {
+	return math.Round(kg*100) / 100
+}
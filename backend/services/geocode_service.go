@@ -0,0 +1,116 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"meu-projeto/backend/geocoding"
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/utils"
+)
+
+// reverseDeliveryRadiusKm é a distância máxima até uma entrega cadastrada para que o seu endereço seja usado na busca reversa.
+const reverseDeliveryRadiusKm = 0.5
+
+// ErrLocalNaoEncontrado indica que não há nenhum endereço conhecido perto das coordenadas consultadas.
+var ErrLocalNaoEncontrado = errors.New("nenhum endereço conhecido perto das coordenadas")
+
+// GeocodeService é uma estrutura que contém métodos para converter coordenadas no endereço conhecido mais próximo.
+type GeocodeService struct {
+	Dataset    geocoding.ReverseGeocoder  // Dicionário geográfico local
+	Deliveries repositories.DeliveryStore // Entregas cadastradas, usadas quando o dicionário não conhece a rua
+}
+
+// ForTenant retorna uma cópia do serviço que usa apenas os endereços das entregas do embarcador informado.
+func (s *GeocodeService) ForTenant(embarcadorID int) *GeocodeService {
+	return &GeocodeService{Dataset: s.Dataset, Deliveries: s.Deliveries.ForTenant(embarcadorID)}
+}
+
+// Reverse retorna o endereço conhecido mais próximo do ponto. Uma rua do dicionário geográfico tem preferência;
+// se não houver, usa o endereço da entrega cadastrada mais próxima (até 500 m) e, por fim, o bairro ou a cidade do dicionário.
+func (s *GeocodeService) Reverse(lat, lng float64) (*models.ReverseGeocodeResult, error) {
+	if !utils.ValidCoordinates(lat, lng) {
+		return nil, fmt.Errorf("%w: coordenadas fora dos limites", ErrBuscaInvalida)
+	}
+
+	// Procura no dicionário geográfico
+	place, err := s.Dataset.Reverse(lat, lng)
+	if err != nil && !errors.Is(err, geocoding.ErrNotFound) {
+		return nil, err
+	}
+	if place != nil && place.Precisao == geocoding.PrecisaoLogradouro {
+		return placeResult(place), nil
+	}
+
+	// Sem rua conhecida no dicionário, procura a entrega cadastrada mais próxima
+	nearest, err := s.nearestDelivery(lat, lng)
+	if err != nil {
+		return nil, err
+	}
+	if nearest != nil {
+		return nearest, nil
+	}
+
+	// Por fim, usa o bairro ou a cidade do dicionário
+	if place != nil {
+		return placeResult(place), nil
+	}
+	return nil, ErrLocalNaoEncontrado
+}
+
+// nearestDelivery retorna o endereço da entrega cadastrada mais próxima do ponto, dentro de reverseDeliveryRadiusKm.
+func (s *GeocodeService) nearestDelivery(lat, lng float64) (*models.ReverseGeocodeResult, error) {
+	var box models.BoundingBox
+	box.MinLat, box.MaxLat, box.MinLng, box.MaxLng = utils.BoundingBox(lat, lng, reverseDeliveryRadiusKm)
+	candidates, err := s.Deliveries.FindInBoundingBox(box, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var nearest *models.ReverseGeocodeResult
+	for _, delivery := range candidates {
+		distance := utils.Haversine(lat, lng, delivery.Latitude, delivery.Longitude)
+		if distance > reverseDeliveryRadiusKm || (nearest != nil && nearest.DistanciaKm <= distance) {
+			continue
+		}
+
+		// Sem logradouro, usa o início do endereço completo
+		logradouro := delivery.Logradouro
+		if logradouro == "" {
+			logradouro = geocoding.StreetFromEndereco(delivery.Endereco)
+		}
+		nearest = &models.ReverseGeocodeResult{
+			Logradouro:  logradouro,
+			Bairro:      delivery.Bairro,
+			Cidade:      delivery.Cidade,
+			Estado:      delivery.Estado,
+			Pais:        delivery.Pais,
+			Latitude:    delivery.Latitude,
+			Longitude:   delivery.Longitude,
+			DistanciaKm: distance,
+			Precisao:    geocoding.PrecisaoLogradouro,
+			Fonte:       models.ReverseSourceDelivery,
+		}
+	}
+	if nearest != nil {
+		nearest.DistanciaKm = roundKm(nearest.DistanciaKm)
+	}
+	return nearest, nil
+}
+
+// placeResult converte um lugar do dicionário geográfico no resultado da busca reversa.
+func placeResult(place *geocoding.Place) *models.ReverseGeocodeResult {
+	return &models.ReverseGeocodeResult{
+		Logradouro:  place.Logradouro,
+		Bairro:      place.Bairro,
+		Cidade:      place.Cidade,
+		Estado:      place.Estado,
+		Pais:        place.Pais,
+		Latitude:    place.Latitude,
+		Longitude:   place.Longitude,
+		DistanciaKm: roundKm(place.DistanciaKm),
+		Precisao:    place.Precisao,
+		Fonte:       models.ReverseSourceGazetteer,
+	}
+}
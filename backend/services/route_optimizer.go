@@ -0,0 +1,121 @@
+package services
+
+import (
+	"math"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/utils"
+)
+
+// distanceMatrix calcula as distâncias (em km) entre todos os pontos. O índice 0 é o depósito
+// e o índice i+1 corresponde à entrega i.
+func distanceMatrix(depotLat, depotLng float64, deliveries []models.Delivery) [][]float64 {
+	lats := []float64{depotLat}
+	lngs := []float64{depotLng}
+	for _, delivery := range deliveries {
+		lats = append(lats, delivery.Latitude)
+		lngs = append(lngs, delivery.Longitude)
+	}
+
+	dist := make([][]float64, len(lats))
+	for i := range dist {
+		dist[i] = make([]float64, len(lats))
+		for j := 0; j < i; j++ {
+			dist[i][j] = utils.Haversine(lats[i], lngs[i], lats[j], lngs[j])
+			dist[j][i] = dist[i][j]
+		}
+	}
+	return dist
+}
+
+// nearestNeighbour monta uma rota inicial partindo do depósito (índice 0) e indo sempre
+// para o ponto ainda não visitado mais próximo.
+func nearestNeighbour(dist [][]float64) []int {
+	tour := []int{0}
+	visited := make([]bool, len(dist))
+	visited[0] = true
+
+	for current := 0; len(tour) < len(dist); {
+		next := -1
+		for candidate := range dist {
+			if !visited[candidate] && (next == -1 || dist[current][candidate] < dist[current][next]) {
+				next = candidate
+			}
+		}
+		visited[next] = true
+		tour = append(tour, next)
+		current = next
+	}
+	return tour
+}
+
+// twoOpt melhora a rota invertendo trechos enquanto isso reduzir a distância total.
+// O depósito permanece na primeira posição; se closed for true, a volta ao depósito é considerada.
+func twoOpt(tour []int, dist [][]float64, closed bool) {
+	n := len(tour)
+	for improved := true; improved; {
+		improved = false
+		for i := 1; i < n-1; i++ {
+			for j := i + 1; j < n; j++ {
+				// Troca as arestas (a,b) e (c,d) por (a,c) e (b,d), invertendo o trecho de b até c
+				a, b, c := tour[i-1], tour[i], tour[j]
+				delta := dist[a][c] - dist[a][b]
+				if j+1 < n {
+					d := tour[j+1]
+					delta += dist[b][d] - dist[c][d]
+				} else if closed {
+					delta += dist[b][0] - dist[c][0]
+				}
+
+				if delta < -1e-9 {
+					for left, right := i, j; left < right; left, right = left+1, right-1 {
+						tour[left], tour[right] = tour[right], tour[left]
+					}
+					improved = true
+				}
+			}
+		}
+	}
+}
+
+// roundKm arredonda uma distância para metros (três casas decimais).
+func roundKm(km float64) float64 {
+	return math.Round(km*1000) / 1000
+}
+
+// buildRoute ordena as entregas a partir do depósito (vizinho mais próximo seguido de 2-opt)
+// e calcula a distância de cada trecho e a distância total.
+func buildRoute(depotLat, depotLng float64, deliveries []models.Delivery, returnToDepot bool) models.Route {
+	route := models.Route{Paradas: []models.RouteStop{}}
+	if len(deliveries) == 0 {
+		return route
+	}
+
+	dist := distanceMatrix(depotLat, depotLng, deliveries)
+	tour := nearestNeighbour(dist)
+	twoOpt(tour, dist, returnToDepot)
+
+	total := 0.0
+	for position := 1; position < len(tour); position++ {
+		delivery := deliveries[tour[position]-1]
+		leg := dist[tour[position-1]][tour[position]]
+		total += leg
+		route.Paradas = append(route.Paradas, models.RouteStop{
+			Ordem:          position,
+			EntregaID:      delivery.ID,
+			CodigoRastreio: delivery.CodigoRastreio,
+			Endereco:       delivery.Endereco,
+			Cidade:         delivery.Cidade,
+			Latitude:       delivery.Latitude,
+			Longitude:      delivery.Longitude,
+			DistanciaKm:    roundKm(leg),
+		})
+	}
+	if returnToDepot {
+		back := dist[tour[len(tour)-1]][0]
+		total += back
+		route.RetornoKm = roundKm(back)
+	}
+	route.DistanciaTotalKm = roundKm(total)
+	return route
+}
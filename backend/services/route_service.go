@@ -0,0 +1,231 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/utils"
+	"meu-projeto/backend/validation"
+)
+
+// ErrRotaInvalida é retornado quando a requisição de otimização de rota é inválida.
+var ErrRotaInvalida = errors.New("requisição de rota inválida")
+
+// RouteService é uma estrutura que contém a lógica de otimização da ordem de visita das entregas
+// e de distribuição das entregas entre os veículos da frota.
+// O cálculo é feito inteiramente a partir das coordenadas armazenadas, sem serviços externos.
+type RouteService struct {
+	Deliveries repositories.DeliveryStore // Repositório de entregas
+	Vehicles   repositories.VehicleStore  // Repositório de veículos
+}
+
+// ForTenant retorna uma cópia do serviço que planeja apenas as entregas do embarcador informado.
+func (s *RouteService) ForTenant(embarcadorID int) *RouteService {
+	return &RouteService{Deliveries: s.Deliveries.ForTenant(embarcadorID), Vehicles: s.Vehicles}
+}
+
+// Optimize calcula uma boa ordem de visita para as entregas solicitadas, partindo do depósito.
+func (s *RouteService) Optimize(request models.RouteRequest) (*models.Route, error) {
+	deliveries, err := s.selectDeliveries(request)
+	if err != nil {
+		return nil, err
+	}
+
+	route := buildRoute(*request.DepositoLatitude, *request.DepositoLongitude, deliveries, request.RetornarAoDeposito)
+	return &route, nil
+}
+
+// Plan distribui as entregas solicitadas entre os veículos disponíveis, respeitando a capacidade
+// de peso de cada um, e calcula uma rota otimizada por veículo.
+func (s *RouteService) Plan(request models.FleetPlanRequest) (*models.FleetPlan, error) {
+	deliveries, err := s.selectDeliveries(request.RouteRequest)
+	if err != nil {
+		return nil, err
+	}
+	vehicles, err := s.availableVehicles(request.VeiculoIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	depotLat, depotLng := *request.DepositoLatitude, *request.DepositoLongitude
+	loads, unassigned := sweepAssign(depotLat, depotLng, deliveries, vehicles)
+
+	plan := &models.FleetPlan{Rotas: []models.VehicleRoute{}, EntregasNaoAlocadas: []int{}}
+	total := 0.0
+	for i, vehicle := range vehicles {
+		if len(loads[i]) == 0 {
+			continue // Veículo não utilizado
+		}
+		route := models.VehicleRoute{Veiculo: vehicle, Route: buildRoute(depotLat, depotLng, loads[i], request.RetornarAoDeposito)}
+		for _, delivery := range loads[i] {
+			route.PesoTotalKg += delivery.Peso
+		}
+		route.PesoTotalKg = roundKg(route.PesoTotalKg)
+		total += route.DistanciaTotalKm
+		plan.Rotas = append(plan.Rotas, route)
+	}
+	for _, delivery := range unassigned {
+		plan.EntregasNaoAlocadas = append(plan.EntregasNaoAlocadas, delivery.ID)
+	}
+	plan.DistanciaTotalKm = roundKm(total)
+	return plan, nil
+}
+
+// selectDeliveries valida o depósito e busca as entregas da requisição, pelos IDs ou pelo filtro de cidade e data.
+func (s *RouteService) selectDeliveries(request models.RouteRequest) ([]models.Delivery, error) {
+	// Valida o depósito e a seleção das entregas, reunindo os erros de todos os campos
+	v := validation.New()
+	if request.DepositoLatitude == nil || request.DepositoLongitude == nil {
+		v.Check(request.DepositoLatitude != nil, "deposito_latitude", validation.CodeRequired, "O campo 'deposito_latitude' é obrigatório")
+		v.Check(request.DepositoLongitude != nil, "deposito_longitude", validation.CodeRequired, "O campo 'deposito_longitude' é obrigatório")
+	} else if !utils.ValidCoordinates(*request.DepositoLatitude, *request.DepositoLongitude) {
+		v.Add("deposito_latitude", validation.CodeOutOfRange, "As coordenadas do depósito estão fora dos limites")
+	}
+	if len(request.EntregaIDs) == 0 {
+		v.Check(request.Cidade != "" || request.Data != "", "entrega_ids", validation.CodeRequired, "Informe entrega_ids ou um filtro de cidade e/ou data")
+		if request.Data != "" {
+			_, err := time.Parse("2006-01-02", request.Data)
+			v.Check(err == nil, "data", validation.CodeInvalidFormat, "A data deve estar no formato AAAA-MM-DD")
+		}
+	}
+	if err := v.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRotaInvalida, err)
+	}
+
+	// Busca as entregas pelos IDs ou pelo filtro de cidade e data
+	var deliveries []models.Delivery
+	var err error
+	if len(request.EntregaIDs) > 0 {
+		deliveries, err = s.findByIDs(request.EntregaIDs)
+	} else {
+		deliveries, err = s.findByFilter(request.Cidade, request.Data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(deliveries) > models.MaxRouteStops {
+		return nil, fmt.Errorf("%w: a rota pode ter no máximo %d paradas", ErrRotaInvalida, models.MaxRouteStops)
+	}
+	return deliveries, nil
+}
+
+// availableVehicles retorna os veículos informados (que devem existir e estar ativos)
+// ou, se nenhum for informado, todos os veículos ativos da frota.
+func (s *RouteService) availableVehicles(ids []int) ([]models.Vehicle, error) {
+	vehicles := []models.Vehicle{}
+	if len(ids) > 0 {
+		seen := make(map[int]bool)
+		for _, id := range ids {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+
+			vehicle, err := s.Vehicles.FindByID(id)
+			if err != nil {
+				return nil, err
+			}
+			if vehicle == nil {
+				return nil, fmt.Errorf("%w: %d", ErrVeiculoNaoEncontrado, id)
+			}
+			if !vehicle.Ativo {
+				return nil, fmt.Errorf("%w: o veículo %s está inativo", ErrRotaInvalida, vehicle.Placa)
+			}
+			vehicles = append(vehicles, *vehicle)
+		}
+		return vehicles, nil
+	}
+
+	// Percorre todas as páginas de veículos ativos
+	ativo := true
+	filter := models.VehicleFilter{Ativo: &ativo, Page: 1, PageSize: models.MaxPageSize}
+	for {
+		page, total, err := s.Vehicles.List(filter)
+		if err != nil {
+			return nil, err
+		}
+		vehicles = append(vehicles, page...)
+		if len(page) == 0 || filter.Page*filter.PageSize >= total {
+			break
+		}
+		filter.Page++
+	}
+	if len(vehicles) == 0 {
+		return nil, fmt.Errorf("%w: nenhum veículo ativo disponível", ErrRotaInvalida)
+	}
+	return vehicles, nil
+}
+
+// findByIDs busca as entregas pelos IDs informados, ignorando IDs repetidos.
+func (s *RouteService) findByIDs(ids []int) ([]models.Delivery, error) {
+	seen := make(map[int]bool)
+	deliveries := []models.Delivery{}
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		delivery, err := s.Deliveries.FindByID(id)
+		if err != nil {
+			return nil, err
+		}
+		if delivery == nil {
+			return nil, fmt.Errorf("%w: %d", ErrEntregaNaoEncontrada, id)
+		}
+		deliveries = append(deliveries, *delivery)
+	}
+	return deliveries, nil
+}
+
+// findByFilter busca as entregas ainda em aberto (status não final) da cidade e do dia informados.
+// O filtro já foi validado por selectDeliveries.
+func (s *RouteService) findByFilter(cidade, data string) ([]models.Delivery, error) {
+	filter := models.DeliveryFilter{Cidade: cidade}
+	if data != "" {
+		day, err := time.Parse("2006-01-02", data)
+		if err != nil {
+			return nil, fmt.Errorf("%w: a data deve estar no formato AAAA-MM-DD", ErrRotaInvalida)
+		}
+		next := day.AddDate(0, 0, 1)
+		filter.DataInicio, filter.DataFim = &day, &next
+	}
+
+	// Considera apenas as entregas ainda em aberto
+	deliveries, err := listAllDeliveries(s.Deliveries, filter)
+	if err != nil {
+		return nil, err
+	}
+	return openDeliveries(deliveries), nil
+}
+
+// listAllDeliveries percorre todas as páginas da listagem e retorna todas as entregas que atendem ao filtro.
+func listAllDeliveries(store repositories.DeliveryStore, filter models.DeliveryFilter) ([]models.Delivery, error) {
+	filter.Page, filter.PageSize = 1, models.MaxPageSize
+	deliveries := []models.Delivery{}
+	for {
+		page, total, err := store.List(filter)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, page...)
+		if len(page) == 0 || filter.Page*filter.PageSize >= total {
+			return deliveries, nil
+		}
+		filter.Page++
+	}
+}
+
+// openDeliveries retorna apenas as entregas que ainda não chegaram a um status final.
+func openDeliveries(deliveries []models.Delivery) []models.Delivery {
+	open := []models.Delivery{}
+	for _, delivery := range deliveries {
+		if !models.IsFinalStatus(delivery.Status) {
+			open = append(open, delivery)
+		}
+	}
+	return open
+}
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/validation"
+)
+
+// Erros retornados pelo ShipperService.
+var (
+	ErrEmbarcadorInvalido  = errors.New("dados do embarcador inválidos")
+	ErrEmbarcadorDuplicado = errors.New("embarcador já cadastrado")
+)
+
+// ShipperService é uma estrutura que contém métodos para lidar com os embarcadores, as empresas para as quais as
+// entregas são feitas.
+type ShipperService struct {
+	Repository repositories.ShipperStore // Repositório para interagir com o banco de dados
+}
+
+// Create valida e cadastra um novo embarcador.
+func (s *ShipperService) Create(shipper *models.Embarcador) error {
+	shipper.Nome = strings.TrimSpace(shipper.Nome)
+	v := validation.New()
+	if v.Required("nome", shipper.Nome) {
+		v.MaxLength("nome", shipper.Nome, 100)
+	}
+	if err := v.Err(); err != nil {
+		return fmt.Errorf("%w: %w", ErrEmbarcadorInvalido, err)
+	}
+
+	if err := s.Repository.Create(shipper); err != nil {
+		if errors.Is(err, repositories.ErrConflict) {
+			return fmt.Errorf("%w: %s", ErrEmbarcadorDuplicado, shipper.Nome)
+		}
+		return err
+	}
+	return nil
+}
+
+// List retorna todos os embarcadores cadastrados.
+func (s *ShipperService) List() ([]models.Embarcador, error) {
+	return s.Repository.List()
+}
+
+// validateShipper verifica se o embarcador de um usuário ou de uma chave de API existe, adicionando o erro ao
+// campo embarcador_id. Sem o repositório de embarcadores, apenas o ID é verificado.
+func validateShipper(v *validation.Validator, shippers repositories.ShipperStore, embarcadorID int) error {
+	if !v.Check(embarcadorID > 0, "embarcador_id", validation.CodeInvalidValue, "O campo 'embarcador_id' deve ser um ID válido") || shippers == nil {
+		return nil
+	}
+	shipper, err := shippers.FindByID(embarcadorID)
+	if err != nil {
+		return err
+	}
+	v.Check(shipper != nil, "embarcador_id", validation.CodeInvalidValue, fmt.Sprintf("Embarcador %d não encontrado", embarcadorID))
+	return nil
+}
@@ -0,0 +1,147 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/utils"
+	"meu-projeto/backend/validation"
+)
+
+// ErrCodigoRastreioInvalido é retornado quando o código de rastreio não tem um formato válido.
+var ErrCodigoRastreioInvalido = errors.New("código de rastreio inválido")
+
+// TrackingEventService é uma estrutura que contém métodos para lidar com a lógica de negócio do histórico de rastreamento.
+type TrackingEventService struct {
+	Repository repositories.TrackingEventStore // Repositório para interagir com o banco de dados
+	Deliveries *DeliveryService                // Serviço de entregas, usado para validar e alterar o status
+}
+
+// ForTenant retorna uma cópia do serviço restrita às entregas do embarcador informado. O rastreio público
+// (Track) usa o serviço sem embarcador, já que os códigos de rastreio são únicos em todos eles.
+func (s *TrackingEventService) ForTenant(embarcadorID int) *TrackingEventService {
+	return &TrackingEventService{Repository: s.Repository, Deliveries: s.Deliveries.ForTenant(embarcadorID)}
+}
+
+// ForActor retorna uma cópia do serviço que registra os eventos e as mudanças de status na auditoria em nome
+// do ator informado.
+func (s *TrackingEventService) ForActor(actor string) *TrackingEventService {
+	return &TrackingEventService{Repository: s.Repository, Deliveries: s.Deliveries.ForActor(actor)}
+}
+
+// List retorna o histórico de eventos de uma entrega em ordem cronológica.
+func (s *TrackingEventService) List(entregaID int) ([]models.TrackingEvent, error) {
+	// Verifica se a entrega existe
+	delivery, err := s.Deliveries.Repository.FindByID(entregaID)
+	if err != nil {
+		return nil, err
+	}
+	if delivery == nil {
+		return nil, ErrEntregaNaoEncontrada
+	}
+
+	// Chama o método ListByEntrega do repositório para obter os eventos
+	return s.Repository.ListByEntrega(entregaID)
+}
+
+// Create adiciona um novo evento ao histórico de uma entrega, em nome do ator do serviço (o responsável
+// informado no evento é ignorado). Se o evento não informar status, é usado o status atual da entrega.
+// Se informar um status diferente do atual, a transição é validada e aplicada à entrega.
+func (s *TrackingEventService) Create(entregaID int, event *models.TrackingEvent) error {
+	// Valida os dados do evento (o status é opcional)
+	v := validation.New()
+	validateTrackingEvent(v, event, false)
+	if err := v.Err(); err != nil {
+		return fmt.Errorf("%w: %w", ErrEventoInvalido, err)
+	}
+
+	// Busca a entrega para conhecer o status atual
+	delivery, err := s.Deliveries.Repository.FindByID(entregaID)
+	if err != nil {
+		return err
+	}
+	if delivery == nil {
+		return ErrEntregaNaoEncontrada
+	}
+
+	// Evento com mudança de status: delega ao serviço de entregas, que valida a transição e registra o evento
+	if event.Status != "" && event.Status != delivery.Status {
+		updated, err := s.Deliveries.UpdateStatus(entregaID, *event)
+		if err != nil {
+			return err
+		}
+		*event = *updated.UltimoEvento
+		return nil
+	}
+
+	// Evento informativo: mantém o status atual da entrega
+	event.EntregaID = entregaID
+	event.Status = delivery.Status
+	event.DataHora = time.Now()
+	event.Responsavel = s.Deliveries.Actor
+	return s.Repository.Create(event)
+}
+
+// Track retorna a visão pública de uma entrega a partir do seu código de rastreio.
+func (s *TrackingEventService) Track(code string) (*models.TrackingView, error) {
+	// Valida o formato e o dígito verificador antes de consultar o banco de dados
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if !utils.ValidateTrackingCode(code) {
+		return nil, ErrCodigoRastreioInvalido
+	}
+
+	// Busca a entrega pelo código de rastreio
+	delivery, err := s.Deliveries.Repository.FindByTrackingCode(code)
+	if err != nil {
+		return nil, err
+	}
+	if delivery == nil {
+		return nil, ErrEntregaNaoEncontrada
+	}
+
+	// Busca o histórico de eventos da entrega
+	events, err := s.Repository.ListByEntrega(delivery.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Monta a visão pública, sem dados pessoais do cliente
+	view := &models.TrackingView{
+		CodigoRastreio: delivery.CodigoRastreio,
+		Status:         delivery.Status,
+		Cidade:         delivery.Cidade,
+		Estado:         delivery.Estado,
+		Eventos:        make([]models.TrackingViewEvent, 0, len(events)),
+	}
+	for i, event := range events {
+		// Apenas as mudanças de status são publicadas; os eventos informativos e as atribuições mantêm o status
+		if i > 0 && event.Status == events[i-1].Status {
+			continue
+		}
+		view.Eventos = append(view.Eventos, models.TrackingViewEvent{DataHora: event.DataHora, Status: event.Status, Descricao: models.StatusDescriptions[event.Status]})
+	}
+	return view, nil
+}
+
+// validateTrackingEvent verifica os campos de um evento de rastreamento: o status (obrigatório se statusRequired
+// for verdadeiro), as coordenadas, que devem ser informadas juntas, e o tamanho da observação.
+func validateTrackingEvent(v *validation.Validator, event *models.TrackingEvent, statusRequired bool) {
+	event.Status = strings.TrimSpace(event.Status)
+	if event.Status == "" {
+		v.Check(!statusRequired, "status", validation.CodeRequired, "O campo 'status' é obrigatório")
+	} else {
+		v.Check(models.IsValidStatus(event.Status), "status", validation.CodeInvalidValue, "Status '"+event.Status+"' desconhecido")
+	}
+
+	if (event.Latitude == nil) != (event.Longitude == nil) {
+		v.Add("latitude", validation.CodeRequired, "Informe a latitude e a longitude juntas")
+	} else if event.Latitude != nil && !utils.ValidCoordinates(*event.Latitude, *event.Longitude) {
+		v.Add("latitude", validation.CodeOutOfRange, "As coordenadas estão fora dos limites (latitude entre -90 e 90, longitude entre -180 e 180)")
+	}
+
+	v.MaxLength("observacao", event.Observacao, 255)
+}
@@ -0,0 +1,108 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/utils"
+	"meu-projeto/backend/validation"
+)
+
+// Erros retornados pelo VehicleService.
+var (
+	ErrVeiculoNaoEncontrado = errors.New("veículo não encontrado")
+	ErrVeiculoInvalido      = errors.New("veículo inválido")
+	ErrPlacaDuplicada       = errors.New("placa já cadastrada")
+)
+
+// VehicleService é uma estrutura que contém métodos para lidar com a lógica de negócio relacionada aos veículos da frota.
+type VehicleService struct {
+	Repository repositories.VehicleStore // Repositório para interagir com o banco de dados
+}
+
+// Create valida e cadastra um novo veículo.
+func (s *VehicleService) Create(vehicle *models.Vehicle) error {
+	if err := s.validate(vehicle); err != nil {
+		return err
+	}
+	return s.Repository.Create(vehicle)
+}
+
+// List retorna uma página de veículos de acordo com o filtro e a paginação informados.
+func (s *VehicleService) List(filter models.VehicleFilter) (models.Page[models.Vehicle], error) {
+	// Chama o método List do repositório para obter a página de veículos
+	vehicles, total, err := s.Repository.List(filter)
+	if err != nil {
+		return models.Page[models.Vehicle]{}, err
+	}
+	return models.NewPage(vehicles, total, filter.Page, filter.PageSize), nil
+}
+
+// FindByID busca um veículo pelo ID.
+func (s *VehicleService) FindByID(id int) (*models.Vehicle, error) {
+	vehicle, err := s.Repository.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if vehicle == nil {
+		return nil, ErrVeiculoNaoEncontrado
+	}
+	return vehicle, nil
+}
+
+// Update valida e atualiza os dados de um veículo existente.
+func (s *VehicleService) Update(vehicle *models.Vehicle) error {
+	// Verifica se o veículo existe
+	existing, err := s.FindByID(vehicle.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.validate(vehicle); err != nil {
+		return err
+	}
+	if err := s.Repository.Update(vehicle); err != nil {
+		return err
+	}
+	vehicle.DataCadastro = existing.DataCadastro
+	return nil
+}
+
+// Delete remove um veículo.
+func (s *VehicleService) Delete(id int) error {
+	// Verifica se o veículo existe
+	if _, err := s.FindByID(id); err != nil {
+		return err
+	}
+	return s.Repository.Delete(id)
+}
+
+// validate normaliza a placa e verifica os campos do veículo, incluindo a unicidade da placa.
+func (s *VehicleService) validate(vehicle *models.Vehicle) error {
+	// Verifica os campos, reunindo os erros de todos eles
+	vehicle.Placa = utils.NormalizePlate(vehicle.Placa)
+	v := validation.New()
+	if v.Required("placa", vehicle.Placa) {
+		v.Check(utils.ValidatePlate(vehicle.Placa), "placa", validation.CodeInvalidFormat, "Placa '"+vehicle.Placa+"' fora do padrão (ex: ABC1234 ou ABC1D23)")
+	}
+	if v.Required("tipo", vehicle.Tipo) {
+		v.Check(models.VehicleTypes[vehicle.Tipo], "tipo", validation.CodeInvalidValue, "Tipo '"+vehicle.Tipo+"' desconhecido (use moto, carro, van ou caminhao)")
+	}
+	v.Positive("capacidade_peso", vehicle.CapacidadePeso)
+	v.Check(vehicle.CapacidadeVolume >= 0, "capacidade_volume", validation.CodeOutOfRange, "A capacidade de volume não pode ser negativa")
+	if err := v.Err(); err != nil {
+		return fmt.Errorf("%w: %w", ErrVeiculoInvalido, err)
+	}
+
+	// A placa deve ser única na frota
+	existing, err := s.Repository.FindByPlate(vehicle.Placa)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != vehicle.ID {
+		return fmt.Errorf("%w: %s", ErrPlacaDuplicada, vehicle.Placa)
+	}
+	return nil
+}
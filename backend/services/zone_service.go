@@ -0,0 +1,200 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/utils"
+	"meu-projeto/backend/validation"
+)
+
+// Erros retornados pelo ZoneService.
+var (
+	ErrZonaNaoEncontrada = errors.New("zona não encontrada")
+	ErrZonaInvalida      = errors.New("zona inválida")
+	ErrZonaDuplicada     = errors.New("nome de zona já cadastrado")
+)
+
+// ZoneService é uma estrutura que contém métodos para lidar com a lógica de negócio relacionada às zonas de entrega.
+// Sempre que uma zona é criada, alterada ou excluída, as entregas da área afetada são reatribuídas.
+type ZoneService struct {
+	Repository repositories.ZoneStore // Repositório para interagir com o banco de dados
+	Deliveries *DeliveryService       // Serviço de entregas, para a reatribuição de zonas (registrada na auditoria)
+}
+
+// ForTenant retorna uma cópia do serviço em que a listagem das entregas da zona considera apenas as do
+// embarcador informado. As zonas são compartilhadas: o cadastro, a alteração e a exclusão reatribuem as
+// entregas de todos os embarcadores, mesmo no serviço restrito.
+func (s *ZoneService) ForTenant(embarcadorID int) *ZoneService {
+	return &ZoneService{Repository: s.Repository, Deliveries: s.Deliveries.ForTenant(embarcadorID)}
+}
+
+// ForActor retorna uma cópia do serviço que registra a reatribuição das entregas na auditoria em nome do ator
+// informado (o e-mail do usuário ou o prefixo da chave de API).
+func (s *ZoneService) ForActor(actor string) *ZoneService {
+	return &ZoneService{Repository: s.Repository, Deliveries: s.Deliveries.ForActor(actor)}
+}
+
+// Create valida e cadastra uma nova zona, associando a ela as entregas que estão dentro do polígono.
+func (s *ZoneService) Create(zone *models.Zona) error {
+	if err := s.validate(zone); err != nil {
+		return err
+	}
+	if err := s.Repository.Create(zone); err != nil {
+		return err
+	}
+	return s.reassignDeliveries(zone.Poligono)
+}
+
+// List retorna todas as zonas cadastradas.
+func (s *ZoneService) List() ([]models.Zona, error) {
+	return s.Repository.List()
+}
+
+// FindByID busca uma zona pelo ID.
+func (s *ZoneService) FindByID(id int) (*models.Zona, error) {
+	zone, err := s.Repository.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if zone == nil {
+		return nil, ErrZonaNaoEncontrada
+	}
+	return zone, nil
+}
+
+// Update valida e atualiza uma zona existente. As entregas dentro da área antiga ou da nova são reatribuídas.
+func (s *ZoneService) Update(zone *models.Zona) error {
+	// Verifica se a zona existe
+	existing, err := s.FindByID(zone.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.validate(zone); err != nil {
+		return err
+	}
+	if err := s.Repository.Update(zone); err != nil {
+		return err
+	}
+	zone.DataCadastro = existing.DataCadastro
+	return s.reassignDeliveries(existing.Poligono, zone.Poligono)
+}
+
+// Delete remove uma zona. As suas entregas passam para outra zona que as contenha ou ficam fora de zona.
+func (s *ZoneService) Delete(id int) error {
+	// Verifica se a zona existe
+	existing, err := s.FindByID(id)
+	if err != nil {
+		return err
+	}
+	if err := s.Repository.Delete(id); err != nil {
+		return err
+	}
+	return s.reassignDeliveries(existing.Poligono)
+}
+
+// ListDeliveries retorna uma página das entregas associadas à zona.
+func (s *ZoneService) ListDeliveries(id, page, pageSize int) (models.Page[models.Delivery], error) {
+	// Verifica se a zona existe
+	if _, err := s.FindByID(id); err != nil {
+		return models.Page[models.Delivery]{}, err
+	}
+
+	filter := models.DeliveryFilter{ZonaID: id, Page: page, PageSize: pageSize}
+	deliveries, total, err := s.Deliveries.Repository.List(filter)
+	if err != nil {
+		return models.Page[models.Delivery]{}, err
+	}
+	return models.NewPage(deliveries, total, filter.Page, filter.PageSize), nil
+}
+
+// reassignDeliveries recalcula a zona das entregas dentro do retângulo de cada polígono informado,
+// gravando apenas as que mudaram de zona.
+func (s *ZoneService) reassignDeliveries(polygons ...models.GeoJSONPolygon) error {
+	zones, err := s.Repository.List()
+	if err != nil {
+		return err
+	}
+
+	// As zonas são compartilhadas, então as entregas de todos os embarcadores são reatribuídas
+	deliveries := s.Deliveries.ForTenant(0)
+
+	seen := make(map[int]bool)
+	for _, polygon := range polygons {
+		// Busca as candidatas dentro do retângulo que contém o polígono
+		var box models.BoundingBox
+		box.MinLat, box.MaxLat, box.MinLng, box.MaxLng = utils.PolygonBounds(polygon.Coordinates)
+		candidates, err := deliveries.Repository.FindInBoundingBox(box, "")
+		if err != nil {
+			return err
+		}
+
+		for _, delivery := range candidates {
+			if seen[delivery.ID] {
+				continue
+			}
+			seen[delivery.ID] = true
+
+			zonaID := locateZone(zones, delivery.Latitude, delivery.Longitude)
+			if !sameZone(zonaID, delivery.ZonaID) {
+				if err := deliveries.updateZone(delivery, zonaID); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validate normaliza o nome e verifica o polígono da zona, incluindo a unicidade do nome.
+func (s *ZoneService) validate(zone *models.Zona) error {
+	// Verifica os campos, reunindo os erros de todos eles
+	zone.Nome = strings.TrimSpace(zone.Nome)
+	v := validation.New()
+	if v.Required("nome", zone.Nome) {
+		v.MaxLength("nome", zone.Nome, 100)
+	}
+	v.Check(zone.Poligono.Type == models.GeoJSONTypePolygon, "poligono.type", validation.CodeInvalidValue, "O polígono deve ser uma geometria GeoJSON do tipo 'Polygon'")
+	rings, err := utils.ValidatePolygon(zone.Poligono.Coordinates)
+	if err != nil {
+		v.Add("poligono.coordinates", validation.CodeInvalidFormat, err.Error())
+	}
+	if err := v.Err(); err != nil {
+		return fmt.Errorf("%w: %w", ErrZonaInvalida, err)
+	}
+	zone.Poligono.Coordinates = rings
+
+	// O nome deve ser único
+	existing, err := s.Repository.FindByName(zone.Nome)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != zone.ID {
+		return fmt.Errorf("%w: %s", ErrZonaDuplicada, zone.Nome)
+	}
+	return nil
+}
+
+// locateZone retorna o ID da zona que contém o ponto, ou nil se ele estiver fora de todas.
+// Se as zonas se sobrepuserem, vale a cadastrada primeiro (menor ID).
+func locateZone(zones []models.Zona, lat, lng float64) *int {
+	for _, zone := range zones {
+		if utils.PointInPolygon(lat, lng, zone.Poligono.Coordinates) {
+			id := zone.ID
+			return &id
+		}
+	}
+	return nil
+}
+
+// sameZone verifica se dois IDs de zona opcionais são iguais.
+func sameZone(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,100 @@
+package tests
+
+import (
+	"testing"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/utils"
+	"meu-projeto/backend/validation"
+)
+
+// TestNormalizeAddressFields testa a padronização de estados, cidades e nomes de ruas.
+func TestNormalizeAddressFields(t *testing.T) {
+	states := map[string]string{"SP": "SP", "sp": "SP", "São Paulo": "SP", "sao paulo": "SP", " Distrito Federal ": "DF", "PARÁ": "PA"}
+	for value, expected := range states {
+		if uf, ok := utils.NormalizeUF(value); !ok || uf != expected {
+			t.Errorf("NormalizeUF(%q) = %q, %v; esperava %q", value, uf, ok, expected)
+		}
+	}
+	if _, ok := utils.NormalizeUF("XX"); ok {
+		t.Errorf("Esperava que NormalizeUF rejeitasse um estado desconhecido")
+	}
+
+	cities := []struct{ cidade, uf, expected string }{
+		{"sao paulo", "SP", "São Paulo"},
+		{"  RIO   DE JANEIRO ", "RJ", "Rio de Janeiro"},
+		{"santa barbara d'oeste", "", "Santa Bárbara d'Oeste"},
+		{"ji-parana", "RO", "Ji-Paraná"},
+		{"vila nova", "SP", "Vila Nova"}, // Fora da lista: apenas a caixa é ajustada
+	}
+	for _, test := range cities {
+		if cidade := utils.NormalizeCity(test.cidade, test.uf); cidade != test.expected {
+			t.Errorf("NormalizeCity(%q, %q) = %q; esperava %q", test.cidade, test.uf, cidade, test.expected)
+		}
+	}
+
+	names := map[string]string{
+		"  rua   das flores ":       "Rua das Flores",
+		"AVENIDA DOM PEDRO II":      "Avenida Dom Pedro II",
+		"rua xv de novembro":        "Rua XV de Novembro",
+		"Rua Barão de Itapetininga": "Rua Barão de Itapetininga",
+		"Av. BNDES":                 "Av. BNDES", // Caixa mista é mantida
+	}
+	for value, expected := range names {
+		if name := utils.NormalizeName(value); name != expected {
+			t.Errorf("NormalizeName(%q) = %q; esperava %q", value, name, expected)
+		}
+	}
+}
+
+// TestCreateDeliveryNormalization testa a padronização do endereço no cadastro e na atualização de entregas.
+func TestCreateDeliveryNormalization(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		deliveryService, _, _ := newServices(stores)
+		cliente := models.Cliente{Nome: "João Silva", CPF: "529.982.247-25"}
+
+		delivery := models.Delivery{Peso: 1, Endereco: " Rua Augusta,  100 ", Logradouro: "RUA AUGUSTA", Cidade: "sao paulo", Estado: "São Paulo", Pais: "brasil", Latitude: -23.55, Longitude: -46.65}
+		id, err := deliveryService.Create(delivery, cliente)
+		if err != nil {
+			t.Fatalf("Create retornou erro: %v", err)
+		}
+		created, _ := deliveryService.FindByID(int(id))
+		if created.Endereco != "Rua Augusta, 100" || created.Logradouro != "Rua Augusta" || created.Cidade != "São Paulo" || created.Estado != "SP" || created.Pais != "Brasil" {
+			t.Errorf("Endereço não padronizado: %+v", created)
+		}
+
+		// A busca por cidade encontra a entrega com o nome escrito de outra forma
+		deliveries, err := deliveryService.FindByCity("SAO PAULO")
+		if err != nil || len(deliveries) != 1 {
+			t.Errorf("Esperava 1 entrega em São Paulo, mas recebeu %d (erro: %v)", len(deliveries), err)
+		}
+
+		// A atualização também padroniza o endereço
+		created.Cidade, created.Estado = "campinas", "sp"
+		if err := deliveryService.Update(int(id), *created); err != nil {
+			t.Fatalf("Update retornou erro: %v", err)
+		}
+		if updated, _ := deliveryService.FindByID(int(id)); updated.Cidade != "Campinas" || updated.Estado != "SP" {
+			t.Errorf("Esperava Campinas/SP, mas recebeu %s/%s", updated.Cidade, updated.Estado)
+		}
+
+		// Casos de erro: estado desconhecido e coordenadas fora do Brasil
+		invalid := newDelivery("São Paulo", 1)
+		invalid.Estado = "Califórnia"
+		if _, err := deliveryService.Create(invalid, cliente); !hasFieldError(err, "delivery.estado", validation.CodeInvalidValue) {
+			t.Errorf("Esperava erro de validação no estado, mas recebeu %v", err)
+		}
+		invalid = newDelivery("São Paulo", 1)
+		invalid.Latitude, invalid.Longitude = 40.71, -74.00
+		if _, err := deliveryService.Create(invalid, cliente); !hasFieldError(err, "delivery.latitude", validation.CodeOutOfRange) {
+			t.Errorf("Esperava erro de validação nas coordenadas, mas recebeu %v", err)
+		}
+
+		// Fora do Brasil, o estado e as coordenadas não são validados
+		foreign := models.Delivery{Peso: 1, Endereco: "5th Avenue, 1", Cidade: "New York", Estado: "NY", Pais: "Estados Unidos", Latitude: 40.71, Longitude: -74.00}
+		if _, err := deliveryService.Create(foreign, cliente); err != nil {
+			t.Errorf("Create retornou erro para endereço fora do Brasil: %v", err)
+		}
+	})
+}
@@ -0,0 +1,128 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"meu-projeto/backend/apierror"
+	"meu-projeto/backend/controllers"
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/services"
+)
+
+// failingClientStore simula falhas do banco de dados na listagem de clientes.
+type failingClientStore struct {
+	repositories.ClientStore
+}
+
+// ForTenant retorna o próprio repositório, que falha em todos os embarcadores.
+func (s failingClientStore) ForTenant(int) repositories.ClientStore {
+	return s
+}
+
+// List retorna um erro com detalhes internos do banco de dados, que não devem chegar ao cliente.
+func (failingClientStore) List(filter models.ClientFilter) ([]models.Cliente, int, error) {
+	return nil, 0, errors.New("Error 1146 (42S02): Table 'entregas.Cliente' doesn't exist")
+}
+
+// serveAPI executa a requisição pelo middleware de ID da requisição e decodifica o erro da resposta.
+func serveAPI(t *testing.T, handler http.HandlerFunc, req *http.Request) (*httptest.ResponseRecorder, apierror.Body) {
+	t.Helper()
+	rr := httptest.NewRecorder()
+	apierror.RequestID(handler).ServeHTTP(rr, req)
+
+	var response apierror.Response
+	if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+		t.Fatalf("Resposta de erro não é JSON: %v", err)
+	}
+	return rr, response.Error
+}
+
+// TestErrorEnvelope testa o formato único das respostas de erro: código, status, mensagem traduzida e ID da requisição.
+func TestErrorEnvelope(t *testing.T) {
+	stores := repositories.NewMemoryStores()
+	drivers := &controllers.DriverController{Service: &services.DriverService{Repository: stores.Drivers}}
+	clients := &controllers.ClientController{Service: &services.ClientService{Repository: stores.Clients}}
+
+	t.Run("ID da requisição", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/drivers/abc", nil)
+		req.Header.Set(apierror.RequestIDHeader, "req-123")
+		rr, body := serveAPI(t, drivers.FindByID, req)
+		if rr.Code != http.StatusBadRequest || body.Code != apierror.CodeBadRequest {
+			t.Errorf("Esperava 400/bad_request, mas recebeu %d/%s", rr.Code, body.Code)
+		}
+		if body.RequestID != "req-123" || rr.Header().Get(apierror.RequestIDHeader) != "req-123" {
+			t.Errorf("Esperava o ID da requisição enviado pelo cliente, mas recebeu %q", body.RequestID)
+		}
+
+		// IDs com caracteres não permitidos são substituídos por um ID gerado
+		req = httptest.NewRequest(http.MethodGet, "/drivers/abc", nil)
+		req.Header.Set(apierror.RequestIDHeader, "id\ninválido")
+		rr, body = serveAPI(t, drivers.FindByID, req)
+		if len(body.RequestID) != 32 || body.RequestID != rr.Header().Get(apierror.RequestIDHeader) {
+			t.Errorf("Esperava um ID gerado de 32 caracteres, mas recebeu %q", body.RequestID)
+		}
+	})
+
+	t.Run("Idioma", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/clients/id/99", nil)
+		rr, body := serveAPI(t, clients.FindByID, req)
+		if rr.Code != http.StatusNotFound || body.Code != apierror.CodeNotFound || body.Message != "Cliente não encontrado" {
+			t.Errorf("Esperava 404 em português, mas recebeu %d %+v", rr.Code, body)
+		}
+
+		req = httptest.NewRequest(http.MethodGet, "/clients/id/99", nil)
+		req.Header.Set("Accept-Language", "fr-FR, en-US;q=0.8")
+		if _, body = serveAPI(t, clients.FindByID, req); body.Message != "Client not found" {
+			t.Errorf("Esperava a mensagem em inglês, mas recebeu %q", body.Message)
+		}
+
+		// As mensagens dos campos inválidos também são traduzidas
+		req = httptest.NewRequest(http.MethodPost, "/clients?lang=en", strings.NewReader(`{"cpf":"529.982.247-25"}`))
+		rr, body = serveAPI(t, clients.Create, req)
+		if rr.Code != http.StatusUnprocessableEntity || len(body.Fields) != 1 || body.Fields[0].Message != "The 'nome' field is required" {
+			t.Errorf("Esperava 422 com o campo 'nome' em inglês, mas recebeu %d %+v", rr.Code, body)
+		}
+	})
+
+	t.Run("Conflito com detalhe", func(t *testing.T) {
+		payload := `{"nome":"Ana Souza","cpf":"529.982.247-25","cnh_numero":"12345678901","cnh_categoria":"B"}`
+		if rr, _ := serveAPI(t, drivers.Create, httptest.NewRequest(http.MethodPost, "/drivers", strings.NewReader(payload))); rr.Code != http.StatusCreated {
+			t.Fatalf("Esperava 201 no primeiro cadastro, mas recebeu %d", rr.Code)
+		}
+		rr, body := serveAPI(t, drivers.Create, httptest.NewRequest(http.MethodPost, "/drivers", strings.NewReader(payload)))
+		if rr.Code != http.StatusConflict || body.Code != apierror.CodeConflict || !strings.Contains(body.Detail, "529.982.247-25") {
+			t.Errorf("Esperava 409/conflict com o CPF no detalhe, mas recebeu %d %+v", rr.Code, body)
+		}
+	})
+
+	t.Run("JSON inválido", func(t *testing.T) {
+		rr, body := serveAPI(t, drivers.Create, httptest.NewRequest(http.MethodPost, "/drivers", strings.NewReader("{")))
+		if rr.Code != http.StatusBadRequest || body.Code != apierror.CodeInvalidJSON {
+			t.Errorf("Esperava 400/invalid_json, mas recebeu %d/%s", rr.Code, body.Code)
+		}
+	})
+
+	t.Run("Erro interno sem detalhes do banco", func(t *testing.T) {
+		failing := &controllers.ClientController{Service: &services.ClientService{Repository: failingClientStore{}}}
+		rr, body := serveAPI(t, failing.List, httptest.NewRequest(http.MethodGet, "/clients", nil))
+		if rr.Code != http.StatusInternalServerError || body.Code != apierror.CodeInternal {
+			t.Errorf("Esperava 500/internal_error, mas recebeu %d/%s", rr.Code, body.Code)
+		}
+		if body.Detail != "" || strings.Contains(body.Message, "Table") {
+			t.Errorf("A resposta não deve expor o erro do banco de dados: %+v", body)
+		}
+	})
+
+	t.Run("Método não permitido", func(t *testing.T) {
+		rr, body := serveAPI(t, controllers.MethodNotAllowed, httptest.NewRequest(http.MethodPatch, "/drivers", nil))
+		if rr.Code != http.StatusMethodNotAllowed || body.Code != apierror.CodeMethodNotAllowed {
+			t.Errorf("Esperava 405/method_not_allowed, mas recebeu %d/%s", rr.Code, body.Code)
+		}
+	})
+}
@@ -0,0 +1,135 @@
+package tests
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"meu-projeto/backend/apierror"
+	"meu-projeto/backend/auth"
+	"meu-projeto/backend/controllers"
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/services"
+)
+
+// TestAPIKeyService testa o cadastro, a autenticação, a expiração e a remoção das chaves de API.
+func TestAPIKeyService(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		service := &services.APIKeyService{Keys: stores.APIKeys}
+		admin := models.Usuario{Nome: "Admin", Email: "admin@example.com", Senha: "senha-secreta", Papel: auth.RoleAdmin}
+		if err := (&services.AuthService{Users: stores.Users}).CreateUser(&admin); err != nil {
+			t.Fatalf("Erro ao cadastrar o usuário: %v", err)
+		}
+		principal := &auth.Principal{UserID: admin.ID, Role: auth.RoleAdmin}
+
+		// Permissões ausentes, desconhecidas ou reservadas e expiração no passado são rejeitadas
+		past := time.Now().Add(-time.Hour)
+		invalid := []struct {
+			key   models.ChaveAPI
+			field string
+			code  string
+		}{
+			{models.ChaveAPI{Nome: "Loja"}, "permissoes", "required"},
+			{models.ChaveAPI{Nome: "Loja", Permissoes: []string{"deliveries:fly"}}, "permissoes", "invalid_value"},
+			{models.ChaveAPI{Nome: "Loja", Permissoes: []string{auth.PermUsersManage}}, "permissoes", "invalid_value"},
+			{models.ChaveAPI{Nome: "Loja", Permissoes: []string{auth.PermDeliveriesWrite}, ExpiraEm: &past}, "expira_em", "out_of_range"},
+			{models.ChaveAPI{Permissoes: []string{auth.PermDeliveriesWrite}}, "nome", "required"},
+		}
+		for _, tt := range invalid {
+			if _, err := service.Create(&tt.key, principal); !hasFieldError(err, tt.field, tt.code) {
+				t.Errorf("Esperava o erro %s em %s, mas recebeu %v", tt.code, tt.field, err)
+			}
+		}
+
+		key := models.ChaveAPI{Nome: "Loja virtual", Permissoes: []string{" Deliveries:Write ", auth.PermDeliveriesWrite, auth.PermDeliveriesRead}}
+		created, err := service.Create(&key, principal)
+		if err != nil {
+			t.Fatalf("Erro ao cadastrar a chave: %v", err)
+		}
+		if !strings.HasPrefix(created.Chave, created.Prefixo+"_") || len(created.Permissoes) != 2 || created.UsuarioID == nil || *created.UsuarioID != admin.ID {
+			t.Errorf("Chave cadastrada incorreta: %+v", created)
+		}
+		stored, _ := stores.APIKeys.FindByPrefix(created.Prefixo)
+		if stored == nil || stored.Hash == "" || strings.Contains(stored.Hash, created.Chave[len(created.Prefixo)+1:]) || stored.UltimoUso != nil {
+			t.Fatalf("Esperava apenas o hash da chave guardado, sem uso registrado: %+v", stored)
+		}
+
+		// A chave autentica com as suas permissões e registra o último uso
+		authenticated, err := service.Authenticate(created.Chave)
+		if err != nil || !authenticated.Can(auth.PermDeliveriesWrite) || authenticated.Can(auth.PermClientsRead) || authenticated.UserID != 0 {
+			t.Errorf("Esperava a chave autenticada apenas com as suas permissões, mas recebeu %+v (erro: %v)", authenticated, err)
+		}
+		if stored, _ := stores.APIKeys.FindByPrefix(created.Prefixo); stored.UltimoUso == nil {
+			t.Error("Esperava o último uso registrado")
+		}
+
+		// Segredo alterado, formato inválido e chave expirada são recusados
+		forged := created.Chave[:len(created.Chave)-1] + "x"
+		expired := models.ChaveAPI{Nome: "Antiga", Prefixo: "wg_00000000", Hash: auth.HashAPIKey("wg_00000000_" + strings.Repeat("0", 64)), Permissoes: []string{auth.PermDeliveriesRead}, ExpiraEm: &past}
+		if err := stores.APIKeys.Create(&expired); err != nil {
+			t.Fatalf("Erro ao cadastrar a chave expirada: %v", err)
+		}
+		for _, raw := range []string{forged, "chave-qualquer", "wg_00000000_" + strings.Repeat("0", 64)} {
+			if _, err := service.Authenticate(raw); !errors.Is(err, services.ErrNaoAutenticado) || !errors.Is(err, services.ErrChaveAPIRecusada) {
+				t.Errorf("Chave %q: esperava ErrChaveAPIRecusada, mas recebeu %v", raw, err)
+			}
+		}
+
+		// A chave removida deixa de ser aceita
+		if keys, err := service.List(0); err != nil || len(keys) != 2 {
+			t.Errorf("Esperava 2 chaves, mas recebeu %d (erro: %v)", len(keys), err)
+		}
+		if err := service.Delete(created.ID, 0); err != nil {
+			t.Fatalf("Erro ao remover a chave: %v", err)
+		}
+		if _, err := service.Authenticate(created.Chave); !errors.Is(err, services.ErrChaveAPIRecusada) {
+			t.Errorf("Esperava a chave removida recusada, mas recebeu %v", err)
+		}
+		if err := service.Delete(created.ID, 0); !errors.Is(err, services.ErrChaveAPINaoEncontrada) {
+			t.Errorf("Esperava ErrChaveAPINaoEncontrada, mas recebeu %v", err)
+		}
+	})
+}
+
+// TestRequireAPIKey testa a autenticação pelo cabeçalho X-API-Key no mesmo middleware dos tokens: 401 com uma
+// chave inválida e 403 para as permissões que a chave não tem.
+func TestRequireAPIKey(t *testing.T) {
+	stores := repositories.NewMemoryStores()
+	service := &services.APIKeyService{Keys: stores.APIKeys}
+	controller := &controllers.AuthController{Service: &services.AuthService{Users: stores.Users, Tokens: newTokens(nil)}, APIKeys: service}
+	created, err := service.Create(&models.ChaveAPI{Nome: "Loja virtual", Permissoes: []string{auth.PermDeliveriesWrite}}, nil)
+	if err != nil {
+		t.Fatalf("Erro ao cadastrar a chave: %v", err)
+	}
+
+	handler := func(permission string) http.HandlerFunc {
+		return controller.Require(controller.Allow(permission, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+	}
+	request := func(key string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/deliveries", nil)
+		req.Header.Set("X-API-Key", key)
+		return req
+	}
+
+	rr := httptest.NewRecorder()
+	handler(auth.PermDeliveriesWrite)(rr, request(created.Chave))
+	if rr.Code != http.StatusNoContent {
+		t.Errorf("Esperava a requisição liberada, mas recebeu %d", rr.Code)
+	}
+
+	rr, body := serveAPI(t, handler(auth.PermClientsDelete), request(created.Chave))
+	if rr.Code != http.StatusForbidden || !strings.Contains(body.Message, auth.PermClientsDelete) || !strings.Contains(body.Detail, created.Prefixo) {
+		t.Errorf("Esperava 403 com a permissão e o prefixo da chave, mas recebeu %d %+v", rr.Code, body)
+	}
+
+	rr, body = serveAPI(t, handler(auth.PermDeliveriesWrite), request(created.Chave+"0"))
+	if rr.Code != http.StatusUnauthorized || body.Code != apierror.CodeUnauthorized || body.Message != "Chave de API inválida, expirada ou removida" {
+		t.Errorf("Esperava 401 com a chave inválida, mas recebeu %d %+v", rr.Code, body)
+	}
+}
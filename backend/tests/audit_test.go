@@ -0,0 +1,257 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"meu-projeto/backend/apierror"
+	"meu-projeto/backend/auth"
+	"meu-projeto/backend/controllers"
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/services"
+)
+
+// TestAudit testa o registro de auditoria das operações de escrita nas entregas e nos clientes: o ator, a
+// ação e os valores antes e depois de cada campo alterado.
+func TestAudit(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		audit := &services.AuditService{Repository: stores.Audit}
+		deliveries, _, clients := newServices(stores)
+		deliveries.Audit, clients.Audit = audit, audit
+		deliveryService := deliveries.ForTenant(models.EmbarcadorPadrao).ForActor("ana@example.com")
+		clientService := clients.ForTenant(models.EmbarcadorPadrao).ForActor("wg_1a2b3c4d")
+
+		// list retorna os registros da entidade, do mais recente para o mais antigo
+		list := func(entity string, id int) []models.RegistroAuditoria {
+			t.Helper()
+			page, err := audit.List(models.AuditFilter{EmbarcadorID: models.EmbarcadorPadrao, Entidade: entity, EntidadeID: id, Page: 1, PageSize: 10})
+			if err != nil {
+				t.Fatalf("Erro ao consultar a auditoria: %v", err)
+			}
+			return page.Items
+		}
+
+		// O cadastro da entrega registra o cadastro do cliente e da entrega, sem valores anteriores
+		id, err := deliveryService.Create(newDelivery("São Paulo", 2.5), models.Cliente{Nome: "João Silva", CPF: "529.982.247-25"})
+		if err != nil {
+			t.Fatalf("Erro ao cadastrar a entrega: %v", err)
+		}
+		created := list(models.EntidadeEntrega, int(id))
+		if len(created) != 1 || created[0].Acao != models.AcaoCriacao || created[0].Ator != "ana@example.com" || created[0].EmbarcadorID != models.EmbarcadorPadrao {
+			t.Fatalf("Esperava o cadastro da entrega registrado, mas recebeu %+v", created)
+		}
+		if change := created[0].Alteracoes["cidade"]; change.Antes != nil || change.Depois != "São Paulo" {
+			t.Errorf("Esperava a cidade cadastrada na auditoria, mas recebeu %+v", change)
+		}
+		delivery, _ := deliveryService.FindByID(int(id))
+		if entries := list(models.EntidadeCliente, delivery.ClienteID); len(entries) != 1 || entries[0].Alteracoes["cpf"].Depois != "529.982.247-25" {
+			t.Errorf("Esperava o cadastro do cliente registrado, mas recebeu %+v", entries)
+		}
+
+		// A alteração registra apenas os campos alterados, com os valores antes e depois
+		changed := newDelivery("São Paulo", 2.5)
+		changed.ClienteID, changed.Endereco = delivery.ClienteID, "Avenida Paulista, 1000"
+		if err := deliveryService.Update(int(id), changed); err != nil {
+			t.Fatalf("Erro ao atualizar a entrega: %v", err)
+		}
+		if _, err := deliveryService.UpdateStatus(int(id), models.TrackingEvent{Status: models.StatusColetada}); err != nil {
+			t.Fatalf("Erro ao alterar o status: %v", err)
+		}
+		entries := list(models.EntidadeEntrega, int(id))
+		if len(entries) != 3 {
+			t.Fatalf("Esperava 3 registros da entrega, mas recebeu %d", len(entries))
+		}
+		status, update := entries[0], entries[1]
+		if change, ok := update.Alteracoes["endereco"]; update.Acao != models.AcaoAtualizacao || !ok || change.Antes != "Rua das Flores, 123" || change.Depois != "Avenida Paulista, 1000" {
+			t.Errorf("Esperava a alteração do endereço registrada, mas recebeu %+v", update)
+		}
+		if _, ok := update.Alteracoes["cidade"]; ok {
+			t.Errorf("Esperava apenas os campos alterados, mas recebeu %+v", update.Alteracoes)
+		}
+		if change := status.Alteracoes["status"]; len(status.Alteracoes) != 1 || change.Antes != models.StatusPendente || change.Depois != models.StatusColetada {
+			t.Errorf("Esperava a mudança de status registrada, mas recebeu %+v", status.Alteracoes)
+		}
+
+		// A remoção registra os valores anteriores, e os registros continuam disponíveis depois dela
+		if err := deliveryService.Delete(int(id)); err != nil {
+			t.Fatalf("Erro ao remover a entrega: %v", err)
+		}
+		if entries := list(models.EntidadeEntrega, int(id)); len(entries) != 4 || entries[0].Acao != models.AcaoRemocao || entries[0].Alteracoes["endereco"].Antes != "Avenida Paulista, 1000" || entries[0].Alteracoes["endereco"].Depois != nil {
+			t.Errorf("Esperava a remoção da entrega registrada, mas recebeu %+v", entries)
+		}
+
+		// As operações nos clientes são registradas em nome da chave de API
+		client := models.Cliente{ID: delivery.ClienteID, Nome: "João Souza", CPF: "529.982.247-25"}
+		if err := clientService.Update(&client); err != nil {
+			t.Fatalf("Erro ao atualizar o cliente: %v", err)
+		}
+		if err := clientService.Delete(client.ID); err != nil {
+			t.Fatalf("Erro ao remover o cliente: %v", err)
+		}
+		entries = list(models.EntidadeCliente, client.ID)
+		if len(entries) != 3 || entries[0].Acao != models.AcaoRemocao || entries[1].Ator != "wg_1a2b3c4d" || entries[1].Alteracoes["nome"].Antes != "João Silva" || entries[1].Alteracoes["nome"].Depois != "João Souza" {
+			t.Errorf("Esperava a alteração e a remoção do cliente registradas, mas recebeu %+v", entries)
+		}
+
+		// Uma operação que falha não é registrada
+		if err := clientService.Delete(client.ID); err == nil {
+			t.Error("Esperava erro ao remover o cliente já removido")
+		}
+		if entries := list(models.EntidadeCliente, client.ID); len(entries) != 3 {
+			t.Errorf("Esperava 3 registros do cliente, mas recebeu %d", len(entries))
+		}
+
+		// Filtros por período e por embarcador
+		future := time.Now().Add(time.Hour)
+		if page, err := audit.List(models.AuditFilter{EmbarcadorID: models.EmbarcadorPadrao, De: &future, Page: 1, PageSize: 10}); err != nil || page.Total != 0 {
+			t.Errorf("Esperava nenhum registro a partir de daqui a uma hora, mas recebeu %+v (erro: %v)", page, err)
+		}
+		past := time.Now().Add(-time.Hour)
+		if page, err := audit.List(models.AuditFilter{EmbarcadorID: models.EmbarcadorPadrao, De: &past, Ate: &future, Page: 1, PageSize: 10}); err != nil || page.Total != 7 {
+			t.Errorf("Esperava os 7 registros no período, mas recebeu %d (erro: %v)", page.Total, err)
+		}
+		if page, err := audit.List(models.AuditFilter{EmbarcadorID: 2, Page: 1, PageSize: 10}); err != nil || page.Total != 0 {
+			t.Errorf("Esperava nenhum registro de outro embarcador, mas recebeu %+v (erro: %v)", page, err)
+		}
+	})
+}
+
+// TestAuditIndirectChanges testa o registro das alterações feitas nas entregas por outras operações: a
+// atribuição de motorista, a reatribuição de zona e as remoções em cascata do motorista e do cliente.
+func TestAuditIndirectChanges(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		audit := &services.AuditService{Repository: stores.Audit}
+		deliveries, _, clients := newServices(stores)
+		deliveries.Audit, clients.Audit, clients.Deliveries = audit, audit, stores.Deliveries
+		drivers := (&services.DriverService{Repository: stores.Drivers, Deliveries: deliveries}).ForActor("ana@example.com")
+		zones := (&services.ZoneService{Repository: stores.Zones, Deliveries: deliveries}).ForActor("ana@example.com")
+
+		// latest retorna o registro mais recente da entrega
+		latest := func(id int) models.RegistroAuditoria {
+			t.Helper()
+			page, err := audit.List(models.AuditFilter{Entidade: models.EntidadeEntrega, EntidadeID: id, Page: 1, PageSize: 1})
+			if err != nil || len(page.Items) == 0 {
+				t.Fatalf("Esperava registros da entrega %d, mas recebeu %+v (erro: %v)", id, page, err)
+			}
+			return page.Items[0]
+		}
+
+		id := deliveryAt(t, deliveries, -23.55, -46.65)
+		driver := newDriver("Maria Souza", "123.456.789-09", "12345678900")
+		if err := drivers.Create(&driver); err != nil {
+			t.Fatalf("Erro ao cadastrar o motorista: %v", err)
+		}
+
+		// A atribuição registra o novo motorista em nome de quem a fez
+		if _, err := drivers.Assign(driver.ID, id); err != nil {
+			t.Fatalf("Erro ao atribuir a entrega: %v", err)
+		}
+		if entry := latest(id); entry.Ator != "ana@example.com" || entry.Alteracoes["motorista_id"].Antes != nil || entry.Alteracoes["motorista_id"].Depois != float64(driver.ID) {
+			t.Errorf("Esperava a atribuição registrada, mas recebeu %+v", entry)
+		}
+
+		// A zona cadastrada sobre a entrega registra a reatribuição
+		centro := models.Zona{Nome: "Centro", Poligono: models.GeoJSONPolygon{Type: "Polygon", Coordinates: [][][]float64{square(-23.6, -46.7, 0.1)}}}
+		if err := zones.Create(&centro); err != nil {
+			t.Fatalf("Erro ao cadastrar a zona: %v", err)
+		}
+		if entry := latest(id); entry.Alteracoes["zona_id"].Depois != float64(centro.ID) || entry.Alteracoes["fora_de_zona"].Depois != false {
+			t.Errorf("Esperava a reatribuição de zona registrada, mas recebeu %+v", entry)
+		}
+
+		// A remoção do motorista registra a entrega sem motorista
+		if err := drivers.Delete(driver.ID); err != nil {
+			t.Fatalf("Erro ao remover o motorista: %v", err)
+		}
+		if entry := latest(id); entry.Alteracoes["motorista_id"].Antes != float64(driver.ID) || entry.Alteracoes["motorista_id"].Depois != nil {
+			t.Errorf("Esperava a remoção do motorista registrada, mas recebeu %+v", entry)
+		}
+
+		// A remoção do cliente registra a remoção das suas entregas
+		delivery, _ := deliveries.FindByID(id)
+		if err := clients.Delete(delivery.ClienteID); err != nil {
+			t.Fatalf("Erro ao remover o cliente: %v", err)
+		}
+		if entry := latest(id); entry.Acao != models.AcaoRemocao || entry.Alteracoes["endereco"].Antes != "Rua das Flores, 123" {
+			t.Errorf("Esperava a remoção da entrega registrada, mas recebeu %+v", entry)
+		}
+	})
+}
+
+// failingAuditStore simula um banco de dados indisponível na gravação da auditoria.
+type failingAuditStore struct {
+	repositories.AuditStore
+}
+
+func (failingAuditStore) Create(*models.RegistroAuditoria) error {
+	return errors.New("conexão recusada")
+}
+
+// TestAuditFailure testa que uma falha ao gravar a auditoria não faz falhar a operação, que já foi
+// confirmada no banco.
+func TestAuditFailure(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		audit := &services.AuditService{Repository: failingAuditStore{stores.Audit}}
+		deliveries, _, clients := newServices(stores)
+		deliveries.Audit, clients.Audit = audit, audit
+
+		id, err := deliveries.Create(newDelivery("São Paulo", 2.5), models.Cliente{Nome: "João Silva", CPF: "529.982.247-25"})
+		if err != nil {
+			t.Fatalf("Esperava a entrega cadastrada mesmo sem auditoria, mas recebeu %v", err)
+		}
+		if _, err := deliveries.UpdateStatus(int(id), models.TrackingEvent{Status: models.StatusColetada}); err != nil {
+			t.Errorf("Esperava o status alterado mesmo sem auditoria, mas recebeu %v", err)
+		}
+		client := models.Cliente{Nome: "Maria Souza", CPF: "123.456.789-09"}
+		if err := clients.Create(&client); err != nil {
+			t.Errorf("Esperava o cliente cadastrado mesmo sem auditoria, mas recebeu %v", err)
+		}
+		if err := deliveries.Delete(int(id)); err != nil {
+			t.Errorf("Esperava a entrega removida mesmo sem auditoria, mas recebeu %v", err)
+		}
+		if found, _ := deliveries.FindByID(int(id)); found != nil {
+			t.Errorf("Esperava a entrega removida, mas recebeu %+v", found)
+		}
+	})
+}
+
+// TestAuditController testa os parâmetros da consulta da auditoria e o registro do usuário autenticado como ator.
+func TestAuditController(t *testing.T) {
+	stores := repositories.NewMemoryStores()
+	audit := &services.AuditService{Repository: stores.Audit}
+	clients := &controllers.ClientController{Service: &services.ClientService{Repository: stores.Clients, Audit: audit}}
+	controller := &controllers.AuditController{Service: audit}
+	principal := auth.Principal{UserID: 1, Email: "admin@example.com", Role: auth.RoleAdmin, EmbarcadorID: models.EmbarcadorPadrao}
+
+	req := httptest.NewRequest(http.MethodPost, "/clients", strings.NewReader(`{"nome": "João Silva", "cpf": "529.982.247-25"}`))
+	rr := httptest.NewRecorder()
+	clients.Create(rr, withPrincipal(req, principal))
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("Erro ao cadastrar o cliente: %d %s", rr.Code, rr.Body.String())
+	}
+
+	today := time.Now().UTC().Format("2006-01-02")
+	rr = httptest.NewRecorder()
+	controller.List(rr, withPrincipal(httptest.NewRequest(http.MethodGet, "/audit?entity=client&id=1&from="+today+"&to="+today, nil), principal))
+	var page models.Page[models.RegistroAuditoria]
+	if err := json.NewDecoder(rr.Body).Decode(&page); err != nil {
+		t.Fatalf("Erro ao decodificar a resposta: %v", err)
+	}
+	if rr.Code != http.StatusOK || page.Total != 1 || page.Items[0].Ator != "admin@example.com" {
+		t.Errorf("Esperava o cadastro do cliente em nome do usuário, mas recebeu %d %+v", rr.Code, page)
+	}
+
+	invalid := []string{"/audit?entity=veiculo", "/audit?id=1", "/audit?id=abc&entity=client", "/audit?from=15/03/2025", "/audit?from=2025-03-16&to=2025-03-15"}
+	for _, target := range invalid {
+		rr, body := serveAPI(t, controller.List, withPrincipal(httptest.NewRequest(http.MethodGet, target, nil), principal))
+		if rr.Code != http.StatusBadRequest || body.Code != apierror.CodeBadRequest {
+			t.Errorf("%s: esperava 400, mas recebeu %d %+v", target, rr.Code, body)
+		}
+	}
+}
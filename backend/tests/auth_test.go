@@ -0,0 +1,207 @@
+package tests
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"meu-projeto/backend/apierror"
+	"meu-projeto/backend/auth"
+	"meu-projeto/backend/controllers"
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/services"
+)
+
+// newTokens cria um emissor de tokens com uma chave fixa e o relógio informado.
+func newTokens(now func() time.Time) *auth.Tokens {
+	return &auth.Tokens{Secret: []byte(strings.Repeat("s", 32)), AccessTTL: 15 * time.Minute, RefreshTTL: 24 * time.Hour, Now: now}
+}
+
+// TestTokens testa a emissão e a validação dos tokens JWT: assinatura, tipo e expiração.
+func TestTokens(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	tokens := newTokens(func() time.Time { return now })
+
+	access, ttl, err := tokens.Issue(auth.Principal{UserID: 7, Email: "ana@example.com", Role: auth.RoleDriver, MotoristaID: 3, EmbarcadorID: 2}, auth.TokenAccess)
+	if err != nil || ttl != 15*time.Minute {
+		t.Fatalf("Erro ao emitir o token: %v (validade %v)", err, ttl)
+	}
+	claims, err := tokens.Parse(access, auth.TokenAccess)
+	if err != nil {
+		t.Fatalf("Token válido rejeitado: %v", err)
+	}
+	if principal, _ := claims.Principal(); principal == nil || !reflect.DeepEqual(*principal, auth.Principal{UserID: 7, Email: "ana@example.com", Role: auth.RoleDriver, MotoristaID: 3, EmbarcadorID: 2}) {
+		t.Errorf("Dados do token incorretos: %+v", claims)
+	}
+
+	// Um token sem embarcador (emitido antes dos embarcadores) não identifica o usuário
+	legacy, _, _ := tokens.Issue(auth.Principal{UserID: 7, Email: "ana@example.com", Role: auth.RoleAdmin}, auth.TokenAccess)
+	if claims, err := tokens.Parse(legacy, auth.TokenAccess); err != nil {
+		t.Errorf("Token sem embarcador rejeitado na validação da assinatura: %v", err)
+	} else if _, err := claims.Principal(); !errors.Is(err, auth.ErrTokenInvalido) {
+		t.Errorf("Esperava ErrTokenInvalido para o token sem embarcador, mas recebeu %v", err)
+	}
+
+	// Um token de acesso não serve como token de renovação
+	if _, err := tokens.Parse(access, auth.TokenRefresh); !errors.Is(err, auth.ErrTokenInvalido) {
+		t.Errorf("Esperava ErrTokenInvalido para o tipo errado, mas recebeu %v", err)
+	}
+
+	// Payload alterado, outra chave e algoritmo "none" invalidam a assinatura
+	parts := strings.Split(access, ".")
+	forged := []string{
+		parts[0] + "." + parts[1] + "x." + parts[2],
+		"eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0." + parts[1] + ".",
+	}
+	other, _, _ := (&auth.Tokens{Secret: []byte(strings.Repeat("x", 32)), AccessTTL: time.Minute}).Issue(auth.Principal{UserID: 7, Email: "ana@example.com", Role: auth.RoleAdmin}, auth.TokenAccess)
+	forged = append(forged, other, "", "abc")
+	for _, token := range forged {
+		if _, err := tokens.Parse(token, auth.TokenAccess); !errors.Is(err, auth.ErrTokenInvalido) {
+			t.Errorf("Esperava ErrTokenInvalido para %q, mas recebeu %v", token, err)
+		}
+	}
+
+	// Depois da validade, o token expira
+	now = now.Add(15 * time.Minute)
+	if _, err := tokens.Parse(access, auth.TokenAccess); !errors.Is(err, auth.ErrTokenExpirado) {
+		t.Errorf("Esperava ErrTokenExpirado, mas recebeu %v", err)
+	}
+}
+
+// TestAuthService testa o cadastro de usuários, o login e a renovação dos tokens.
+func TestAuthService(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		service := &services.AuthService{Users: stores.Users, Tokens: newTokens(nil)}
+
+		user := models.Usuario{Nome: "Ana Souza", Email: " Ana@Example.com ", Senha: "senha-secreta"}
+		if err := service.CreateUser(&user); err != nil {
+			t.Fatalf("Erro ao cadastrar o usuário: %v", err)
+		}
+		if user.ID == 0 || user.Email != "ana@example.com" || user.Senha != "" || !strings.HasPrefix(user.SenhaHash, "$2") {
+			t.Errorf("Esperava o usuário cadastrado com o e-mail normalizado e apenas o hash da senha: %+v", user)
+		}
+
+		// E-mail repetido (sem diferenciar maiúsculas) e senha curta
+		if err := service.CreateUser(&models.Usuario{Nome: "Outra Ana", Email: "ANA@example.com", Senha: "senha-secreta"}); !errors.Is(err, services.ErrUsuarioDuplicado) {
+			t.Errorf("Esperava ErrUsuarioDuplicado, mas recebeu %v", err)
+		}
+		if err := service.CreateUser(&models.Usuario{Nome: "Bia", Email: "bia@example.com", Senha: "curta"}); !hasFieldError(err, "senha", "out_of_range") {
+			t.Errorf("Esperava erro de validação na senha, mas recebeu %v", err)
+		}
+
+		// Senha incorreta e e-mail inexistente recebem o mesmo erro
+		for _, credentials := range [][2]string{{"ana@example.com", "senha-errada"}, {"ninguem@example.com", "senha-secreta"}} {
+			if _, err := service.Login(credentials[0], credentials[1]); !errors.Is(err, services.ErrCredenciaisInvalidas) {
+				t.Errorf("Login %v: esperava ErrCredenciaisInvalidas, mas recebeu %v", credentials, err)
+			}
+		}
+
+		tokens, err := service.Login("ANA@example.com", "senha-secreta")
+		if err != nil {
+			t.Fatalf("Erro no login: %v", err)
+		}
+		principal, err := service.Authenticate(tokens.AccessToken)
+		if err != nil || principal.UserID != user.ID {
+			t.Errorf("Esperava o usuário %d autenticado, mas recebeu %+v (erro: %v)", user.ID, principal, err)
+		}
+
+		// O token de renovação gera um novo par, mas não autentica requisições
+		if _, err := service.Authenticate(tokens.RefreshToken); !errors.Is(err, services.ErrNaoAutenticado) {
+			t.Errorf("Esperava ErrNaoAutenticado com o token de renovação, mas recebeu %v", err)
+		}
+		renewed, err := service.Refresh(tokens.RefreshToken)
+		if err != nil || renewed.AccessToken == "" || renewed.AccessToken == tokens.AccessToken {
+			t.Errorf("Esperava um novo par de tokens, mas recebeu %+v (erro: %v)", renewed, err)
+		}
+		if _, err := service.Refresh(tokens.AccessToken); !errors.Is(err, services.ErrNaoAutenticado) {
+			t.Errorf("Esperava ErrNaoAutenticado ao renovar com o token de acesso, mas recebeu %v", err)
+		}
+	})
+}
+
+// TestRequireAuth testa o middleware de autenticação: 401 sem token ou com token inválido, e o usuário
+// autenticado no contexto com um token válido.
+func TestRequireAuth(t *testing.T) {
+	stores := repositories.NewMemoryStores()
+	service := &services.AuthService{Users: stores.Users, Tokens: newTokens(nil)}
+	if _, err := service.EnsureAdmin("admin@example.com", "senha-secreta"); err != nil {
+		t.Fatalf("Erro ao cadastrar o usuário inicial: %v", err)
+	}
+	controller := &controllers.AuthController{Service: service}
+
+	var principal *auth.Principal
+	handler := controller.Require(func(w http.ResponseWriter, r *http.Request) {
+		principal = auth.FromContext(r.Context())
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	for _, header := range []string{"", "Basic YWRtaW46c2VuaGE=", "Bearer token-invalido"} {
+		req := httptest.NewRequest(http.MethodGet, "/deliveries", nil)
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		rr, body := serveAPI(t, handler, req)
+		if rr.Code != http.StatusUnauthorized || body.Code != apierror.CodeUnauthorized || rr.Header().Get("WWW-Authenticate") == "" {
+			t.Errorf("Authorization %q: esperava 401/unauthorized, mas recebeu %d %+v", header, rr.Code, body)
+		}
+	}
+
+	tokens, err := service.Login("admin@example.com", "senha-secreta")
+	if err != nil {
+		t.Fatalf("Erro no login: %v", err)
+	}
+	req := httptest.NewRequest(http.MethodGet, "/deliveries", nil)
+	req.Header.Set("Authorization", "Bearer "+tokens.AccessToken)
+	rr := httptest.NewRecorder()
+	handler(rr, req)
+	if rr.Code != http.StatusNoContent || principal == nil || principal.Email != "admin@example.com" {
+		t.Errorf("Esperava a requisição autenticada, mas recebeu %d (usuário %+v)", rr.Code, principal)
+	}
+}
+
+// editedUserStore altera os usuários lidos do repositório, simulando mudanças feitas depois da emissão do token.
+type editedUserStore struct {
+	repositories.UserStore
+	edit func(user *models.Usuario)
+}
+
+func (s editedUserStore) FindByID(id int) (*models.Usuario, error) {
+	user, err := s.UserStore.FindByID(id)
+	if user != nil && s.edit != nil {
+		s.edit(user)
+	}
+	return user, err
+}
+
+// TestAuthenticateCurrentUser testa que o token de acesso reflete o papel atual do usuário e deixa de valer
+// quando ele é desativado, sem esperar o token expirar.
+func TestAuthenticateCurrentUser(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		users := &editedUserStore{UserStore: stores.Users}
+		service := &services.AuthService{Users: users, Tokens: newTokens(nil)}
+		if err := service.CreateUser(&models.Usuario{Nome: "Ana Souza", Email: "ana@example.com", Senha: "senha-secreta", Papel: auth.RoleAdmin}); err != nil {
+			t.Fatalf("Erro ao cadastrar o usuário: %v", err)
+		}
+		tokens, err := service.Login("ana@example.com", "senha-secreta")
+		if err != nil {
+			t.Fatalf("Erro no login: %v", err)
+		}
+
+		// Papel rebaixado depois do login
+		users.edit = func(user *models.Usuario) { user.Papel = auth.RoleViewer }
+		if principal, err := service.Authenticate(tokens.AccessToken); err != nil || principal.Role != auth.RoleViewer {
+			t.Errorf("Esperava o papel viewer, mas recebeu %+v (erro: %v)", principal, err)
+		}
+
+		// Usuário desativado depois do login
+		users.edit = func(user *models.Usuario) { user.Ativo = false }
+		if _, err := service.Authenticate(tokens.AccessToken); !errors.Is(err, services.ErrNaoAutenticado) {
+			t.Errorf("Esperava ErrNaoAutenticado para o usuário desativado, mas recebeu %v", err)
+		}
+	})
+}
@@ -0,0 +1,140 @@
+package tests
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"meu-projeto/backend/geocoding"
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/services"
+	"meu-projeto/backend/utils"
+	"meu-projeto/backend/validation"
+)
+
+// TestValidateCEP testa a validação e a formatação de CEPs.
+func TestValidateCEP(t *testing.T) {
+	tests := []struct {
+		cep       string
+		valid     bool
+		formatted string
+	}{
+		{"01310-100", true, "01310-100"},
+		{"01310100", true, "01310-100"},
+		{"01.310-100", true, "01310-100"},
+		{"0131-0100", false, "0131-0100"},
+		{"1310100", false, "1310100"},
+		{"00000-000", false, "00000-000"},
+		{"abcde-fgh", false, "abcde-fgh"},
+	}
+	for _, test := range tests {
+		if valid := utils.ValidateCEP(test.cep); valid != test.valid {
+			t.Errorf("ValidateCEP(%q) = %v; esperava %v", test.cep, valid, test.valid)
+		}
+		if formatted := utils.FormatCEP(test.cep); formatted != test.formatted {
+			t.Errorf("FormatCEP(%q) = %q; esperava %q", test.cep, formatted, test.formatted)
+		}
+	}
+}
+
+// TestCEPLookup testa a consulta de CEP na tabela embutida e no adaptador HTTP, que imita o ViaCEP.
+func TestCEPLookup(t *testing.T) {
+	service := &services.CEPService{Provider: geocoding.DefaultCEPTable()}
+
+	address, err := service.Lookup("01310100")
+	if err != nil {
+		t.Fatalf("Lookup retornou erro: %v", err)
+	}
+	expected := models.CEPAddress{CEP: "01310-100", Logradouro: "Avenida Paulista", Bairro: "Bela Vista", Cidade: "São Paulo", Estado: "SP", Pais: "Brasil"}
+	if *address != expected {
+		t.Errorf("Lookup = %+v; esperava %+v", *address, expected)
+	}
+
+	// Casos de erro: CEP inválido e CEP fora da tabela
+	if _, err := service.Lookup("123"); !errors.Is(err, services.ErrCEPInvalido) {
+		t.Errorf("Esperava ErrCEPInvalido, mas recebeu %v", err)
+	}
+	if _, err := service.Lookup("99999-999"); !errors.Is(err, services.ErrCEPNaoEncontrado) {
+		t.Errorf("Esperava ErrCEPNaoEncontrado, mas recebeu %v", err)
+	}
+	if _, err := geocoding.NewCEPTable(strings.NewReader("cep,logradouro,bairro,cidade,estado\n123,Rua,,Cidade,SP\n")); err == nil {
+		t.Errorf("Esperava erro para tabela com CEP inválido")
+	}
+
+	// Adaptador HTTP: o ViaCEP responde {"erro": true} (ou "true") para CEPs inexistentes
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ws/30130010/json/":
+			w.Write([]byte(`{"cep":"30130-010","logradouro":"Praça Sete de Setembro","bairro":"Centro","localidade":"Belo Horizonte","uf":"MG"}`))
+		case "/ws/99999999/json/":
+			w.Write([]byte(`{"erro":"true"}`))
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+	service.Provider = geocoding.CEPChain{&geocoding.HTTPCEPProvider{BaseURL: server.URL}, geocoding.DefaultCEPTable()}
+
+	address, err = service.Lookup("30130-010")
+	if err != nil || address.Cidade != "Belo Horizonte" || address.Estado != "MG" {
+		t.Errorf("Esperava o CEP de Belo Horizonte, mas recebeu %+v (erro: %v)", address, err)
+	}
+	if _, err := service.Lookup("99999-999"); !errors.Is(err, services.ErrCEPNaoEncontrado) {
+		t.Errorf("Esperava ErrCEPNaoEncontrado, mas recebeu %v", err)
+	}
+
+	// Com o serviço indisponível, a tabela local é usada; se ela também não conhece o CEP, o erro do serviço é retornado
+	if address, err := service.Lookup("20040-002"); err != nil || address.Bairro != "Centro" {
+		t.Errorf("Esperava o CEP da tabela local, mas recebeu %+v (erro: %v)", address, err)
+	}
+	if _, err := service.Lookup("88888-888"); !errors.Is(err, services.ErrConsultaCEP) {
+		t.Errorf("Esperava ErrConsultaCEP, mas recebeu %v", err)
+	}
+}
+
+// TestCreateDeliveryCEP testa o preenchimento do endereço no cadastro de entregas informadas apenas com CEP e número.
+func TestCreateDeliveryCEP(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		deliveryService, _, _ := newServices(stores)
+		deliveryService.CEPs = &services.CEPService{Provider: geocoding.DefaultCEPTable()}
+		deliveryService.Geocoder = geocoding.DefaultGazetteer()
+		cliente := models.Cliente{Nome: "João Silva", CPF: "529.982.247-25"}
+
+		// Apenas CEP e número: o endereço e as coordenadas são preenchidos
+		id, err := deliveryService.Create(models.Delivery{Peso: 1, CEP: "01310100", Numero: "1000"}, cliente)
+		if err != nil {
+			t.Fatalf("Create retornou erro: %v", err)
+		}
+		created, _ := deliveryService.FindByID(int(id))
+		if created.CEP != "01310-100" || created.Endereco != "Avenida Paulista, 1000" || created.Bairro != "Bela Vista" || created.Cidade != "São Paulo" || created.Estado != "SP" || created.Pais != "Brasil" {
+			t.Errorf("Endereço preenchido incorretamente: %+v", created)
+		}
+		if created.Latitude != -23.5632 || created.Longitude != -46.6542 {
+			t.Errorf("Esperava as coordenadas da Avenida Paulista, mas recebeu (%v, %v)", created.Latitude, created.Longitude)
+		}
+
+		// Campos informados não são sobrescritos
+		delivery := models.Delivery{Peso: 1, CEP: "01310-100", Numero: "10", Bairro: "Cerqueira César", Latitude: -23.56, Longitude: -46.65}
+		id, err = deliveryService.Create(delivery, cliente)
+		if err != nil {
+			t.Fatalf("Create retornou erro: %v", err)
+		}
+		if created, _ := deliveryService.FindByID(int(id)); created.Bairro != "Cerqueira César" || created.Logradouro != "Avenida Paulista" {
+			t.Errorf("Esperava o bairro informado e o logradouro do CEP, mas recebeu %+v", created)
+		}
+
+		// Casos de erro: CEP inválido (também na atualização) e CEP desconhecido
+		if _, err := deliveryService.Create(models.Delivery{Peso: 1, CEP: "123", Numero: "1"}, cliente); !hasFieldError(err, "delivery.cep", validation.CodeInvalidFormat) {
+			t.Errorf("Esperava erro de validação no CEP, mas recebeu %v", err)
+		}
+		if err := deliveryService.Update(int(id), models.Delivery{CEP: "abc"}); !hasFieldError(err, "cep", validation.CodeInvalidFormat) {
+			t.Errorf("Esperava erro de validação no CEP, mas recebeu %v", err)
+		}
+		if _, err := deliveryService.Create(models.Delivery{Peso: 1, CEP: "99999-999", Numero: "1"}, cliente); !errors.Is(err, services.ErrCEPNaoEncontrado) {
+			t.Errorf("Esperava ErrCEPNaoEncontrado, mas recebeu %v", err)
+		}
+	})
+}
@@ -3,36 +3,28 @@ package tests
 import (
 	"bytes"
 	"encoding/json"
-	"log"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
-	"github.com/joho/godotenv"
-
+	"meu-projeto/backend/apierror"
 	"meu-projeto/backend/controllers"
-	"meu-projeto/backend/database"
 	"meu-projeto/backend/repositories"
 	"meu-projeto/backend/services"
+	"meu-projeto/backend/validation"
 )
 
-// init é uma função especial que é executada antes de qualquer teste.
-func init() {
-	// Carrega as variáveis de ambiente do arquivo .env
-	if err := godotenv.Load("../.env"); err != nil {
-		log.Fatal("Erro ao carregar o arquivo .env") // Encerra o programa se o arquivo .env não for encontrado
-	} else {
-		log.Println("Arquivo .env carregado com sucesso!") // Loga uma mensagem de sucesso
-	}
+// newDeliveryController cria um DeliveryController com armazenamento em memória, sem depender do MySQL.
+func newDeliveryController() *controllers.DeliveryController {
+	stores := repositories.NewMemoryStores()
+	service := &services.DeliveryService{Repository: stores.Deliveries, Events: stores.Events, Zones: stores.Zones}
+	return &controllers.DeliveryController{Service: service}
 }
 
 // TestCreateDeliveryValidation testa as validações ao criar uma entrega.
 func TestCreateDeliveryValidation(t *testing.T) {
-	// Configura o banco de dados e o repositório
-	database.InitDB()
-	repo := &repositories.DeliveryRepository{DB: database.DB}
-	service := &services.DeliveryService{Repository: repo}
-	controller := &controllers.DeliveryController{Service: service}
+	// Configura o controlador com armazenamento em memória
+	controller := newDeliveryController()
 
 	// Caso de teste 1: Campo 'peso' ausente
 	t.Run("Campo 'peso' ausente", func(t *testing.T) {
@@ -46,7 +38,7 @@ func TestCreateDeliveryValidation(t *testing.T) {
 				"cpf":  "529.982.247-25",
 			},
 		}
-		testValidation(t, controller, payload, "O campo 'peso' é obrigatório e deve ser maior que zero")
+		testValidation(t, controller, payload, validation.FieldError{Field: "delivery.peso", Code: validation.CodeRequired})
 	})
 
 	// Caso de teste 2: CPF inválido
@@ -62,12 +54,36 @@ func TestCreateDeliveryValidation(t *testing.T) {
 				"cpf":  "123.456.789-00", // CPF inválido
 			},
 		}
-		testValidation(t, controller, payload, "CPF inválido")
+		testValidation(t, controller, payload, validation.FieldError{Field: "cliente.cpf", Code: validation.CodeInvalidFormat})
+	})
+
+	// Caso de teste 3: Vários campos inválidos são informados de uma só vez
+	t.Run("Vários campos inválidos", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"delivery": map[string]interface{}{
+				"peso":   -1,
+				"cep":    "123",
+				"estado": "XX",
+			},
+			"cliente": map[string]interface{}{
+				"cpf":   "529.982.247-25",
+				"email": "joao",
+			},
+		}
+		testValidation(t, controller, payload,
+			validation.FieldError{Field: "delivery.peso", Code: validation.CodeOutOfRange},
+			validation.FieldError{Field: "delivery.cep", Code: validation.CodeInvalidFormat},
+			validation.FieldError{Field: "delivery.endereco", Code: validation.CodeRequired},
+			validation.FieldError{Field: "delivery.cidade", Code: validation.CodeRequired},
+			validation.FieldError{Field: "delivery.estado", Code: validation.CodeInvalidValue},
+			validation.FieldError{Field: "cliente.nome", Code: validation.CodeRequired},
+			validation.FieldError{Field: "cliente.email", Code: validation.CodeInvalidFormat},
+		)
 	})
 }
 
-// Função auxiliar para testar validações
-func testValidation(t *testing.T, controller *controllers.DeliveryController, payload map[string]interface{}, expectedError string) {
+// Função auxiliar para testar validações: verifica o status 422 e a lista exata de campos e códigos de erro
+func testValidation(t *testing.T, controller *controllers.DeliveryController, payload map[string]interface{}, expected ...validation.FieldError) {
 	// Converte o payload para JSON
 	body, _ := json.Marshal(payload)
 
@@ -78,28 +94,33 @@ func testValidation(t *testing.T, controller *controllers.DeliveryController, pa
 	// Chama o método Create do controller
 	controller.Create(rr, req)
 
-	// Verifica se o status da resposta é 400 (Bad Request)
-	if rr.Code != http.StatusBadRequest {
-		t.Errorf("Esperava status %d, mas recebeu %d", http.StatusBadRequest, rr.Code)
+	// Verifica se o status da resposta é 422 (Unprocessable Entity)
+	if rr.Code != http.StatusUnprocessableEntity {
+		t.Errorf("Esperava status %d, mas recebeu %d", http.StatusUnprocessableEntity, rr.Code)
 	}
 
-	// Decodifica a resposta JSON para um mapa
-	var response map[string]string
+	// Decodifica a resposta JSON com a lista de erros
+	var response apierror.Response
 	json.NewDecoder(rr.Body).Decode(&response)
+	if response.Error.Code != apierror.CodeValidation {
+		t.Errorf("Esperava o código %s, mas recebeu %+v", apierror.CodeValidation, response.Error)
+	}
 
-	// Verifica se a mensagem de erro é a esperada
-	if response["error"] != expectedError {
-		t.Errorf("Esperava erro '%s', mas recebeu '%s'", expectedError, response["error"])
+	// Verifica se os erros são os esperados, na mesma ordem, e se todos têm mensagem
+	if len(response.Error.Fields) != len(expected) {
+		t.Fatalf("Esperava %d erros, mas recebeu %+v", len(expected), response.Error.Fields)
+	}
+	for i, fieldErr := range response.Error.Fields {
+		if fieldErr.Field != expected[i].Field || fieldErr.Code != expected[i].Code || fieldErr.Message == "" {
+			t.Errorf("Erro %d: esperava %s/%s, mas recebeu %+v", i, expected[i].Field, expected[i].Code, fieldErr)
+		}
 	}
 }
 
 // TestFindByIDValidation testa as validações ao buscar uma entrega por ID.
 func TestFindByIDValidation(t *testing.T) {
-	// Configura o banco de dados e o repositório
-	database.InitDB()
-	repo := &repositories.DeliveryRepository{DB: database.DB}
-	service := &services.DeliveryService{Repository: repo}
-	controller := &controllers.DeliveryController{Service: service}
+	// Configura o controlador com armazenamento em memória
+	controller := newDeliveryController()
 
 	// Caso de teste: ID inválido
 	t.Run("ID inválido", func(t *testing.T) {
@@ -115,24 +136,21 @@ func TestFindByIDValidation(t *testing.T) {
 			t.Errorf("Esperava status %d, mas recebeu %d", http.StatusBadRequest, rr.Code)
 		}
 
-		// Decodifica a resposta JSON para um mapa
-		var response map[string]string
+		// Decodifica a resposta JSON com o erro
+		var response apierror.Response
 		json.NewDecoder(rr.Body).Decode(&response)
 
-		// Verifica se a mensagem de erro é a esperada
-		if response["error"] != "ID inválido" {
-			t.Errorf("Esperava erro 'ID inválido', mas recebeu '%s'", response["error"])
+		// Verifica se o código e a mensagem de erro são os esperados
+		if response.Error.Code != apierror.CodeBadRequest || response.Error.Message != "ID inválido" {
+			t.Errorf("Esperava erro 'ID inválido', mas recebeu %+v", response.Error)
 		}
 	})
 }
 
 // TestFindByCityValidation testa as validações ao buscar entregas por cidade.
 func TestFindByCityValidation(t *testing.T) {
-	// Configura o banco de dados e o repositório
-	database.InitDB()
-	repo := &repositories.DeliveryRepository{DB: database.DB}
-	service := &services.DeliveryService{Repository: repo}
-	controller := &controllers.DeliveryController{Service: service}
+	// Configura o controlador com armazenamento em memória
+	controller := newDeliveryController()
 
 	// Caso de teste: Cidade não fornecida
 	t.Run("Cidade não fornecida", func(t *testing.T) {
@@ -148,13 +166,13 @@ func TestFindByCityValidation(t *testing.T) {
 			t.Errorf("Esperava status %d, mas recebeu %d", http.StatusBadRequest, rr.Code)
 		}
 
-		// Decodifica a resposta JSON para um mapa
-		var response map[string]string
+		// Decodifica a resposta JSON com o erro
+		var response apierror.Response
 		json.NewDecoder(rr.Body).Decode(&response)
 
-		// Verifica se a mensagem de erro é a esperada
-		if response["error"] != "O parâmetro 'cidade' é obrigatório" {
-			t.Errorf("Esperava erro 'O parâmetro 'cidade' é obrigatório', mas recebeu '%s'", response["error"])
+		// Verifica se o código e a mensagem de erro são os esperados
+		if response.Error.Code != apierror.CodeBadRequest || response.Error.Message != "O parâmetro 'cidade' é obrigatório" {
+			t.Errorf("Esperava erro 'O parâmetro 'cidade' é obrigatório', mas recebeu %+v", response.Error)
 		}
 	})
 }
@@ -0,0 +1,335 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"meu-projeto/backend/database"
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/services"
+	"meu-projeto/backend/utils"
+)
+
+// forEachStore executa o teste com cada implementação de armazenamento (memória e SQLite),
+// garantindo que ambas se comportem da mesma forma.
+func forEachStore(t *testing.T, test func(t *testing.T, stores repositories.Stores)) {
+	t.Run("memória", func(t *testing.T) {
+		test(t, repositories.NewMemoryStores())
+	})
+	t.Run("sqlite", func(t *testing.T) {
+		db, err := database.OpenSQLite(filepath.Join(t.TempDir(), "test.db"))
+		if err != nil {
+			t.Fatalf("Erro ao abrir o banco SQLite: %v", err)
+		}
+		defer db.Close()
+		if _, err := database.MigrateUp(db, "sqlite"); err != nil {
+			t.Fatalf("Erro ao aplicar as migrações: %v", err)
+		}
+		test(t, repositories.NewSQLStores(db))
+	})
+}
+
+// newServices cria os serviços de entregas, rastreamento e clientes sobre o armazenamento informado.
+func newServices(stores repositories.Stores) (*services.DeliveryService, *services.TrackingEventService, *services.ClientService) {
+	deliveryService := &services.DeliveryService{Repository: stores.Deliveries, Events: stores.Events, Zones: stores.Zones}
+	eventService := &services.TrackingEventService{Repository: stores.Events, Deliveries: deliveryService}
+	clientService := &services.ClientService{Repository: stores.Clients}
+	return deliveryService, eventService, clientService
+}
+
+// newDelivery cria uma entrega válida na cidade informada.
+func newDelivery(cidade string, peso float64) models.Delivery {
+	return models.Delivery{Peso: peso, Endereco: "Rua das Flores, 123", Cidade: cidade, Estado: "SP", Pais: "Brasil"}
+}
+
+// TestCreateDelivery testa se uma nova entrega recebe status, código de rastreio e o primeiro evento do histórico.
+func TestCreateDelivery(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		deliveryService, _, _ := newServices(stores)
+
+		id, err := deliveryService.Create(newDelivery("São Paulo", 2.5), models.Cliente{Nome: "João Silva", CPF: "529.982.247-25"})
+		if err != nil {
+			t.Fatalf("Create retornou erro: %v", err)
+		}
+
+		delivery, err := deliveryService.FindByID(int(id))
+		if err != nil || delivery == nil {
+			t.Fatalf("FindByID(%d) = %v, %v; esperava a entrega criada", id, delivery, err)
+		}
+		if delivery.Status != models.StatusPendente {
+			t.Errorf("Esperava status '%s', mas recebeu '%s'", models.StatusPendente, delivery.Status)
+		}
+		if !utils.ValidateTrackingCode(delivery.CodigoRastreio) {
+			t.Errorf("Esperava um código de rastreio válido, mas recebeu '%s'", delivery.CodigoRastreio)
+		}
+		if delivery.UltimoEvento == nil || delivery.UltimoEvento.Status != models.StatusPendente {
+			t.Errorf("Esperava o evento de cadastro como último evento, mas recebeu %+v", delivery.UltimoEvento)
+		}
+	})
+}
+
+// TestUpdateStatus testa a aplicação das transições de status e o registro no histórico.
+func TestUpdateStatus(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		deliveryService, eventService, _ := newServices(stores)
+		id, _ := deliveryService.Create(newDelivery("São Paulo", 2.5), models.Cliente{Nome: "João Silva", CPF: "529.982.247-25"})
+
+		// Caso de teste 1: Transição permitida
+		if _, err := deliveryService.UpdateStatus(int(id), models.TrackingEvent{Status: models.StatusColetada}); err != nil {
+			t.Fatalf("UpdateStatus para '%s' retornou erro: %v", models.StatusColetada, err)
+		}
+
+		// Caso de teste 2: Transição não permitida
+		_, err := deliveryService.UpdateStatus(int(id), models.TrackingEvent{Status: models.StatusEntregue})
+		if !errors.Is(err, services.ErrTransicaoInvalida) {
+			t.Errorf("Esperava ErrTransicaoInvalida, mas recebeu %v", err)
+		}
+
+		// Caso de teste 3: Entrega inexistente
+		_, err = deliveryService.UpdateStatus(999, models.TrackingEvent{Status: models.StatusColetada})
+		if !errors.Is(err, services.ErrEntregaNaoEncontrada) {
+			t.Errorf("Esperava ErrEntregaNaoEncontrada, mas recebeu %v", err)
+		}
+
+		// O histórico deve conter o cadastro e a coleta
+		events, err := eventService.List(int(id))
+		if err != nil {
+			t.Fatalf("List retornou erro: %v", err)
+		}
+		if len(events) != 2 || events[1].Status != models.StatusColetada {
+			t.Errorf("Esperava 2 eventos terminando em '%s', mas recebeu %+v", models.StatusColetada, events)
+		}
+	})
+}
+
+// failingEventStore simula um banco de dados indisponível no registro dos eventos de rastreamento.
+type failingEventStore struct {
+	repositories.TrackingEventStore
+}
+
+func (failingEventStore) Create(*models.TrackingEvent) error {
+	return errors.New("conexão recusada")
+}
+
+// TestCreateDeliveryRollback testa que a entrega é removida quando o registro do primeiro evento falha, em
+// vez de ficar cadastrada sem histórico.
+func TestCreateDeliveryRollback(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		deliveryService, _, _ := newServices(stores)
+		deliveryService.Events = failingEventStore{stores.Events}
+
+		if _, err := deliveryService.Create(newDelivery("São Paulo", 2.5), models.Cliente{Nome: "João Silva", CPF: "529.982.247-25"}); err == nil {
+			t.Fatal("Esperava erro ao cadastrar a entrega sem o registro do evento")
+		}
+		if page, err := deliveryService.List(models.DeliveryFilter{Page: 1, PageSize: 10}); err != nil || page.Total != 0 {
+			t.Errorf("Esperava nenhuma entrega cadastrada, mas recebeu %+v (erro: %v)", page.Items, err)
+		}
+	})
+}
+
+// TestUpdateStatusRollback testa que o status anterior é restaurado quando o registro do evento da mudança
+// falha, para que nenhuma transição fique sem histórico.
+func TestUpdateStatusRollback(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		deliveryService, _, _ := newServices(stores)
+		id, err := deliveryService.Create(newDelivery("São Paulo", 2.5), models.Cliente{Nome: "João Silva", CPF: "529.982.247-25"})
+		if err != nil {
+			t.Fatalf("Erro ao cadastrar a entrega: %v", err)
+		}
+
+		failing := *deliveryService
+		failing.Events = failingEventStore{stores.Events}
+		if _, err := failing.UpdateStatus(int(id), models.TrackingEvent{Status: models.StatusColetada}); err == nil {
+			t.Fatal("Esperava erro ao alterar o status sem o registro do evento")
+		}
+		if delivery, _ := deliveryService.FindByID(int(id)); delivery.Status != models.StatusPendente {
+			t.Errorf("Esperava o status '%s' restaurado, mas recebeu '%s'", models.StatusPendente, delivery.Status)
+		}
+	})
+}
+
+// racingDeliveryStore simula uma transição concorrente: antes de cada alteração de status, outra operação
+// altera o status da entrega para concurrent.
+type racingDeliveryStore struct {
+	repositories.DeliveryStore
+	concurrent string
+}
+
+func (s racingDeliveryStore) UpdateStatus(id int, from, to string) error {
+	if err := s.DeliveryStore.UpdateStatus(id, from, s.concurrent); err != nil {
+		return err
+	}
+	return s.DeliveryStore.UpdateStatus(id, from, to)
+}
+
+// TestUpdateStatusConflict testa que a transição é recusada quando o status muda entre a leitura e a
+// escrita, sem sobrescrever o status da outra operação nem registrar o evento.
+func TestUpdateStatusConflict(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		deliveryService, eventService, _ := newServices(stores)
+		id, _ := deliveryService.Create(newDelivery("São Paulo", 2.5), models.Cliente{Nome: "João Silva", CPF: "529.982.247-25"})
+
+		// No repositório, a alteração só é feita a partir do status esperado
+		if err := stores.Deliveries.UpdateStatus(int(id), models.StatusColetada, models.StatusEmRota); !errors.Is(err, repositories.ErrConflict) {
+			t.Errorf("Esperava ErrConflict com o status esperado diferente do atual, mas recebeu %v", err)
+		}
+
+		// No serviço, a entrega é cancelada por outra operação enquanto a coleta é registrada
+		racing := *deliveryService
+		racing.Repository = racingDeliveryStore{DeliveryStore: stores.Deliveries, concurrent: models.StatusCancelada}
+		_, err := racing.UpdateStatus(int(id), models.TrackingEvent{Status: models.StatusColetada})
+		if !errors.Is(err, services.ErrTransicaoInvalida) {
+			t.Errorf("Esperava ErrTransicaoInvalida, mas recebeu %v", err)
+		}
+
+		delivery, _ := deliveryService.FindByID(int(id))
+		if delivery.Status != models.StatusCancelada {
+			t.Errorf("Esperava o status '%s' da outra operação, mas recebeu '%s'", models.StatusCancelada, delivery.Status)
+		}
+		if events, _ := eventService.List(int(id)); len(events) != 1 {
+			t.Errorf("Esperava apenas o evento do cadastro, mas recebeu %+v", events)
+		}
+	})
+}
+
+// TestTrack testa a consulta pública pelo código de rastreio.
+func TestTrack(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		deliveryService, eventService, _ := newServices(stores)
+		id, _ := deliveryService.Create(newDelivery("Campinas", 1), models.Cliente{Nome: "João Silva", CPF: "529.982.247-25"})
+		delivery, _ := deliveryService.FindByID(int(id))
+
+		view, err := eventService.Track(delivery.CodigoRastreio)
+		if err != nil {
+			t.Fatalf("Track retornou erro: %v", err)
+		}
+		if view.Cidade != "Campinas" || view.Status != models.StatusPendente || len(view.Eventos) != 1 {
+			t.Errorf("Visão pública inesperada: %+v", view)
+		}
+
+		// As observações internas (nomes de motoristas, anotações dos operadores) não aparecem no rastreio
+		drivers := &services.DriverService{Repository: stores.Drivers, Deliveries: deliveryService}
+		maria := newDriver("Maria Souza", "123.456.789-09", "12345678900")
+		drivers.Create(&maria)
+		if _, err := drivers.Assign(maria.ID, int(id)); err != nil {
+			t.Fatalf("Erro ao atribuir a entrega: %v", err)
+		}
+		if _, err := deliveryService.UpdateStatus(int(id), models.TrackingEvent{Status: models.StatusColetada, Observacao: "Cliente pediu para deixar com o porteiro Carlos"}); err != nil {
+			t.Fatalf("Erro ao alterar o status: %v", err)
+		}
+		view, _ = eventService.Track(delivery.CodigoRastreio)
+		data, _ := json.Marshal(view)
+		if strings.Contains(string(data), "Maria") || strings.Contains(string(data), "Carlos") {
+			t.Errorf("Esperava o rastreio sem as observações internas, mas recebeu %s", data)
+		}
+		if len(view.Eventos) != 2 || view.Eventos[1].Status != models.StatusColetada || view.Eventos[1].Descricao != models.StatusDescriptions[models.StatusColetada] {
+			t.Errorf("Esperava apenas as mudanças de status com a descrição fixa, mas recebeu %+v", view.Eventos)
+		}
+
+		// Códigos com dígito verificador errado são rejeitados sem consultar o banco
+		if _, err := eventService.Track("EN473124828BR"); !errors.Is(err, services.ErrCodigoRastreioInvalido) {
+			t.Errorf("Esperava ErrCodigoRastreioInvalido, mas recebeu %v", err)
+		}
+	})
+}
+
+// TestListDeliveries testa os filtros, a ordenação e a paginação da listagem de entregas.
+func TestListDeliveries(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		deliveryService, _, _ := newServices(stores)
+		cliente := models.Cliente{Nome: "João Silva", CPF: "529.982.247-25"}
+		for _, delivery := range []models.Delivery{newDelivery("São Paulo", 5), newDelivery("Campinas", 1), newDelivery("São Paulo", 3), newDelivery("São Paulo", 10)} {
+			if _, err := deliveryService.Create(delivery, cliente); err != nil {
+				t.Fatalf("Create retornou erro: %v", err)
+			}
+		}
+
+		// Filtra por cidade (sem acento), ordena por peso decrescente e pega a primeira página com 2 itens
+		pesoMin := 2.0
+		page, err := deliveryService.List(models.DeliveryFilter{Cidade: "sao paulo", PesoMin: &pesoMin, Sort: "peso", Desc: true, Page: 1, PageSize: 2})
+		if err != nil {
+			t.Fatalf("List retornou erro: %v", err)
+		}
+		if page.Total != 3 || page.TotalPages != 2 || len(page.Items) != 2 {
+			t.Fatalf("Esperava total 3 em 2 páginas com 2 itens, mas recebeu %+v", page)
+		}
+		if page.Items[0].Peso != 10 || page.Items[1].Peso != 5 {
+			t.Errorf("Esperava pesos 10 e 5, mas recebeu %v e %v", page.Items[0].Peso, page.Items[1].Peso)
+		}
+	})
+}
+
+// TestListClients testa a busca de clientes por nome sem acento e por CPF sem pontuação.
+func TestListClients(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		_, _, clientService := newServices(stores)
+		clientService.Create(&models.Cliente{Nome: "Patrícia Gonçalves", CPF: "529.982.247-25", Email: "patricia@example.com"})
+		clientService.Create(&models.Cliente{Nome: "João Silva", CPF: "123.456.789-09", Email: "joao@example.com"})
+
+		// Define uma lista de casos de teste
+		tests := []struct {
+			q        string // Texto buscado
+			expected string // Nome do único cliente esperado
+		}{
+			{"goncalves", "Patrícia Gonçalves"}, // Busca parcial sem acento
+			{"JOAO@", "João Silva"},             // Busca parcial no e-mail
+			{"12345678909", "João Silva"},       // CPF sem pontuação
+		}
+
+		for _, test := range tests {
+			page, err := clientService.List(models.ClientFilter{Q: test.q, Page: 1, PageSize: models.DefaultPageSize})
+			if err != nil {
+				t.Fatalf("List(%s) retornou erro: %v", test.q, err)
+			}
+			if page.Total != 1 || page.Items[0].Nome != test.expected {
+				t.Errorf("List(%s) = %+v; esperava apenas '%s'", test.q, page.Items, test.expected)
+			}
+		}
+	})
+}
+
+// TestNearbyDeliveries testa a busca por proximidade: raio, ordem por distância e exclusão das entregas finalizadas.
+func TestNearbyDeliveries(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		deliveryService, _, _ := newServices(stores)
+		cliente := models.Cliente{Nome: "João Silva", CPF: "529.982.247-25"}
+
+		// Entregas a cerca de 1 km, 3 km, 8 km e 50 km a leste da Praça da Sé
+		longitudes := []float64{-46.624, -46.604, -46.556, -46.145}
+		ids := []int{}
+		for _, lng := range longitudes {
+			delivery := newDelivery("São Paulo", 1)
+			delivery.Latitude, delivery.Longitude = -23.5505, lng
+			id, _ := deliveryService.Create(delivery, cliente)
+			ids = append(ids, int(id))
+		}
+		deliveryService.UpdateStatus(ids[1], models.TrackingEvent{Status: models.StatusCancelada})
+
+		nearby, err := deliveryService.Nearby(-23.5505, -46.6333, 10, "", 0, 50)
+		if err != nil {
+			t.Fatalf("Nearby retornou erro: %v", err)
+		}
+
+		// A entrega cancelada e a que está a 50 km ficam de fora
+		if len(nearby) != 2 || nearby[0].ID != ids[0] || nearby[1].ID != ids[2] {
+			t.Fatalf("Esperava as entregas %d e %d, nesta ordem, mas recebeu %+v", ids[0], ids[2], nearby)
+		}
+		if nearby[0].DistanciaKm <= 0 || nearby[0].DistanciaKm >= nearby[1].DistanciaKm {
+			t.Errorf("Esperava distâncias crescentes, mas recebeu %.3f e %.3f", nearby[0].DistanciaKm, nearby[1].DistanciaKm)
+		}
+
+		// Com o status informado, as entregas finalizadas também são consideradas
+		if canceled, _ := deliveryService.Nearby(-23.5505, -46.6333, 10, models.StatusCancelada, 0, 50); len(canceled) != 1 || canceled[0].ID != ids[1] {
+			t.Errorf("Esperava apenas a entrega cancelada, mas recebeu %+v", canceled)
+		}
+
+		// Caso de erro: raio inválido
+		if _, err := deliveryService.Nearby(-23.5505, -46.6333, 0, "", 0, 50); !errors.Is(err, services.ErrBuscaInvalida) {
+			t.Errorf("Esperava ErrBuscaInvalida para raio zero, mas recebeu %v", err)
+		}
+	})
+}
@@ -0,0 +1,35 @@
+package tests
+
+import (
+	"meu-projeto/backend/models"
+	"testing"
+)
+
+// TestCanTransition testa as transições de status permitidas para uma entrega.
+func TestCanTransition(t *testing.T) {
+	// Define uma lista de casos de teste
+	tests := []struct {
+		from     string // Status atual
+		to       string // Novo status
+		expected bool   // Resultado esperado (true para transição permitida)
+	}{
+		{models.StatusPendente, models.StatusColetada, true},   // Fluxo normal
+		{models.StatusColetada, models.StatusEmRota, true},     // Fluxo normal
+		{models.StatusEmRota, models.StatusEntregue, true},     // Fluxo normal
+		{models.StatusEmRota, models.StatusFalhou, true},       // Tentativa sem sucesso
+		{models.StatusFalhou, models.StatusEmRota, true},       // Nova tentativa
+		{models.StatusPendente, models.StatusEntregue, false},  // Pula etapas
+		{models.StatusEntregue, models.StatusEmRota, false},    // Status final
+		{models.StatusCancelada, models.StatusPendente, false}, // Status final
+		{models.StatusPendente, models.StatusPendente, false},  // Mesmo status
+		{models.StatusPendente, "extraviada", false},           // Status desconhecido
+	}
+
+	// Itera sobre os casos de teste
+	for _, test := range tests {
+		result := models.CanTransition(test.from, test.to)
+		if result != test.expected {
+			t.Errorf("CanTransition(%s, %s) = %v; esperava %v", test.from, test.to, result, test.expected)
+		}
+	}
+}
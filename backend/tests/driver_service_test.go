@@ -0,0 +1,113 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/services"
+)
+
+// newDriver cria um motorista ativo com os dados informados.
+func newDriver(nome, cpf, cnh string) models.Motorista {
+	return models.Motorista{Nome: nome, CPF: cpf, CNHNumero: cnh, CNHCategoria: "b", Telefone: "11999990000", Ativo: true}
+}
+
+// TestCreateDriver testa a validação e a normalização dos dados do motorista.
+func TestCreateDriver(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		deliveryService, _, _ := newServices(stores)
+		service := &services.DriverService{Repository: stores.Drivers, Deliveries: deliveryService}
+
+		driver := newDriver("Maria Souza", "52998224725", "12345678900")
+		if err := service.Create(&driver); err != nil {
+			t.Fatalf("Create retornou erro: %v", err)
+		}
+		if driver.CPF != "529.982.247-25" || driver.CNHCategoria != "B" {
+			t.Errorf("Esperava CPF e categoria normalizados, mas recebeu %s e %s", driver.CPF, driver.CNHCategoria)
+		}
+
+		// Casos de erro: CPF inválido, CNH fora do formato e CPF duplicado
+		invalidCPF := newDriver("José", "111.111.111-11", "98765432100")
+		if err := service.Create(&invalidCPF); !errors.Is(err, services.ErrMotoristaInvalido) {
+			t.Errorf("Esperava ErrMotoristaInvalido para CPF inválido, mas recebeu %v", err)
+		}
+		invalidCNH := newDriver("José", "111.444.777-35", "123")
+		if err := service.Create(&invalidCNH); !errors.Is(err, services.ErrMotoristaInvalido) {
+			t.Errorf("Esperava ErrMotoristaInvalido para CNH inválida, mas recebeu %v", err)
+		}
+		duplicate := newDriver("Outra Maria", "529.982.247-25", "98765432100")
+		if err := service.Create(&duplicate); !errors.Is(err, services.ErrMotoristaDuplicado) {
+			t.Errorf("Esperava ErrMotoristaDuplicado, mas recebeu %v", err)
+		}
+	})
+}
+
+// TestAssignDelivery testa a atribuição, a transferência e a remoção de entregas, e o registro no histórico.
+func TestAssignDelivery(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		deliveryService, eventService, _ := newServices(stores)
+		service := (&services.DriverService{Repository: stores.Drivers, Deliveries: deliveryService}).ForActor("ana@example.com")
+
+		maria := newDriver("Maria Souza", "529.982.247-25", "12345678900")
+		jose := newDriver("José Lima", "111.444.777-35", "98765432100")
+		service.Create(&maria)
+		service.Create(&jose)
+		id, _ := deliveryService.Create(newDelivery("São Paulo", 2.5), models.Cliente{Nome: "João Silva", CPF: "123.456.789-09"})
+		deliveryID := int(id)
+
+		// Atribui a entrega à Maria e verifica a carga de trabalho e o histórico
+		delivery, err := service.Assign(maria.ID, deliveryID)
+		if err != nil {
+			t.Fatalf("Assign retornou erro: %v", err)
+		}
+		if delivery.MotoristaID == nil || *delivery.MotoristaID != maria.ID {
+			t.Errorf("Esperava a entrega atribuída à motorista %d, mas recebeu %v", maria.ID, delivery.MotoristaID)
+		}
+		workload, err := service.Workload(maria.ID)
+		if err != nil || len(workload) != 1 || workload[0].ID != deliveryID {
+			t.Errorf("Esperava 1 entrega para a Maria, mas recebeu %v (erro: %v)", workload, err)
+		}
+
+		// Transfere a entrega para o José
+		if _, err := service.Assign(jose.ID, deliveryID); err != nil {
+			t.Fatalf("Assign (transferência) retornou erro: %v", err)
+		}
+		if workload, _ := service.Workload(maria.ID); len(workload) != 0 {
+			t.Errorf("Esperava nenhuma entrega para a Maria após a transferência, mas recebeu %d", len(workload))
+		}
+
+		// Caso de erro: remover de quem não tem a entrega
+		if _, err := service.Unassign(maria.ID, deliveryID); !errors.Is(err, services.ErrAtribuicaoInvalida) {
+			t.Errorf("Esperava ErrAtribuicaoInvalida, mas recebeu %v", err)
+		}
+		if _, err := service.Unassign(jose.ID, deliveryID); err != nil {
+			t.Fatalf("Unassign retornou erro: %v", err)
+		}
+
+		// Cadastro, atribuição, transferência e remoção devem constar no histórico
+		events, _ := eventService.List(deliveryID)
+		if len(events) != 4 {
+			t.Errorf("Esperava 4 eventos no histórico, mas recebeu %d", len(events))
+		} else if events[3].Responsavel != "ana@example.com" {
+			t.Errorf("Esperava quem fez a remoção como responsável pelo último evento, mas recebeu '%s'", events[3].Responsavel)
+		}
+
+		// Caso de erro: motorista inativo não recebe entregas
+		jose.Ativo = false
+		service.Update(&jose)
+		if _, err := service.Assign(jose.ID, deliveryID); !errors.Is(err, services.ErrAtribuicaoInvalida) {
+			t.Errorf("Esperava ErrAtribuicaoInvalida para motorista inativo, mas recebeu %v", err)
+		}
+
+		// Ao excluir a motorista, a entrega fica sem motorista
+		service.Assign(maria.ID, deliveryID)
+		if err := service.Delete(maria.ID); err != nil {
+			t.Fatalf("Delete retornou erro: %v", err)
+		}
+		if delivery, _ := deliveryService.FindByID(deliveryID); delivery.MotoristaID != nil {
+			t.Errorf("Esperava a entrega sem motorista após a exclusão, mas recebeu %v", *delivery.MotoristaID)
+		}
+	})
+}
@@ -0,0 +1,166 @@
+package tests
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"meu-projeto/backend/geocoding"
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/services"
+)
+
+// TestGazetteer testa a busca no dicionário geográfico embutido, do logradouro à cidade.
+func TestGazetteer(t *testing.T) {
+	gazetteer := geocoding.DefaultGazetteer()
+
+	tests := []struct {
+		address  geocoding.Address
+		precisao string
+		lat, lng float64
+	}{
+		{geocoding.Address{Logradouro: "Av. Paulista", Cidade: "sao paulo", Estado: "SP"}, geocoding.PrecisaoLogradouro, -23.5632, -46.6542},
+		{geocoding.Address{Logradouro: "Rua Desconhecida", Bairro: "COPACABANA", Cidade: "Rio de Janeiro"}, geocoding.PrecisaoBairro, -22.9711, -43.1822},
+		{geocoding.Address{Cidade: "Campinas"}, geocoding.PrecisaoCidade, -22.9056, -47.0608},
+	}
+	for _, test := range tests {
+		result, err := gazetteer.Geocode(test.address)
+		if err != nil {
+			t.Errorf("Geocode(%+v) retornou erro: %v", test.address, err)
+			continue
+		}
+		if result.Precisao != test.precisao || result.Latitude != test.lat || result.Longitude != test.lng {
+			t.Errorf("Geocode(%+v) = %+v; esperava %s em (%v, %v)", test.address, result, test.precisao, test.lat, test.lng)
+		}
+	}
+
+	// Caso de erro: cidade fora do dicionário ou de outro estado
+	if _, err := gazetteer.Geocode(geocoding.Address{Cidade: "Campinas", Estado: "RJ"}); !errors.Is(err, geocoding.ErrNotFound) {
+		t.Errorf("Esperava ErrNotFound, mas recebeu %v", err)
+	}
+
+	// Caso de erro: arquivo com coordenadas inválidas
+	if _, err := geocoding.NewGazetteer(strings.NewReader("estado,cidade,bairro,logradouro,latitude,longitude\nSP,X,,,abc,0\n")); err == nil {
+		t.Errorf("Esperava erro para dicionário com coordenadas inválidas")
+	}
+}
+
+// TestHTTPGeocoder testa o adaptador HTTP contra um servidor local que imita o Nominatim.
+func TestHTTPGeocoder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch {
+		case r.URL.Path != "/search" || r.Header.Get("User-Agent") != "testes":
+			w.WriteHeader(http.StatusBadRequest)
+		case query.Get("city") == "Falha":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case query.Get("street") == "100 Rua Augusta" && query.Get("state") == "SP" && query.Get("country") == "Brasil":
+			w.Write([]byte(`[{"lat":"-23.5538","lon":"-46.6563","addresstype":"road"}]`))
+		default:
+			w.Write([]byte(`[]`))
+		}
+	}))
+	defer server.Close()
+	provider := &geocoding.HTTPGeocoder{BaseURL: server.URL, UserAgent: "testes"}
+
+	result, err := provider.Geocode(geocoding.Address{Logradouro: "Rua Augusta", Numero: "100", Cidade: "São Paulo", Estado: "SP"})
+	if err != nil {
+		t.Fatalf("Geocode retornou erro: %v", err)
+	}
+	if result.Latitude != -23.5538 || result.Longitude != -46.6563 || result.Precisao != geocoding.PrecisaoLogradouro {
+		t.Errorf("Resultado inesperado: %+v", result)
+	}
+
+	// Lista vazia indica endereço não encontrado; status de erro indica provedor indisponível
+	if _, err := provider.Geocode(geocoding.Address{Cidade: "Lugar Nenhum"}); !errors.Is(err, geocoding.ErrNotFound) {
+		t.Errorf("Esperava ErrNotFound, mas recebeu %v", err)
+	}
+	if _, err := provider.Geocode(geocoding.Address{Cidade: "Falha"}); err == nil || errors.Is(err, geocoding.ErrNotFound) {
+		t.Errorf("Esperava erro de provedor indisponível, mas recebeu %v", err)
+	}
+
+	// Na cadeia, o dicionário offline é usado quando o provedor não encontra o endereço
+	chain := geocoding.Chain{provider, geocoding.DefaultGazetteer()}
+	result, err = chain.Geocode(geocoding.Address{Cidade: "Santos", Estado: "SP"})
+	if err != nil || result.Provedor != "offline" {
+		t.Errorf("Esperava o resultado do dicionário offline, mas recebeu %+v (erro: %v)", result, err)
+	}
+}
+
+// TestCreateDeliveryGeocoding testa o preenchimento das coordenadas no cadastro de entregas sem latitude e longitude.
+func TestCreateDeliveryGeocoding(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		deliveryService, _, _ := newServices(stores)
+		deliveryService.Geocoder = geocoding.DefaultGazetteer()
+		cliente := models.Cliente{Nome: "João Silva", CPF: "529.982.247-25"}
+
+		// Sem logradouro, usa o início do endereço completo
+		delivery := newDelivery("São Paulo", 1)
+		delivery.Endereco = "Avenida Paulista, 1000"
+		id, err := deliveryService.Create(delivery, cliente)
+		if err != nil {
+			t.Fatalf("Create retornou erro: %v", err)
+		}
+		created, _ := deliveryService.FindByID(int(id))
+		if created.Latitude != -23.5632 || created.Longitude != -46.6542 {
+			t.Errorf("Esperava as coordenadas da Avenida Paulista, mas recebeu (%v, %v)", created.Latitude, created.Longitude)
+		}
+
+		// Coordenadas informadas não são alteradas
+		delivery.Latitude, delivery.Longitude = -23.6, -46.7
+		id, _ = deliveryService.Create(delivery, cliente)
+		if created, _ := deliveryService.FindByID(int(id)); created.Latitude != -23.6 {
+			t.Errorf("Esperava a latitude informada, mas recebeu %v", created.Latitude)
+		}
+
+		// Caso de erro: endereço fora do dicionário
+		if _, err := deliveryService.Create(newDelivery("Cidade Inexistente", 1), cliente); !errors.Is(err, services.ErrEnderecoNaoEncontrado) {
+			t.Errorf("Esperava ErrEnderecoNaoEncontrado, mas recebeu %v", err)
+		}
+	})
+}
+
+// TestReverseGeocode testa a busca do endereço conhecido mais próximo, com as entregas cadastradas como alternativa.
+func TestReverseGeocode(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		deliveryService, _, _ := newServices(stores)
+		service := &services.GeocodeService{Dataset: geocoding.DefaultGazetteer(), Deliveries: stores.Deliveries}
+
+		// Perto de uma rua do dicionário
+		result, err := service.Reverse(-23.5635, -46.6545)
+		if err != nil {
+			t.Fatalf("Reverse retornou erro: %v", err)
+		}
+		if result.Logradouro != "Avenida Paulista" || result.Bairro != "Bela Vista" || result.Fonte != models.ReverseSourceGazetteer {
+			t.Errorf("Esperava a Avenida Paulista do dicionário, mas recebeu %+v", result)
+		}
+
+		// Sem rua conhecida no dicionário, usa a entrega cadastrada mais próxima
+		delivery := newDelivery("São Paulo", 1)
+		delivery.Logradouro, delivery.Bairro, delivery.Latitude, delivery.Longitude = "Rua dos Pinheiros", "Pinheiros", -23.5702, -46.7003
+		if _, err := deliveryService.Create(delivery, models.Cliente{Nome: "João Silva", CPF: "529.982.247-25"}); err != nil {
+			t.Fatalf("Create retornou erro: %v", err)
+		}
+		result, err = service.Reverse(-23.5700, -46.7000)
+		if err != nil || result.Logradouro != "Rua dos Pinheiros" || result.Fonte != models.ReverseSourceDelivery {
+			t.Errorf("Esperava a Rua dos Pinheiros da entrega, mas recebeu %+v (erro: %v)", result, err)
+		}
+
+		// Longe de ruas e entregas, retorna apenas o bairro
+		result, err = service.Reverse(-23.5660, -46.6940)
+		if err != nil || result.Logradouro != "" || result.Bairro != "Pinheiros" || result.Precisao != geocoding.PrecisaoBairro {
+			t.Errorf("Esperava apenas o bairro Pinheiros, mas recebeu %+v (erro: %v)", result, err)
+		}
+
+		// Casos de erro: ponto no oceano e coordenadas inválidas
+		if _, err := service.Reverse(-30, -20); !errors.Is(err, services.ErrLocalNaoEncontrado) {
+			t.Errorf("Esperava ErrLocalNaoEncontrado, mas recebeu %v", err)
+		}
+		if _, err := service.Reverse(100, 0); !errors.Is(err, services.ErrBuscaInvalida) {
+			t.Errorf("Esperava ErrBuscaInvalida, mas recebeu %v", err)
+		}
+	})
+}
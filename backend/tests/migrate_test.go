@@ -0,0 +1,66 @@
+package tests
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"meu-projeto/backend/database"
+)
+
+func TestMigrations(t *testing.T) {
+	db, err := database.OpenSQLite(filepath.Join(t.TempDir(), "migrate.db"))
+	if err != nil {
+		t.Fatalf("Erro ao abrir o banco SQLite: %v", err)
+	}
+	defer db.Close()
+
+	migrations, err := database.LoadMigrations("sqlite")
+	if err != nil {
+		t.Fatalf("Erro ao carregar as migrações: %v", err)
+	}
+
+	// Aplica todas as migrações; uma segunda execução não deve aplicar nada
+	count, err := database.MigrateUp(db, "sqlite")
+	if err != nil || count != len(migrations) {
+		t.Fatalf("Esperado aplicar %d migrações, aplicou %d (erro: %v)", len(migrations), count, err)
+	}
+	if count, err = database.MigrateUp(db, "sqlite"); err != nil || count != 0 {
+		t.Fatalf("Esperado nenhuma migração pendente, aplicou %d (erro: %v)", count, err)
+	}
+
+	// Desfaz a última migração e verifica o status
+	undone, err := database.MigrateDown(db, "sqlite")
+	if err != nil || undone == nil || undone.Version != migrations[len(migrations)-1].Version {
+		t.Fatalf("Esperado desfazer a migração %d, obteve %v (erro: %v)", migrations[len(migrations)-1].Version, undone, err)
+	}
+	states, err := database.MigrationStatus(db, "sqlite")
+	if err != nil {
+		t.Fatalf("Erro ao consultar o status: %v", err)
+	}
+	if states[len(states)-1].AppliedAt != nil || states[0].AppliedAt == nil {
+		t.Errorf("Status inesperado após desfazer a última migração")
+	}
+
+	// Desfaz todas as migrações e aplica novamente
+	for {
+		undone, err := database.MigrateDown(db, "sqlite")
+		if err != nil {
+			t.Fatalf("Erro ao desfazer as migrações: %v", err)
+		}
+		if undone == nil {
+			break
+		}
+	}
+	if count, err = database.MigrateUp(db, "sqlite"); err != nil || count != len(migrations) {
+		t.Fatalf("Esperado reaplicar %d migrações, aplicou %d (erro: %v)", len(migrations), count, err)
+	}
+
+	// Um banco com uma migração desconhecida está à frente da aplicação
+	if _, err := db.Exec("INSERT INTO schema_migrations (version, name) VALUES (9999, 'futura')"); err != nil {
+		t.Fatalf("Erro ao registrar a migração futura: %v", err)
+	}
+	if _, err := database.MigrateUp(db, "sqlite"); !errors.Is(err, database.ErrSchemaAhead) {
+		t.Errorf("Esperado ErrSchemaAhead, obteve %v", err)
+	}
+}
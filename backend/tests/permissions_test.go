@@ -0,0 +1,210 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"meu-projeto/backend/apierror"
+	"meu-projeto/backend/auth"
+	"meu-projeto/backend/controllers"
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/services"
+)
+
+// withPrincipal retorna a requisição autenticada com o usuário informado.
+func withPrincipal(req *http.Request, principal auth.Principal) *http.Request {
+	return req.WithContext(auth.WithPrincipal(req.Context(), &principal))
+}
+
+// TestPermissionMatrix testa a matriz de permissões dos papéis.
+func TestPermissionMatrix(t *testing.T) {
+	tests := []struct {
+		role       string
+		permission string
+		allowed    bool
+	}{
+		{auth.RoleAdmin, auth.PermClientsDelete, true},
+		{auth.RoleAdmin, auth.PermUsersManage, true},
+		{auth.RoleDispatcher, auth.PermClientsWrite, true},
+		{auth.RoleDispatcher, auth.PermDeliveriesDelete, true},
+		{auth.RoleDispatcher, auth.PermRoutesPlan, true},
+		{auth.RoleDispatcher, auth.PermClientsDelete, false},
+		{auth.RoleDispatcher, auth.PermUsersManage, false},
+		{auth.RoleDispatcher, auth.PermAuditRead, false},
+		{auth.RoleAdmin, auth.PermAuditRead, true},
+		{auth.RoleDriver, auth.PermDeliveriesRead, true},
+		{auth.RoleDriver, auth.PermDeliveriesStatus, true},
+		{auth.RoleDriver, auth.PermDeliveriesWrite, false},
+		{auth.RoleDriver, auth.PermClientsRead, false},
+		{auth.RoleViewer, auth.PermFleetRead, true},
+		{auth.RoleViewer, auth.PermDeliveriesStatus, false},
+		{"desconhecido", auth.PermDeliveriesRead, false},
+	}
+	for _, tt := range tests {
+		if allowed := auth.Can(tt.role, tt.permission); allowed != tt.allowed {
+			t.Errorf("Can(%q, %q): esperava %v, mas recebeu %v", tt.role, tt.permission, tt.allowed, allowed)
+		}
+	}
+}
+
+// TestAllowPermission testa o middleware de permissões: 403 com a permissão que faltou na mensagem e a
+// requisição liberada para quem tem a permissão.
+func TestAllowPermission(t *testing.T) {
+	controller := &controllers.AuthController{}
+	called := false
+	handler := controller.Allow(auth.PermClientsDelete, func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	req := withPrincipal(httptest.NewRequest(http.MethodDelete, "/clients/1?lang=en", nil), auth.Principal{UserID: 2, Role: auth.RoleDispatcher})
+	rr, body := serveAPI(t, handler, req)
+	if rr.Code != http.StatusForbidden || body.Code != apierror.CodeForbidden || called {
+		t.Fatalf("Esperava 403/forbidden, mas recebeu %d %+v", rr.Code, body)
+	}
+	if body.Message != "Permission 'clients:delete' required" || !strings.Contains(body.Detail, "dispatcher") {
+		t.Errorf("Esperava a permissão e o papel na resposta, mas recebeu %+v", body)
+	}
+
+	req = withPrincipal(httptest.NewRequest(http.MethodDelete, "/clients/1", nil), auth.Principal{UserID: 1, Role: auth.RoleAdmin})
+	rr = httptest.NewRecorder()
+	handler(rr, req)
+	if rr.Code != http.StatusNoContent || !called {
+		t.Errorf("Esperava a requisição liberada para o administrador, mas recebeu %d", rr.Code)
+	}
+}
+
+// TestCreateUserRoles testa a validação do papel e do motorista vinculado no cadastro de usuários.
+func TestCreateUserRoles(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		service := &services.AuthService{Users: stores.Users, Tokens: newTokens(nil)}
+		drivers := &services.DriverService{Repository: stores.Drivers}
+		maria := newDriver("Maria Souza", "529.982.247-25", "12345678900")
+		if err := drivers.Create(&maria); err != nil {
+			t.Fatalf("Erro ao cadastrar o motorista: %v", err)
+		}
+
+		viewer := models.Usuario{Nome: "Ana", Email: "ana@example.com", Senha: "senha-secreta"}
+		if err := service.CreateUser(&viewer); err != nil || viewer.Papel != auth.RoleViewer {
+			t.Errorf("Esperava o papel viewer por padrão, mas recebeu %q (erro: %v)", viewer.Papel, err)
+		}
+		driver := models.Usuario{Nome: "Maria", Email: "maria@example.com", Senha: "senha-secreta", Papel: "Driver", MotoristaID: &maria.ID}
+		if err := service.CreateUser(&driver); err != nil || driver.Papel != auth.RoleDriver {
+			t.Fatalf("Erro ao cadastrar o motorista: %v", err)
+		}
+		tokens, _ := service.Login("maria@example.com", "senha-secreta")
+		if principal, err := service.Authenticate(tokens.AccessToken); err != nil || principal.Role != auth.RoleDriver || principal.MotoristaID != maria.ID {
+			t.Errorf("Esperava o papel e o motorista no token, mas recebeu %+v (erro: %v)", principal, err)
+		}
+
+		missing := 999
+		invalid := []struct {
+			user  models.Usuario
+			field string
+			code  string
+		}{
+			{models.Usuario{Papel: "gerente"}, "papel", "invalid_value"},
+			{models.Usuario{Papel: auth.RoleDriver}, "motorista_id", "required"},
+			{models.Usuario{Papel: auth.RoleViewer, MotoristaID: &maria.ID}, "motorista_id", "invalid_value"},
+			{models.Usuario{Papel: auth.RoleDriver, MotoristaID: &missing}, "motorista_id", "invalid_value"},
+		}
+		for i, tt := range invalid {
+			tt.user.Nome, tt.user.Email, tt.user.Senha = "Usuário", fmt.Sprintf("usuario%d@example.com", i), "senha-secreta"
+			if err := service.CreateUser(&tt.user); !hasFieldError(err, tt.field, tt.code) {
+				t.Errorf("Papel %q: esperava o erro %s em %s, mas recebeu %v", tt.user.Papel, tt.code, tt.field, err)
+			}
+		}
+	})
+}
+
+// TestDriverScope testa que os motoristas só acessam as entregas atribuídas a eles, e que as entregas de
+// outros motoristas são respondidas como inexistentes.
+func TestDriverScope(t *testing.T) {
+	stores := repositories.NewMemoryStores()
+	deliveryService, eventService, _ := newServices(stores)
+	drivers := &services.DriverService{Repository: stores.Drivers, Deliveries: deliveryService}
+	deliveryController := &controllers.DeliveryController{Service: deliveryService}
+	eventController := &controllers.TrackingEventController{Service: eventService}
+
+	maria := newDriver("Maria Souza", "529.982.247-25", "12345678900")
+	jose := newDriver("José Lima", "111.444.777-35", "98765432100")
+	drivers.Create(&maria)
+	drivers.Create(&jose)
+	var ids []int
+	for _, driverID := range []int{maria.ID, jose.ID} {
+		id, err := deliveryService.Create(newDelivery("São Paulo", 2.5), models.Cliente{Nome: "João Silva", CPF: "123.456.789-09"})
+		if err != nil {
+			t.Fatalf("Erro ao cadastrar a entrega: %v", err)
+		}
+		if _, err := drivers.Assign(driverID, int(id)); err != nil {
+			t.Fatalf("Erro ao atribuir a entrega: %v", err)
+		}
+		ids = append(ids, int(id))
+	}
+	own, other := ids[0], ids[1]
+	principal := auth.Principal{UserID: 5, Email: "maria@example.com", Role: auth.RoleDriver, MotoristaID: maria.ID}
+
+	// A listagem ignora o filtro de outro motorista e retorna apenas as entregas da Maria
+	rr := httptest.NewRecorder()
+	deliveryController.List(rr, withPrincipal(httptest.NewRequest(http.MethodGet, fmt.Sprintf("/deliveries?motorista_id=%d", jose.ID), nil), principal))
+	var page models.Page[models.Delivery]
+	json.NewDecoder(rr.Body).Decode(&page)
+	if rr.Code != http.StatusOK || page.Total != 1 || page.Items[0].ID != own {
+		t.Errorf("Esperava apenas a entrega %d na listagem, mas recebeu %d %+v", own, rr.Code, page)
+	}
+
+	// A própria entrega é acessível; a do José é respondida como inexistente em todas as rotas
+	rr = httptest.NewRecorder()
+	deliveryController.FindByID(rr, withPrincipal(httptest.NewRequest(http.MethodGet, fmt.Sprintf("/deliveries/id/%d", own), nil), principal))
+	if rr.Code != http.StatusOK {
+		t.Errorf("Esperava 200 na própria entrega, mas recebeu %d", rr.Code)
+	}
+	requests := []struct {
+		handler http.HandlerFunc
+		req     *http.Request
+	}{
+		{deliveryController.FindByID, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/deliveries/id/%d", other), nil)},
+		{deliveryController.UpdateStatus, httptest.NewRequest(http.MethodPost, fmt.Sprintf("/deliveries/%d/status", other), strings.NewReader(`{"status":"coletada"}`))},
+		{eventController.List, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/deliveries/%d/events", other), nil)},
+		{eventController.Create, httptest.NewRequest(http.MethodPost, fmt.Sprintf("/deliveries/%d/events", other), strings.NewReader(`{"observacao":"Saiu"}`))},
+	}
+	for _, request := range requests {
+		rr, body := serveAPI(t, request.handler, withPrincipal(request.req, principal))
+		if rr.Code != http.StatusNotFound || body.Code != apierror.CodeNotFound {
+			t.Errorf("%s %s: esperava 404, mas recebeu %d %+v", request.req.Method, request.req.URL.Path, rr.Code, body)
+		}
+	}
+	if delivery, _ := deliveryService.FindByID(other); delivery.Status != models.StatusPendente {
+		t.Errorf("A entrega de outro motorista não deveria mudar de status, mas está %s", delivery.Status)
+	}
+
+	// O responsável pelos eventos é o usuário autenticado, e não o informado no corpo da requisição
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/deliveries/%d/events", own), strings.NewReader(`{"observacao":"Saiu","responsavel":"Outra pessoa"}`))
+	rr = httptest.NewRecorder()
+	eventController.Create(rr, withPrincipal(req, principal))
+	var event models.TrackingEvent
+	json.NewDecoder(rr.Body).Decode(&event)
+	if rr.Code != http.StatusCreated || event.Responsavel != "maria@example.com" {
+		t.Errorf("Esperava o evento em nome da motorista, mas recebeu %d %+v", rr.Code, event)
+	}
+	req = httptest.NewRequest(http.MethodPost, fmt.Sprintf("/deliveries/%d/status", own), strings.NewReader(`{"status":"coletada","responsavel":"Outra pessoa"}`))
+	rr = httptest.NewRecorder()
+	deliveryController.UpdateStatus(rr, withPrincipal(req, principal))
+	var updated models.Delivery
+	json.NewDecoder(rr.Body).Decode(&updated)
+	if rr.Code != http.StatusOK || updated.UltimoEvento == nil || updated.UltimoEvento.Responsavel != "maria@example.com" {
+		t.Errorf("Esperava a mudança de status em nome da motorista, mas recebeu %d %+v", rr.Code, updated.UltimoEvento)
+	}
+
+	// Um operador acessa todas as entregas
+	rr = httptest.NewRecorder()
+	deliveryController.FindByID(rr, withPrincipal(httptest.NewRequest(http.MethodGet, fmt.Sprintf("/deliveries/id/%d", other), nil), auth.Principal{UserID: 1, Role: auth.RoleDispatcher}))
+	if rr.Code != http.StatusOK {
+		t.Errorf("Esperava 200 para o operador, mas recebeu %d", rr.Code)
+	}
+}
@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"meu-projeto/backend/apierror"
+	"meu-projeto/backend/controllers"
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/services"
+)
+
+// TestRepositoryErrors testa se os repositórios retornam os mesmos erros no MySQL/SQLite e em memória:
+// ErrNotFound para IDs inexistentes, ErrConflict para duplicidades e ErrForeignKey para referências inválidas.
+func TestRepositoryErrors(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		cliente := models.Cliente{Nome: "Ana Souza", CPF: "529.982.247-25"}
+		if err := stores.Clients.Create(&cliente); err != nil {
+			t.Fatalf("Erro ao cadastrar o cliente: %v", err)
+		}
+		delivery := newDelivery("São Paulo", 2)
+		delivery.ClienteID = cliente.ID
+		delivery.Status = models.StatusPendente
+		id, err := stores.Deliveries.Create(delivery)
+		if err != nil {
+			t.Fatalf("Erro ao cadastrar a entrega: %v", err)
+		}
+
+		t.Run("Não encontrado", func(t *testing.T) {
+			if _, err := stores.Clients.FindByID(999); !errors.Is(err, repositories.ErrNotFound) {
+				t.Errorf("FindByID do cliente: esperava ErrNotFound, mas recebeu %v", err)
+			}
+			missing := models.Cliente{ID: 999, Nome: "Ninguém", CPF: "123.456.789-09"}
+			if err := stores.Clients.Update(&missing); !errors.Is(err, repositories.ErrNotFound) {
+				t.Errorf("Update do cliente: esperava ErrNotFound, mas recebeu %v", err)
+			}
+			if err := stores.Clients.Delete(999); !errors.Is(err, repositories.ErrNotFound) {
+				t.Errorf("Delete do cliente: esperava ErrNotFound, mas recebeu %v", err)
+			}
+			if err := stores.Deliveries.Update(999, delivery); !errors.Is(err, repositories.ErrNotFound) {
+				t.Errorf("Update da entrega: esperava ErrNotFound, mas recebeu %v", err)
+			}
+			if err := stores.Deliveries.UpdateStatus(999, models.StatusColetada, models.StatusEmRota); !errors.Is(err, repositories.ErrNotFound) {
+				t.Errorf("UpdateStatus da entrega: esperava ErrNotFound, mas recebeu %v", err)
+			}
+			if err := stores.Deliveries.Delete(999); !errors.Is(err, repositories.ErrNotFound) {
+				t.Errorf("Delete da entrega: esperava ErrNotFound, mas recebeu %v", err)
+			}
+			if err := stores.Drivers.Delete(999); !errors.Is(err, repositories.ErrNotFound) {
+				t.Errorf("Delete do motorista: esperava ErrNotFound, mas recebeu %v", err)
+			}
+
+			// Atualizar com os mesmos valores não é confundido com um registro inexistente
+			if err := stores.Clients.Update(&cliente); err != nil {
+				t.Errorf("Update sem alterações: esperava sucesso, mas recebeu %v", err)
+			}
+		})
+
+		t.Run("Duplicado", func(t *testing.T) {
+			duplicate := models.Cliente{Nome: "Outra Ana", CPF: cliente.CPF}
+			if err := stores.Clients.Create(&duplicate); !errors.Is(err, repositories.ErrConflict) {
+				t.Errorf("Esperava ErrConflict para o CPF repetido, mas recebeu %v", err)
+			}
+		})
+
+		t.Run("Chave estrangeira", func(t *testing.T) {
+			orphan := delivery
+			orphan.ClienteID = 999
+			if err := stores.Deliveries.Update(int(id), orphan); !errors.Is(err, repositories.ErrForeignKey) {
+				t.Errorf("Esperava ErrForeignKey para o cliente inexistente, mas recebeu %v", err)
+			}
+		})
+	})
+}
+
+// TestRepositoryErrorResponses testa os status HTTP dos erros dos repositórios: 404 ao alterar ou remover um
+// registro inexistente e 409 ao cadastrar um CPF repetido.
+func TestRepositoryErrorResponses(t *testing.T) {
+	stores := repositories.NewMemoryStores()
+	clients := &controllers.ClientController{Service: &services.ClientService{Repository: stores.Clients}}
+	deliveries := newDeliveryController()
+
+	payload := `{"nome":"Ana Souza","cpf":"529.982.247-25"}`
+	if rr, _ := serveAPI(t, clients.Create, httptest.NewRequest(http.MethodPost, "/clients", strings.NewReader(payload))); rr.Code != http.StatusCreated {
+		t.Fatalf("Esperava 201 no primeiro cadastro, mas recebeu %d", rr.Code)
+	}
+
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		req     *http.Request
+		status  int
+		message string
+	}{
+		{"CPF repetido", clients.Create, httptest.NewRequest(http.MethodPost, "/clients", strings.NewReader(payload)), http.StatusConflict, "CPF já cadastrado"},
+		{"Atualizar cliente inexistente", clients.Update, httptest.NewRequest(http.MethodPut, "/clients", strings.NewReader(`{"id":99,"nome":"Ana Souza","cpf":"123.456.789-09"}`)), http.StatusNotFound, "Cliente não encontrado"},
+		{"Remover cliente inexistente", clients.Delete, httptest.NewRequest(http.MethodDelete, "/clients/99", nil), http.StatusNotFound, "Cliente não encontrado"},
+		{"Remover entrega inexistente", deliveries.Delete, httptest.NewRequest(http.MethodDelete, "/deliveries/99", nil), http.StatusNotFound, "Entrega não encontrada"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr, body := serveAPI(t, tt.handler, tt.req)
+			if rr.Code != tt.status || body.Message != tt.message {
+				t.Errorf("Esperava %d %q, mas recebeu %d %+v", tt.status, tt.message, rr.Code, body)
+			}
+			if tt.status == http.StatusConflict && body.Code != apierror.CodeConflict {
+				t.Errorf("Esperava o código conflict, mas recebeu %s", body.Code)
+			}
+		})
+	}
+}
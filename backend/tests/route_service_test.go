@@ -0,0 +1,123 @@
+package tests
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/services"
+	"meu-projeto/backend/utils"
+)
+
+// TestHaversine testa o cálculo de distância entre duas coordenadas.
+func TestHaversine(t *testing.T) {
+	// Distância aproximada entre o centro de São Paulo e o centro do Rio de Janeiro
+	distance := utils.Haversine(-23.5505, -46.6333, -22.9068, -43.1729)
+	if distance < 355 || distance > 362 {
+		t.Errorf("Haversine(São Paulo, Rio de Janeiro) = %.1f km; esperava cerca de 358 km", distance)
+	}
+	if utils.Haversine(-23.5, -46.6, -23.5, -46.6) != 0 {
+		t.Errorf("Esperava distância zero entre pontos iguais")
+	}
+}
+
+// TestOptimizeRoute testa se as paradas alinhadas são visitadas em sequência, da mais próxima à mais distante.
+func TestOptimizeRoute(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		deliveryService, _, _ := newServices(stores)
+		routeService := &services.RouteService{Deliveries: stores.Deliveries}
+
+		// Cria entregas ao longo da mesma latitude, fora de ordem, a leste do depósito
+		longitudes := []float64{-46.50, -46.62, -46.56, -46.44, -46.59}
+		ids := []int{}
+		for _, lng := range longitudes {
+			delivery := newDelivery("São Paulo", 1)
+			delivery.Latitude, delivery.Longitude = -23.55, lng
+			id, err := deliveryService.Create(delivery, models.Cliente{Nome: "João Silva", CPF: "529.982.247-25"})
+			if err != nil {
+				t.Fatalf("Create retornou erro: %v", err)
+			}
+			ids = append(ids, int(id))
+		}
+
+		lat, lng := -23.55, -46.65
+		route, err := routeService.Optimize(models.RouteRequest{DepositoLatitude: &lat, DepositoLongitude: &lng, EntregaIDs: ids, RetornarAoDeposito: true})
+		if err != nil {
+			t.Fatalf("Optimize retornou erro: %v", err)
+		}
+
+		// A ordem esperada é da longitude mais a oeste para a mais a leste
+		expected := []int{ids[1], ids[4], ids[2], ids[0], ids[3]}
+		if len(route.Paradas) != len(expected) {
+			t.Fatalf("Esperava %d paradas, mas recebeu %d", len(expected), len(route.Paradas))
+		}
+		for i, stop := range route.Paradas {
+			if stop.EntregaID != expected[i] || stop.Ordem != i+1 {
+				t.Errorf("Parada %d: esperava a entrega %d, mas recebeu %d", i+1, expected[i], stop.EntregaID)
+			}
+		}
+
+		// Ida e volta em linha reta: o total é o dobro da distância até a parada mais distante
+		farthest := utils.Haversine(lat, lng, -23.55, -46.44)
+		if math.Abs(route.DistanciaTotalKm-2*farthest) > 0.01 {
+			t.Errorf("Esperava distância total de %.3f km, mas recebeu %.3f km", 2*farthest, route.DistanciaTotalKm)
+		}
+		if math.Abs(route.RetornoKm-farthest) > 0.01 {
+			t.Errorf("Esperava retorno de %.3f km, mas recebeu %.3f km", farthest, route.RetornoKm)
+		}
+	})
+}
+
+// TestOptimizeRouteByFilter testa a seleção das entregas por cidade, ignorando as que já têm status final.
+func TestOptimizeRouteByFilter(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		deliveryService, _, _ := newServices(stores)
+		routeService := &services.RouteService{Deliveries: stores.Deliveries}
+		cliente := models.Cliente{Nome: "João Silva", CPF: "529.982.247-25"}
+
+		deliveryService.Create(newDelivery("Campinas", 1), cliente)
+		deliveryService.Create(newDelivery("Campinas", 1), cliente)
+		cancelada, _ := deliveryService.Create(newDelivery("Campinas", 1), cliente)
+		deliveryService.Create(newDelivery("Santos", 1), cliente)
+		deliveryService.UpdateStatus(int(cancelada), models.TrackingEvent{Status: models.StatusCancelada})
+
+		lat, lng := -22.9, -47.06
+		route, err := routeService.Optimize(models.RouteRequest{DepositoLatitude: &lat, DepositoLongitude: &lng, Cidade: "Campinas"})
+		if err != nil {
+			t.Fatalf("Optimize retornou erro: %v", err)
+		}
+		if len(route.Paradas) != 2 {
+			t.Errorf("Esperava 2 paradas em Campinas, mas recebeu %d", len(route.Paradas))
+		}
+
+		// Caso de erro: depósito não informado
+		if _, err := routeService.Optimize(models.RouteRequest{Cidade: "Campinas"}); !errors.Is(err, services.ErrRotaInvalida) {
+			t.Errorf("Esperava ErrRotaInvalida sem o depósito, mas recebeu %v", err)
+		}
+
+		// Caso de erro: entrega inexistente
+		if _, err := routeService.Optimize(models.RouteRequest{DepositoLatitude: &lat, DepositoLongitude: &lng, EntregaIDs: []int{999}}); !errors.Is(err, services.ErrEntregaNaoEncontrada) {
+			t.Errorf("Esperava ErrEntregaNaoEncontrada, mas recebeu %v", err)
+		}
+	})
+}
+
+// TestBoundingBox testa se o retângulo de pré-filtro contém os pontos na borda do círculo.
+func TestBoundingBox(t *testing.T) {
+	lat, lng, radius := -23.5505, -46.6333, 25.0
+	minLat, maxLat, minLng, maxLng := utils.BoundingBox(lat, lng, radius)
+
+	// Pontos a exatamente 25 km ao norte e a leste devem estar dentro do retângulo
+	north := lat + radius/(utils.EarthRadiusKm*math.Pi/180)
+	if north > maxLat+1e-9 || lat-(north-lat) < minLat-1e-9 {
+		t.Errorf("Latitudes do retângulo (%f, %f) não contêm o raio de %.0f km", minLat, maxLat, radius)
+	}
+	if distance := utils.Haversine(lat, lng, lat, maxLng); distance < radius-0.01 {
+		t.Errorf("O retângulo alcança apenas %.3f km a leste; esperava ao menos %.0f km", distance, radius)
+	}
+	if minLng >= lng || maxLng <= lng {
+		t.Errorf("Longitudes do retângulo (%f, %f) não contêm o ponto central", minLng, maxLng)
+	}
+}
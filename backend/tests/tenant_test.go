@@ -0,0 +1,186 @@
+package tests
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"meu-projeto/backend/apierror"
+	"meu-projeto/backend/auth"
+	"meu-projeto/backend/controllers"
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/services"
+)
+
+// TestTenantIsolation testa o isolamento dos clientes e das entregas entre os embarcadores: os registros de
+// outro embarcador são tratados como inexistentes, e o CPF é único em cada embarcador.
+func TestTenantIsolation(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		shipper := models.Embarcador{Nome: "Loja Azul"}
+		if err := (&services.ShipperService{Repository: stores.Shippers}).Create(&shipper); err != nil {
+			t.Fatalf("Erro ao cadastrar o embarcador: %v", err)
+		}
+		deliveries, _, clients := newServices(stores)
+		defaultDeliveries, otherDeliveries := deliveries.ForTenant(models.EmbarcadorPadrao), deliveries.ForTenant(shipper.ID)
+		defaultClients, otherClients := clients.ForTenant(models.EmbarcadorPadrao), clients.ForTenant(shipper.ID)
+
+		// O mesmo CPF pode ser cadastrado em cada embarcador, mas não duas vezes no mesmo
+		client := models.Cliente{Nome: "João Silva", CPF: "529.982.247-25"}
+		if err := defaultClients.Create(&client); err != nil {
+			t.Fatalf("Erro ao cadastrar o cliente: %v", err)
+		}
+		other := models.Cliente{Nome: "João Silva", CPF: "529.982.247-25"}
+		if err := otherClients.Create(&other); err != nil || other.EmbarcadorID != shipper.ID {
+			t.Fatalf("Esperava o mesmo CPF aceito em outro embarcador, mas recebeu %+v (erro: %v)", other, err)
+		}
+		duplicate := models.Cliente{Nome: "Outro João", CPF: "529.982.247-25"}
+		if err := otherClients.Create(&duplicate); !errors.Is(err, services.ErrClienteDuplicado) {
+			t.Errorf("Esperava ErrClienteDuplicado no mesmo embarcador, mas recebeu %v", err)
+		}
+
+		// Os clientes de outro embarcador não são encontrados, listados, atualizados nem removidos
+		if found, err := otherClients.FindByID(client.ID); !errors.Is(err, services.ErrClienteNaoEncontrado) {
+			t.Errorf("Esperava ErrClienteNaoEncontrado ao buscar o cliente de outro embarcador, mas recebeu %+v (erro: %v)", found, err)
+		}
+		if page, err := otherClients.List(models.ClientFilter{Page: 1, PageSize: 10}); err != nil || page.Total != 1 || page.Items[0].ID != other.ID {
+			t.Errorf("Esperava apenas o cliente do embarcador na listagem, mas recebeu %+v (erro: %v)", page, err)
+		}
+		if err := otherClients.Update(&models.Cliente{ID: client.ID, Nome: "Invasor", CPF: "123.456.789-09"}); !errors.Is(err, services.ErrClienteNaoEncontrado) {
+			t.Errorf("Esperava ErrClienteNaoEncontrado ao atualizar o cliente de outro embarcador, mas recebeu %v", err)
+		}
+		if err := otherClients.Delete(client.ID); !errors.Is(err, services.ErrClienteNaoEncontrado) {
+			t.Errorf("Esperava ErrClienteNaoEncontrado ao remover o cliente de outro embarcador, mas recebeu %v", err)
+		}
+
+		// A entrega usa o cliente do próprio embarcador com o mesmo CPF e pertence ao embarcador dele
+		id, err := otherDeliveries.Create(newDelivery("São Paulo", 2), models.Cliente{Nome: "João Silva", CPF: "529.982.247-25"})
+		if err != nil {
+			t.Fatalf("Erro ao cadastrar a entrega: %v", err)
+		}
+		delivery, err := otherDeliveries.FindByID(int(id))
+		if err != nil || delivery == nil || delivery.ClienteID != other.ID || delivery.EmbarcadorID != shipper.ID {
+			t.Fatalf("Esperava a entrega do cliente %d no embarcador %d, mas recebeu %+v (erro: %v)", other.ID, shipper.ID, delivery, err)
+		}
+
+		// A entrega não é vista nem alterada pelo outro embarcador
+		if found, err := defaultDeliveries.FindByID(int(id)); err != nil || found != nil {
+			t.Errorf("Esperava a entrega de outro embarcador não encontrada, mas recebeu %+v (erro: %v)", found, err)
+		}
+		if page, err := defaultDeliveries.List(models.DeliveryFilter{Page: 1, PageSize: 10}); err != nil || page.Total != 0 {
+			t.Errorf("Esperava nenhuma entrega na listagem do outro embarcador, mas recebeu %+v (erro: %v)", page, err)
+		}
+		if found, err := defaultDeliveries.FindByCity("São Paulo"); err != nil || len(found) != 0 {
+			t.Errorf("Esperava nenhuma entrega na busca por cidade do outro embarcador, mas recebeu %+v (erro: %v)", found, err)
+		}
+		moved := newDelivery("Campinas", 3)
+		moved.ClienteID = client.ID
+		if err := defaultDeliveries.Update(int(id), moved); !errors.Is(err, services.ErrEntregaNaoEncontrada) {
+			t.Errorf("Esperava ErrEntregaNaoEncontrada ao atualizar a entrega de outro embarcador, mas recebeu %v", err)
+		}
+		if err := defaultDeliveries.Delete(int(id)); !errors.Is(err, services.ErrEntregaNaoEncontrada) {
+			t.Errorf("Esperava ErrEntregaNaoEncontrada ao remover a entrega de outro embarcador, mas recebeu %v", err)
+		}
+
+		// A entrega não pode ser associada ao cliente de outro embarcador
+		if err := otherDeliveries.Update(int(id), moved); !errors.Is(err, repositories.ErrForeignKey) {
+			t.Errorf("Esperava ErrForeignKey ao associar o cliente de outro embarcador, mas recebeu %v", err)
+		}
+
+		// Sem embarcador, o serviço vê todas as entregas (ex: o rastreamento público)
+		if found, err := deliveries.FindByID(int(id)); err != nil || found == nil {
+			t.Errorf("Esperava a entrega encontrada sem embarcador, mas recebeu %+v (erro: %v)", found, err)
+		}
+	})
+}
+
+// TestTenantControllers testa as respostas das rotas para os usuários de outros embarcadores: 404 nos
+// registros de outro embarcador e 403 no cadastro de usuários e chaves de API fora do próprio embarcador.
+func TestTenantControllers(t *testing.T) {
+	stores := repositories.NewMemoryStores()
+	shipper := models.Embarcador{Nome: "Loja Azul"}
+	shipperService := &services.ShipperService{Repository: stores.Shippers}
+	if err := shipperService.Create(&shipper); err != nil {
+		t.Fatalf("Erro ao cadastrar o embarcador: %v", err)
+	}
+	if err := shipperService.Create(&models.Embarcador{Nome: " loja azul "}); !errors.Is(err, services.ErrEmbarcadorDuplicado) {
+		t.Errorf("Esperava ErrEmbarcadorDuplicado, mas recebeu %v", err)
+	}
+
+	deliveryService, _, clientService := newServices(stores)
+	id, err := deliveryService.ForTenant(models.EmbarcadorPadrao).Create(newDelivery("São Paulo", 2), models.Cliente{Nome: "João Silva", CPF: "529.982.247-25"})
+	if err != nil {
+		t.Fatalf("Erro ao cadastrar a entrega: %v", err)
+	}
+	deliveries := &controllers.DeliveryController{Service: deliveryService}
+	clients := &controllers.ClientController{Service: clientService}
+	principal := auth.Principal{UserID: 9, Role: auth.RoleAdmin, EmbarcadorID: shipper.ID}
+
+	// Os registros de outro embarcador respondem 404, como se não existissem
+	rr, body := serveAPI(t, deliveries.FindByID, withPrincipal(httptest.NewRequest(http.MethodGet, "/deliveries/id/1", nil), principal))
+	if rr.Code != http.StatusNotFound || body.Code != apierror.CodeNotFound {
+		t.Errorf("Esperava 404 na entrega %d de outro embarcador, mas recebeu %d %+v", id, rr.Code, body)
+	}
+	rr, body = serveAPI(t, clients.FindByID, withPrincipal(httptest.NewRequest(http.MethodGet, "/clients/id/1", nil), principal))
+	if rr.Code != http.StatusNotFound || body.Code != apierror.CodeNotFound {
+		t.Errorf("Esperava 404 no cliente de outro embarcador, mas recebeu %d %+v", rr.Code, body)
+	}
+
+	// O administrador de um embarcador não cadastra usuários em outro embarcador
+	users := &controllers.AuthController{Service: &services.AuthService{Users: stores.Users, Tokens: newTokens(nil), Shippers: stores.Shippers}}
+	req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{"nome": "Ana", "email": "ana@example.com", "senha": "senha-secreta", "embarcador_id": 1}`))
+	rr, body = serveAPI(t, users.CreateUser, withPrincipal(req, principal))
+	if rr.Code != http.StatusForbidden || body.Code != apierror.CodeForbidden {
+		t.Errorf("Esperava 403 no cadastro de usuário em outro embarcador, mas recebeu %d %+v", rr.Code, body)
+	}
+
+	// Sem embarcador informado, o usuário é cadastrado no embarcador de quem faz o cadastro
+	req = httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{"nome": "Ana", "email": "ana@example.com", "senha": "senha-secreta"}`))
+	rr = httptest.NewRecorder()
+	users.CreateUser(rr, withPrincipal(req, principal))
+	if user, _ := stores.Users.FindByEmail("ana@example.com"); rr.Code != http.StatusCreated || user == nil || user.EmbarcadorID != shipper.ID {
+		t.Errorf("Esperava o usuário cadastrado no embarcador %d, mas recebeu %d %+v", shipper.ID, rr.Code, user)
+	}
+
+	// A frota e as zonas são compartilhadas e alteradas apenas pelos usuários do embarcador padrão
+	zones := &controllers.ZoneController{Service: &services.ZoneService{Repository: stores.Zones, Deliveries: deliveryService}}
+	drivers := &controllers.DriverController{Service: &services.DriverService{Repository: stores.Drivers, Deliveries: deliveryService}}
+	vehicles := &controllers.VehicleController{Service: &services.VehicleService{Repository: stores.Vehicles}}
+	zone := `{"nome": "Centro", "poligono": {"type": "Polygon", "coordinates": [[[-46.7, -23.6], [-46.6, -23.6], [-46.6, -23.5], [-46.7, -23.5], [-46.7, -23.6]]]}}`
+	shared := []struct {
+		handler http.HandlerFunc
+		req     *http.Request
+	}{
+		{zones.Create, httptest.NewRequest(http.MethodPost, "/zones", strings.NewReader(zone))},
+		{zones.Delete, httptest.NewRequest(http.MethodDelete, "/zones/1", nil)},
+		{drivers.Create, httptest.NewRequest(http.MethodPost, "/drivers", strings.NewReader(`{"nome": "Maria Souza", "cpf": "529.982.247-25", "cnh_numero": "12345678900", "cnh_categoria": "B"}`))},
+		{drivers.Delete, httptest.NewRequest(http.MethodDelete, "/drivers/1", nil)},
+		{vehicles.Create, httptest.NewRequest(http.MethodPost, "/vehicles", strings.NewReader(`{"placa": "ABC1D23", "tipo": "van", "capacidade_peso": 1000}`))},
+		{vehicles.Delete, httptest.NewRequest(http.MethodDelete, "/vehicles/1", nil)},
+	}
+	for _, request := range shared {
+		rr, body := serveAPI(t, request.handler, withPrincipal(request.req, principal))
+		if rr.Code != http.StatusForbidden || body.Code != apierror.CodeForbidden {
+			t.Errorf("%s %s: esperava 403 para o usuário de outro embarcador, mas recebeu %d %+v", request.req.Method, request.req.URL.Path, rr.Code, body)
+		}
+	}
+	rr = httptest.NewRecorder()
+	zones.Create(rr, withPrincipal(httptest.NewRequest(http.MethodPost, "/zones", strings.NewReader(zone)), auth.Principal{UserID: 1, Role: auth.RoleDispatcher, EmbarcadorID: models.EmbarcadorPadrao}))
+	if rr.Code != http.StatusCreated {
+		t.Errorf("Esperava a zona cadastrada pelo embarcador padrão, mas recebeu %d %s", rr.Code, rr.Body.String())
+	}
+
+	// Apenas os usuários do embarcador padrão gerenciam os embarcadores
+	shippers := &controllers.ShipperController{Service: shipperService}
+	rr, _ = serveAPI(t, shippers.List, withPrincipal(httptest.NewRequest(http.MethodGet, "/shippers", nil), principal))
+	if rr.Code != http.StatusForbidden {
+		t.Errorf("Esperava 403 na listagem dos embarcadores, mas recebeu %d", rr.Code)
+	}
+	rr = httptest.NewRecorder()
+	shippers.List(rr, withPrincipal(httptest.NewRequest(http.MethodGet, "/shippers", nil), auth.Principal{UserID: 1, Role: auth.RoleAdmin, EmbarcadorID: models.EmbarcadorPadrao}))
+	if rr.Code != http.StatusOK || !strings.Contains(rr.Body.String(), "Loja Azul") {
+		t.Errorf("Esperava os embarcadores listados, mas recebeu %d %s", rr.Code, rr.Body.String())
+	}
+}
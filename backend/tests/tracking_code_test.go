@@ -0,0 +1,62 @@
+package tests
+
+import (
+	"meu-projeto/backend/utils"
+	"testing"
+)
+
+// TestGenerateTrackingCode testa se os códigos gerados são válidos e não se repetem.
+func TestGenerateTrackingCode(t *testing.T) {
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		code, err := utils.GenerateTrackingCode()
+		if err != nil {
+			t.Fatalf("GenerateTrackingCode() retornou erro: %v", err)
+		}
+
+		// Verifica se o código gerado passa na validação
+		if !utils.ValidateTrackingCode(code) {
+			t.Errorf("GenerateTrackingCode() gerou um código inválido: %s", code)
+		}
+
+		// Verifica o tamanho: 12 caracteres aleatórios e o verificador entre o prefixo e o sufixo
+		if len(code) != 17 {
+			t.Errorf("GenerateTrackingCode() gerou um código com %d caracteres: %s", len(code), code)
+		}
+
+		// Verifica se o código não se repete
+		if seen[code] {
+			t.Errorf("GenerateTrackingCode() gerou um código repetido: %s", code)
+		}
+		seen[code] = true
+	}
+}
+
+// TestValidateTrackingCode testa a função ValidateTrackingCode do pacote utils.
+func TestValidateTrackingCode(t *testing.T) {
+	// Define uma lista de casos de teste
+	tests := []struct {
+		code     string // Código a ser testado
+		expected bool   // Resultado esperado (true para válido, false para inválido)
+	}{
+		{"ENF85JJ3CX4PER8BR", true},  // Código válido
+		{"ENF85JJ3CX4PER9BR", false}, // Caractere verificador incorreto
+		{"EN85FJJ3CX4PER8BR", false}, // Caracteres vizinhos invertidos
+		{"XXF85JJ3CX4PER8BR", false}, // Prefixo incorreto
+		{"ENF85JJ3CX4PEO8BR", false}, // Caractere fora do alfabeto (O)
+		{"ENF85JJ3CX4PE8BR", false},  // Tamanho incorreto
+		{"enf85jj3cx4per8br", false}, // Letras minúsculas (o serviço converte antes de validar)
+		{"EN473124829BR", true},      // Código no formato antigo (S10)
+		{"EN473124828BR", false},     // Formato antigo com dígito verificador incorreto
+		{"EN4731248A9BR", false},     // Formato antigo com caracteres não numéricos
+		{"", false},                  // Código vazio
+	}
+
+	// Itera sobre os casos de teste
+	for _, test := range tests {
+		result := utils.ValidateTrackingCode(test.code)
+		if result != test.expected {
+			t.Errorf("ValidateTrackingCode(%s) = %v; esperava %v", test.code, result, test.expected)
+		}
+	}
+}
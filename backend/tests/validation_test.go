@@ -0,0 +1,102 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/services"
+	"meu-projeto/backend/validation"
+)
+
+// hasFieldError verifica se err contém um erro de validação do campo com o código informado.
+func hasFieldError(err error, field, code string) bool {
+	errs, ok := validation.FromError(err)
+	return ok && errs.Has(field, code)
+}
+
+// TestValidator testa o acúmulo de erros, os prefixos dos campos e a extração dos erros encapsulados.
+func TestValidator(t *testing.T) {
+	v := validation.New()
+	if v.Err() != nil {
+		t.Fatalf("Esperava nenhum erro em um Validator novo")
+	}
+
+	v.Required("nome", "  ")
+	nested := v.With("delivery.")
+	nested.Positive("peso", -2)
+	nested.MaxLength("numero", "12345678901", 10)
+	v.Check(true, "ignorado", validation.CodeInvalidValue, "não deve ser registrado")
+
+	err := errors.Join(errors.New("contexto"), v.Err())
+	errs, ok := validation.FromError(err)
+	if !ok || len(errs) != 3 {
+		t.Fatalf("Esperava 3 erros de validação, mas recebeu %v", err)
+	}
+	expected := []validation.FieldError{
+		{Field: "nome", Code: validation.CodeRequired},
+		{Field: "delivery.peso", Code: validation.CodeOutOfRange},
+		{Field: "delivery.numero", Code: validation.CodeTooLong},
+	}
+	for i, fieldErr := range errs {
+		if fieldErr.Field != expected[i].Field || fieldErr.Code != expected[i].Code {
+			t.Errorf("Erro %d: esperava %s/%s, mas recebeu %+v", i, expected[i].Field, expected[i].Code, fieldErr)
+		}
+	}
+}
+
+// TestWriteEndpointsValidation testa se os serviços das operações de escrita retornam todos os campos inválidos de uma só vez.
+func TestWriteEndpointsValidation(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		deliveryService, eventService, clientService := newServices(stores)
+
+		// Cliente: nome ausente, CPF e e-mail inválidos
+		err := clientService.Create(&models.Cliente{CPF: "111.111.111-11", Email: "sem-arroba"})
+		if !errors.Is(err, services.ErrClienteInvalido) || !hasFieldError(err, "nome", validation.CodeRequired) ||
+			!hasFieldError(err, "cpf", validation.CodeInvalidFormat) || !hasFieldError(err, "email", validation.CodeInvalidFormat) {
+			t.Errorf("Esperava erros em nome, cpf e email, mas recebeu %v", err)
+		}
+		if err := clientService.Update(&models.Cliente{Nome: "Ana", CPF: "529.982.247-25"}); !hasFieldError(err, "id", validation.CodeRequired) {
+			t.Errorf("Esperava erro no id, mas recebeu %v", err)
+		}
+
+		// Veículo: placa, tipo e capacidade inválidos
+		vehicleService := &services.VehicleService{Repository: stores.Vehicles}
+		err = vehicleService.Create(&models.Vehicle{Placa: "12", Tipo: "navio", CapacidadeVolume: -1})
+		expected := map[string]string{"placa": validation.CodeInvalidFormat, "tipo": validation.CodeInvalidValue,
+			"capacidade_peso": validation.CodeRequired, "capacidade_volume": validation.CodeOutOfRange}
+		for field, code := range expected {
+			if !errors.Is(err, services.ErrVeiculoInvalido) || !hasFieldError(err, field, code) {
+				t.Errorf("Esperava erro %s em %s, mas recebeu %v", code, field, err)
+			}
+		}
+
+		// Atualização da entrega sem os campos obrigatórios
+		err = deliveryService.Update(1, models.Delivery{})
+		for _, field := range []string{"cliente_id", "peso", "endereco", "cidade"} {
+			if !hasFieldError(err, field, validation.CodeRequired) {
+				t.Errorf("Esperava erro obrigatório em %s, mas recebeu %v", field, err)
+			}
+		}
+
+		// Evento: status desconhecido e coordenadas incompletas
+		id, err := deliveryService.Create(newDelivery("São Paulo", 1), models.Cliente{Nome: "João Silva", CPF: "529.982.247-25"})
+		if err != nil {
+			t.Fatalf("Create retornou erro: %v", err)
+		}
+		lat := -23.5
+		err = eventService.Create(int(id), &models.TrackingEvent{Status: "perdida", Latitude: &lat})
+		if !hasFieldError(err, "status", validation.CodeInvalidValue) || !hasFieldError(err, "latitude", validation.CodeRequired) {
+			t.Errorf("Esperava erros em status e latitude, mas recebeu %v", err)
+		}
+
+		// Rota: depósito ausente e data em formato inválido
+		routeService := &services.RouteService{Deliveries: stores.Deliveries, Vehicles: stores.Vehicles}
+		_, err = routeService.Optimize(models.RouteRequest{Data: "18/10/2026"})
+		if !errors.Is(err, services.ErrRotaInvalida) || !hasFieldError(err, "deposito_latitude", validation.CodeRequired) ||
+			!hasFieldError(err, "deposito_longitude", validation.CodeRequired) || !hasFieldError(err, "data", validation.CodeInvalidFormat) {
+			t.Errorf("Esperava erros no depósito e na data, mas recebeu %v", err)
+		}
+	})
+}
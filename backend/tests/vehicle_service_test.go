@@ -0,0 +1,140 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/services"
+	"meu-projeto/backend/utils"
+)
+
+// TestValidatePlate testa a validação de placas no padrão antigo e no padrão Mercosul.
+func TestValidatePlate(t *testing.T) {
+	tests := []struct {
+		placa    string // Placa a ser testada
+		expected bool   // Resultado esperado
+	}{
+		{"ABC-1234", true}, // Padrão antigo com hífen
+		{"abc1d23", true},  // Padrão Mercosul em minúsculas
+		{"AB-12345", false},
+		{"ABCD123", false},
+		{"", false},
+	}
+	for _, test := range tests {
+		if result := utils.ValidatePlate(test.placa); result != test.expected {
+			t.Errorf("ValidatePlate(%s) = %v; esperava %v", test.placa, result, test.expected)
+		}
+	}
+}
+
+// TestVehicleCRUD testa o cadastro, a validação, a atualização e a exclusão de veículos.
+func TestVehicleCRUD(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		service := &services.VehicleService{Repository: stores.Vehicles}
+
+		vehicle := models.Vehicle{Placa: "abc-1234", Tipo: models.VehicleTypeVan, CapacidadePeso: 800, CapacidadeVolume: 6.5, Ativo: true}
+		if err := service.Create(&vehicle); err != nil {
+			t.Fatalf("Create retornou erro: %v", err)
+		}
+		if vehicle.ID == 0 || vehicle.Placa != "ABC1234" {
+			t.Errorf("Esperava o veículo com ID e placa normalizada, mas recebeu %+v", vehicle)
+		}
+
+		// Casos de erro: placa duplicada e dados inválidos
+		duplicate := models.Vehicle{Placa: "ABC1234", Tipo: models.VehicleTypeCarro, CapacidadePeso: 300}
+		if err := service.Create(&duplicate); !errors.Is(err, services.ErrPlacaDuplicada) {
+			t.Errorf("Esperava ErrPlacaDuplicada, mas recebeu %v", err)
+		}
+		invalid := models.Vehicle{Placa: "XYZ9A87", Tipo: "bicicleta", CapacidadePeso: 20}
+		if err := service.Create(&invalid); !errors.Is(err, services.ErrVeiculoInvalido) {
+			t.Errorf("Esperava ErrVeiculoInvalido para tipo desconhecido, mas recebeu %v", err)
+		}
+
+		// Desativa o veículo e verifica o filtro de ativos
+		vehicle.Ativo = false
+		if err := service.Update(&vehicle); err != nil {
+			t.Fatalf("Update retornou erro: %v", err)
+		}
+		ativo := true
+		page, err := service.List(models.VehicleFilter{Ativo: &ativo, Page: 1, PageSize: 20})
+		if err != nil || page.Total != 0 {
+			t.Errorf("Esperava nenhum veículo ativo, mas recebeu %d (erro: %v)", page.Total, err)
+		}
+
+		if err := service.Delete(vehicle.ID); err != nil {
+			t.Fatalf("Delete retornou erro: %v", err)
+		}
+		if _, err := service.FindByID(vehicle.ID); !errors.Is(err, services.ErrVeiculoNaoEncontrado) {
+			t.Errorf("Esperava ErrVeiculoNaoEncontrado após a exclusão, mas recebeu %v", err)
+		}
+	})
+}
+
+// TestPlanFleet testa a distribuição das entregas entre os veículos respeitando a capacidade de peso.
+func TestPlanFleet(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		deliveryService, _, _ := newServices(stores)
+		vehicleService := &services.VehicleService{Repository: stores.Vehicles}
+		routeService := &services.RouteService{Deliveries: stores.Deliveries, Vehicles: stores.Vehicles}
+		cliente := models.Cliente{Nome: "João Silva", CPF: "529.982.247-25"}
+
+		// Frota: uma van de 100 kg, um carro de 50 kg e um caminhão inativo
+		fleet := []models.Vehicle{
+			{Placa: "VAN1A00", Tipo: models.VehicleTypeVan, CapacidadePeso: 100, Ativo: true},
+			{Placa: "CAR2B00", Tipo: models.VehicleTypeCarro, CapacidadePeso: 50, Ativo: true},
+			{Placa: "CAM3C00", Tipo: models.VehicleTypeCaminhao, CapacidadePeso: 5000, Ativo: false},
+		}
+		for i := range fleet {
+			if err := vehicleService.Create(&fleet[i]); err != nil {
+				t.Fatalf("Create retornou erro: %v", err)
+			}
+		}
+
+		// Entregas em dois grupos (norte e sul do depósito) e uma entrega pesada demais para a frota ativa
+		points := []struct{ lat, lng, peso float64 }{
+			{-23.40, -46.60, 30}, {-23.41, -46.62, 30}, {-23.42, -46.61, 30},
+			{-23.70, -46.60, 20}, {-23.71, -46.62, 20},
+			{-23.55, -46.50, 120},
+		}
+		capacities := map[int]float64{}
+		for _, vehicle := range fleet {
+			capacities[vehicle.ID] = vehicle.CapacidadePeso
+		}
+		for _, point := range points {
+			delivery := newDelivery("São Paulo", point.peso)
+			delivery.Latitude, delivery.Longitude = point.lat, point.lng
+			if _, err := deliveryService.Create(delivery, cliente); err != nil {
+				t.Fatalf("Create retornou erro: %v", err)
+			}
+		}
+
+		lat, lng := -23.55, -46.63
+		plan, err := routeService.Plan(models.FleetPlanRequest{RouteRequest: models.RouteRequest{DepositoLatitude: &lat, DepositoLongitude: &lng, Cidade: "São Paulo"}})
+		if err != nil {
+			t.Fatalf("Plan retornou erro: %v", err)
+		}
+
+		// Cada rota respeita a capacidade e o caminhão inativo não é usado
+		assigned := 0
+		for _, route := range plan.Rotas {
+			if !route.Veiculo.Ativo {
+				t.Errorf("O veículo inativo %s não deveria receber entregas", route.Veiculo.Placa)
+			}
+			if route.PesoTotalKg > capacities[route.Veiculo.ID] {
+				t.Errorf("O veículo %s recebeu %.2f kg, acima da capacidade de %.2f kg", route.Veiculo.Placa, route.PesoTotalKg, capacities[route.Veiculo.ID])
+			}
+			assigned += len(route.Paradas)
+		}
+		if assigned != 5 || len(plan.EntregasNaoAlocadas) != 1 {
+			t.Errorf("Esperava 5 entregas alocadas e 1 não alocada, mas recebeu %d e %v", assigned, plan.EntregasNaoAlocadas)
+		}
+
+		// Caso de erro: veículo inativo informado explicitamente
+		request := models.FleetPlanRequest{RouteRequest: models.RouteRequest{DepositoLatitude: &lat, DepositoLongitude: &lng, Cidade: "São Paulo"}, VeiculoIDs: []int{fleet[2].ID}}
+		if _, err := routeService.Plan(request); !errors.Is(err, services.ErrRotaInvalida) {
+			t.Errorf("Esperava ErrRotaInvalida para veículo inativo, mas recebeu %v", err)
+		}
+	})
+}
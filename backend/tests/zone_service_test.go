@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	"meu-projeto/backend/models"
+	"meu-projeto/backend/repositories"
+	"meu-projeto/backend/services"
+	"meu-projeto/backend/utils"
+)
+
+// square retorna um anel quadrado (fechado) com o canto sudoeste e o lado informados, em graus.
+func square(lat, lng, side float64) [][]float64 {
+	return [][]float64{{lng, lat}, {lng + side, lat}, {lng + side, lat + side}, {lng, lat + side}, {lng, lat}}
+}
+
+// deliveryAt cria uma entrega nas coordenadas informadas e retorna o seu ID.
+func deliveryAt(t *testing.T, service *services.DeliveryService, lat, lng float64) int {
+	delivery := newDelivery("São Paulo", 1)
+	delivery.Latitude, delivery.Longitude = lat, lng
+	id, err := service.Create(delivery, models.Cliente{Nome: "João Silva", CPF: "529.982.247-25"})
+	if err != nil {
+		t.Fatalf("Create retornou erro: %v", err)
+	}
+	return int(id)
+}
+
+// TestPointInPolygon testa o ray casting, incluindo buracos e anéis não fechados.
+func TestPointInPolygon(t *testing.T) {
+	rings, err := utils.ValidatePolygon([][][]float64{
+		{{-47, -24}, {-46, -24}, {-46, -23}, {-47, -23}}, // Contorno sem o ponto final repetido
+		square(-23.6, -46.6, 0.2),                        // Buraco no meio
+	})
+	if err != nil {
+		t.Fatalf("ValidatePolygon retornou erro: %v", err)
+	}
+	if len(rings[0]) != 5 {
+		t.Errorf("Esperava o contorno fechado com 5 posições, mas recebeu %d", len(rings[0]))
+	}
+
+	tests := []struct {
+		lat, lng float64
+		expected bool
+	}{
+		{-23.2, -46.8, true},  // Dentro do contorno
+		{-23.5, -46.5, false}, // Dentro do buraco
+		{-22.5, -46.5, false}, // Ao norte do contorno
+		{-23.5, -45.5, false}, // A leste do contorno
+	}
+	for _, test := range tests {
+		if result := utils.PointInPolygon(test.lat, test.lng, rings); result != test.expected {
+			t.Errorf("PointInPolygon(%v, %v) = %v; esperava %v", test.lat, test.lng, result, test.expected)
+		}
+	}
+
+	// Casos de erro: polígono sem anéis e anéis sem três pontos distintos e não alinhados
+	if _, err := utils.ValidatePolygon(nil); err == nil {
+		t.Errorf("Esperava erro para polígono sem anéis")
+	}
+	if _, err := utils.ValidatePolygon([][][]float64{{{-46, -23}, {-46.1, -23}}}); err == nil {
+		t.Errorf("Esperava erro para anel com dois pontos")
+	}
+	if _, err := utils.ValidatePolygon([][][]float64{{{-46, -23}, {-46.1, -23}, {-46.1, -23}}}); err == nil {
+		t.Errorf("Esperava erro para anel com ponto repetido")
+	}
+	if _, err := utils.ValidatePolygon([][][]float64{{{-46, -23}, {-46.1, -23}, {-46.2, -23}}}); err == nil {
+		t.Errorf("Esperava erro para anel com pontos alinhados")
+	}
+}
+
+// TestZoneAssignment testa a associação automática das entregas às zonas e a marcação das que ficam fora.
+func TestZoneAssignment(t *testing.T) {
+	forEachStore(t, func(t *testing.T, stores repositories.Stores) {
+		deliveryService, _, _ := newServices(stores)
+		service := &services.ZoneService{Repository: stores.Zones, Deliveries: deliveryService}
+
+		// Entrega cadastrada antes de existir qualquer zona fica fora de zona
+		before := deliveryAt(t, deliveryService, -23.55, -46.65)
+		if delivery, _ := deliveryService.FindByID(before); delivery.ZonaID != nil || !delivery.ForaDeZona {
+			t.Errorf("Esperava a entrega fora de zona, mas recebeu zona %v", delivery.ZonaID)
+		}
+
+		// Ao criar a zona, a entrega que já existia passa a pertencer a ela
+		centro := models.Zona{Nome: "Centro", Poligono: models.GeoJSONPolygon{Type: "Polygon", Coordinates: [][][]float64{square(-23.6, -46.7, 0.1)}}}
+		if err := service.Create(&centro); err != nil {
+			t.Fatalf("Create retornou erro: %v", err)
+		}
+		if delivery, _ := deliveryService.FindByID(before); delivery.ZonaID == nil || *delivery.ZonaID != centro.ID || delivery.ForaDeZona {
+			t.Errorf("Esperava a entrega na zona %d, mas recebeu %v", centro.ID, delivery.ZonaID)
+		}
+
+		// Novas entregas são associadas na criação; a de fora é marcada para revisão
+		inside := deliveryAt(t, deliveryService, -23.52, -46.62)
+		outside := deliveryAt(t, deliveryService, -22.90, -47.06)
+		page, err := service.ListDeliveries(centro.ID, 1, 20)
+		if err != nil || page.Total != 2 {
+			t.Errorf("Esperava 2 entregas na zona, mas recebeu %d (erro: %v)", page.Total, err)
+		}
+		unzoned, _ := deliveryService.List(models.DeliveryFilter{ForaDeZona: true, Page: 1, PageSize: 20})
+		if unzoned.Total != 1 || unzoned.Items[0].ID != outside {
+			t.Errorf("Esperava apenas a entrega %d fora de zona, mas recebeu %v", outside, unzoned.Items)
+		}
+
+		// Ao mudar as coordenadas na atualização, a zona é recalculada
+		moved, _ := deliveryService.FindByID(inside)
+		moved.Latitude, moved.Longitude = -22.90, -47.06
+		if err := deliveryService.Update(inside, *moved); err != nil {
+			t.Fatalf("Update retornou erro: %v", err)
+		}
+		if delivery, _ := deliveryService.FindByID(inside); !delivery.ForaDeZona {
+			t.Errorf("Esperava a entrega movida fora de zona, mas recebeu zona %v", delivery.ZonaID)
+		}
+
+		// Ao excluir a zona, as suas entregas ficam fora de zona
+		if err := service.Delete(centro.ID); err != nil {
+			t.Fatalf("Delete retornou erro: %v", err)
+		}
+		if delivery, _ := deliveryService.FindByID(before); !delivery.ForaDeZona {
+			t.Errorf("Esperava a entrega fora de zona após a exclusão, mas recebeu zona %v", delivery.ZonaID)
+		}
+
+		// Casos de erro: geometria de outro tipo, nome duplicado e zona inexistente
+		point := models.Zona{Nome: "Ponto", Poligono: models.GeoJSONPolygon{Type: "Point"}}
+		if err := service.Create(&point); !errors.Is(err, services.ErrZonaInvalida) {
+			t.Errorf("Esperava ErrZonaInvalida, mas recebeu %v", err)
+		}
+		norte := models.Zona{Nome: "Norte", Poligono: models.GeoJSONPolygon{Type: "Polygon", Coordinates: [][][]float64{square(-23.5, -46.7, 0.1)}}}
+		service.Create(&norte)
+		duplicate := models.Zona{Nome: " norte ", Poligono: norte.Poligono}
+		if err := service.Create(&duplicate); !errors.Is(err, services.ErrZonaDuplicada) {
+			t.Errorf("Esperava ErrZonaDuplicada, mas recebeu %v", err)
+		}
+		if _, err := service.ListDeliveries(999, 1, 20); !errors.Is(err, services.ErrZonaNaoEncontrada) {
+			t.Errorf("Esperava ErrZonaNaoEncontrada, mas recebeu %v", err)
+		}
+	})
+}
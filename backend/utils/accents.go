@@ -0,0 +1,30 @@
+package utils
+
+import "strings"
+
+// accentReplacer troca as letras acentuadas usadas em português pela letra sem acento.
+var accentReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+	"Á", "A", "À", "A", "Â", "A", "Ã", "A", "Ä", "A",
+	"É", "E", "È", "E", "Ê", "E", "Ë", "E",
+	"Í", "I", "Ì", "I", "Î", "I", "Ï", "I",
+	"Ó", "O", "Ò", "O", "Ô", "O", "Õ", "O", "Ö", "O",
+	"Ú", "U", "Ù", "U", "Û", "U", "Ü", "U",
+	"Ç", "C", "Ñ", "N",
+)
+
+// RemoveAccents remove os acentos de um texto (ex: "São Paulo" -> "Sao Paulo").
+func RemoveAccents(value string) string {
+	return accentReplacer.Replace(value)
+}
+
+// Fold normaliza um texto para comparações que ignoram acentos e maiúsculas,
+// do mesmo modo que a collation utf8mb4_unicode_ci do MySQL (ex: "São Paulo" -> "sao paulo").
+func Fold(value string) string {
+	return strings.ToLower(RemoveAccents(value))
+}
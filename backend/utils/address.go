@@ -0,0 +1,140 @@
+package utils
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Limites aproximados do território brasileiro, incluindo as ilhas oceânicas (ex: Fernando de Noronha e Trindade).
+const (
+	BrazilMinLat = -33.76
+	BrazilMaxLat = 5.28
+	BrazilMinLng = -74.00
+	BrazilMaxLng = -28.80
+)
+
+// BrazilStates associa a sigla (UF) de cada um dos 26 estados e do Distrito Federal ao seu nome.
+var BrazilStates = map[string]string{
+	"AC": "Acre", "AL": "Alagoas", "AP": "Amapá", "AM": "Amazonas", "BA": "Bahia", "CE": "Ceará",
+	"DF": "Distrito Federal", "ES": "Espírito Santo", "GO": "Goiás", "MA": "Maranhão", "MT": "Mato Grosso",
+	"MS": "Mato Grosso do Sul", "MG": "Minas Gerais", "PA": "Pará", "PB": "Paraíba", "PR": "Paraná",
+	"PE": "Pernambuco", "PI": "Piauí", "RJ": "Rio de Janeiro", "RN": "Rio Grande do Norte",
+	"RS": "Rio Grande do Sul", "RO": "Rondônia", "RR": "Roraima", "SC": "Santa Catarina", "SP": "São Paulo",
+	"SE": "Sergipe", "TO": "Tocantins",
+}
+
+// municipiosCSV é a lista de municípios do IBGE embutida no binário, no formato "uf,nome".
+//
+//go:embed data/municipios.csv
+var municipiosCSV string
+
+// municipios indexa o nome oficial dos municípios por UF e nome normalizado com Fold. A chave com UF vazia
+// contém os nomes de todos os estados (ex: municipios[""]["sao paulo"] = "São Paulo").
+var (
+	municipios     map[string]map[string]string
+	municipiosOnce sync.Once
+)
+
+// lowercaseWords são as palavras mantidas em minúsculas nos nomes próprios (ex: "Rio de Janeiro").
+var lowercaseWords = map[string]bool{"da": true, "das": true, "de": true, "do": true, "dos": true, "e": true}
+
+// romanNumeral reconhece números romanos usados em nomes de ruas (ex: "Rua XV de Novembro", "Avenida Pedro II").
+var romanNumeral = regexp.MustCompile(`^(?i)(x{0,3})(ix|iv|v?i{0,3})$`)
+
+// NormalizeUF converte a sigla ou o nome de um estado, com ou sem acentos e em qualquer caixa, na sigla oficial
+// (ex: "sao paulo" -> "SP"). Retorna false se o valor não corresponder a nenhum estado.
+func NormalizeUF(value string) (string, bool) {
+	value = Fold(strings.TrimSpace(value))
+	for uf, name := range BrazilStates {
+		if value == strings.ToLower(uf) || value == Fold(name) {
+			return uf, true
+		}
+	}
+	return "", false
+}
+
+// NormalizeCity retorna o nome oficial do município na lista do IBGE, procurando primeiro no estado informado
+// (ex: "sao paulo", "SP" -> "São Paulo"). Municípios fora da lista são retornados com NormalizeName.
+func NormalizeCity(cidade, uf string) string {
+	municipiosOnce.Do(loadMunicipios)
+
+	key := Fold(NormalizeName(cidade))
+	if name, ok := municipios[uf][key]; ok {
+		return name
+	}
+	if name, ok := municipios[""][key]; ok && uf == "" {
+		return name
+	}
+	return NormalizeName(cidade)
+}
+
+// loadMunicipios monta o índice de municípios a partir do arquivo embutido.
+func loadMunicipios() {
+	records, err := csv.NewReader(strings.NewReader(municipiosCSV)).ReadAll()
+	if err != nil {
+		panic(err) // O arquivo embutido é validado pelos testes
+	}
+
+	municipios = map[string]map[string]string{"": {}}
+	for _, record := range records[1:] {
+		uf, name := record[0], record[1]
+		if municipios[uf] == nil {
+			municipios[uf] = make(map[string]string)
+		}
+		municipios[uf][Fold(name)] = name
+		municipios[""][Fold(name)] = name
+	}
+}
+
+// NormalizeName remove os espaços extras de um nome próprio (rua, bairro, cidade) e, se ele estiver todo em
+// maiúsculas ou todo em minúsculas, aplica a caixa usual (ex: "AV. DAS  NAÇÕES" -> "Av. das Nações").
+// Nomes com caixa mista são mantidos, pois podem conter siglas intencionais.
+func NormalizeName(value string) string {
+	words := strings.Fields(value)
+	value = strings.Join(words, " ")
+	if value != strings.ToLower(value) && value != strings.ToUpper(value) {
+		return value
+	}
+
+	for i, word := range words {
+		switch lower := strings.ToLower(word); {
+		case i > 0 && lowercaseWords[lower]:
+			words[i] = lower
+		case romanNumeral.MatchString(word):
+			words[i] = strings.ToUpper(word)
+		default:
+			words[i] = capitalize(lower)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// capitalize converte em maiúscula a primeira letra da palavra e de cada parte separada por hífen (ex: "ji-paraná" -> "Ji-Paraná").
+func capitalize(word string) string {
+	parts := strings.Split(word, "-")
+	for i, part := range parts {
+		if r, size := utf8.DecodeRuneInString(part); size > 0 {
+			parts[i] = string(unicode.ToUpper(r)) + part[size:]
+		}
+	}
+	return strings.Join(parts, "-")
+}
+
+// IsBrazil verifica se o país informado é o Brasil (ex: "Brasil", "brazil" ou "BR").
+func IsBrazil(pais string) bool {
+	switch Fold(strings.TrimSpace(pais)) {
+	case "brasil", "brazil", "br", "bra":
+		return true
+	}
+	return false
+}
+
+// InBrazil verifica se as coordenadas estão dentro dos limites do território brasileiro.
+func InBrazil(lat, lng float64) bool {
+	return lat >= BrazilMinLat && lat <= BrazilMaxLat && lng >= BrazilMinLng && lng <= BrazilMaxLng
+}
@@ -0,0 +1,20 @@
+package utils
+
+import "regexp"
+
+// cepPattern aceita o CEP com ou sem hífen e ponto (ex: 01310-100, 01310100 ou 01.310-100).
+var cepPattern = regexp.MustCompile(`^[0-9]{2}\.?[0-9]{3}-?[0-9]{3}$`)
+
+// ValidateCEP verifica se o CEP tem 8 dígitos, com ou sem a pontuação usual, e não é composto apenas de zeros.
+func ValidateCEP(cep string) bool {
+	return cepPattern.MatchString(cep) && OnlyDigits(cep) != "00000000"
+}
+
+// FormatCEP formata um CEP válido no padrão 01310-100 (outros valores são retornados sem alteração).
+func FormatCEP(cep string) string {
+	if !ValidateCEP(cep) {
+		return cep
+	}
+	digits := OnlyDigits(cep)
+	return digits[0:5] + "-" + digits[5:8]
+}
@@ -0,0 +1,49 @@
+package utils
+
+import "math"
+
+// EarthRadiusKm é o raio médio da Terra, em quilômetros.
+const EarthRadiusKm = 6371.0
+
+// Haversine calcula a distância em linha reta (sobre a superfície da Terra), em quilômetros,
+// entre dois pontos dados por latitude e longitude em graus.
+func Haversine(lat1, lng1, lat2, lng2 float64) float64 {
+	// Converte as diferenças e as latitudes de graus para radianos
+	dLat := (lat2 - lat1) * math.Pi / 180
+	dLng := (lng2 - lng1) * math.Pi / 180
+	rLat1 := lat1 * math.Pi / 180
+	rLat2 := lat2 * math.Pi / 180
+
+	// Fórmula de haversine
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(rLat1)*math.Cos(rLat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * EarthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// ValidCoordinates verifica se a latitude e a longitude estão dentro dos limites válidos.
+func ValidCoordinates(lat, lng float64) bool {
+	return lat >= -90 && lat <= 90 && lng >= -180 && lng <= 180
+}
+
+// BoundingBox calcula o retângulo de latitudes e longitudes que contém o círculo de raio radiusKm
+// em torno do ponto informado. É usado como pré-filtro barato antes do cálculo exato com Haversine.
+// Se o círculo alcançar um dos polos ou cruzar o antimeridiano, as longitudes não são limitadas.
+func BoundingBox(lat, lng, radiusKm float64) (minLat, maxLat, minLng, maxLng float64) {
+	// Raio angular do círculo, em radianos
+	angular := radiusKm / EarthRadiusKm
+	rLat := lat * math.Pi / 180
+
+	// A variação de latitude é constante; a de longitude aumenta em direção aos polos
+	deltaLat := angular * 180 / math.Pi
+	minLat, maxLat = lat-deltaLat, lat+deltaLat
+	ratio := math.Sin(angular) / math.Cos(rLat)
+	if minLat <= -90 || maxLat >= 90 || ratio >= 1 {
+		return math.Max(minLat, -90), math.Min(maxLat, 90), -180, 180
+	}
+
+	deltaLng := math.Asin(ratio) * 180 / math.Pi
+	minLng, maxLng = lng-deltaLng, lng+deltaLng
+	if minLng < -180 || maxLng > 180 {
+		return minLat, maxLat, -180, 180
+	}
+	return minLat, maxLat, minLng, maxLng
+}
@@ -0,0 +1,20 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+)
+
+// platePattern aceita placas no padrão antigo (ABC1234) e no padrão Mercosul (ABC1D23), já sem hífen.
+var platePattern = regexp.MustCompile(`^[A-Z]{3}[0-9][A-Z0-9][0-9]{2}$`)
+
+// NormalizePlate remove hífens e espaços e converte a placa para maiúsculas (ex: "abc-1234" -> "ABC1234").
+func NormalizePlate(placa string) string {
+	placa = strings.ToUpper(strings.TrimSpace(placa))
+	return strings.NewReplacer("-", "", " ", "").Replace(placa)
+}
+
+// ValidatePlate verifica se a placa (com ou sem hífen) está no padrão antigo ou no padrão Mercosul.
+func ValidatePlate(placa string) bool {
+	return platePattern.MatchString(NormalizePlate(placa))
+}
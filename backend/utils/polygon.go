@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"errors"
+	"math"
+)
+
+// ValidatePolygon verifica os anéis de um polígono GeoJSON: o primeiro é o contorno externo e os demais
+// são buracos. Cada posição é [longitude, latitude] e cada anel precisa de ao menos três pontos distintos e
+// não alinhados, para que a zona tenha área.
+// Anéis que não terminam no ponto inicial são fechados automaticamente.
+func ValidatePolygon(rings [][][]float64) ([][][]float64, error) {
+	if len(rings) == 0 {
+		return nil, errors.New("o polígono precisa de ao menos um anel")
+	}
+
+	closed := make([][][]float64, 0, len(rings))
+	for _, ring := range rings {
+		for _, position := range ring {
+			if len(position) < 2 || !ValidCoordinates(position[1], position[0]) {
+				return nil, errors.New("cada posição deve ser [longitude, latitude] dentro dos limites válidos")
+			}
+		}
+
+		// Fecha o anel, se necessário
+		if len(ring) > 0 && !samePosition(ring[0], ring[len(ring)-1]) {
+			ring = append(append([][]float64{}, ring...), ring[0])
+		}
+		if distinctPositions(ring) < 3 || ringArea(ring) == 0 {
+			return nil, errors.New("cada anel do polígono precisa de ao menos três pontos distintos e não alinhados")
+		}
+		closed = append(closed, ring)
+	}
+	return closed, nil
+}
+
+// distinctPositions conta as posições distintas do anel.
+func distinctPositions(ring [][]float64) int {
+	seen := make(map[[2]float64]struct{}, len(ring))
+	for _, position := range ring {
+		seen[[2]float64{position[0], position[1]}] = struct{}{}
+	}
+	return len(seen)
+}
+
+// ringArea calcula a área do anel fechado pela fórmula do laço (shoelace); anéis com pontos repetidos ou
+// alinhados têm área zero.
+func ringArea(ring [][]float64) float64 {
+	sum := 0.0
+	for i := 0; i+1 < len(ring); i++ {
+		sum += ring[i][0]*ring[i+1][1] - ring[i+1][0]*ring[i][1]
+	}
+	return math.Abs(sum) / 2
+}
+
+// PointInPolygon verifica se o ponto está dentro do polígono (dentro do contorno externo e fora dos buracos),
+// usando o algoritmo de lançamento de raio (ray casting).
+func PointInPolygon(lat, lng float64, rings [][][]float64) bool {
+	if len(rings) == 0 || !pointInRing(lat, lng, rings[0]) {
+		return false
+	}
+	for _, hole := range rings[1:] {
+		if pointInRing(lat, lng, hole) {
+			return false
+		}
+	}
+	return true
+}
+
+// pointInRing conta quantas arestas do anel um raio horizontal partindo do ponto cruza; um número ímpar indica que o ponto está dentro.
+func pointInRing(lat, lng float64, ring [][]float64) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		lngI, latI := ring[i][0], ring[i][1]
+		lngJ, latJ := ring[j][0], ring[j][1]
+		if (latI > lat) != (latJ > lat) && lng < (lngJ-lngI)*(lat-latI)/(latJ-latI)+lngI {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// PolygonBounds retorna o menor retângulo de coordenadas que contém o contorno externo do polígono.
+func PolygonBounds(rings [][][]float64) (minLat, maxLat, minLng, maxLng float64) {
+	minLat, minLng = math.Inf(1), math.Inf(1)
+	maxLat, maxLng = math.Inf(-1), math.Inf(-1)
+	if len(rings) == 0 {
+		return 0, 0, 0, 0
+	}
+	for _, position := range rings[0] {
+		minLng, maxLng = math.Min(minLng, position[0]), math.Max(maxLng, position[0])
+		minLat, maxLat = math.Min(minLat, position[1]), math.Max(maxLat, position[1])
+	}
+	return minLat, maxLat, minLng, maxLng
+}
+
+// samePosition verifica se duas posições GeoJSON têm a mesma longitude e latitude.
+func samePosition(a, b []float64) bool {
+	return a[0] == b[0] && a[1] == b[1]
+}
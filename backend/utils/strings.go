@@ -0,0 +1,24 @@
+package utils
+
+import "strings"
+
+// LikeEscape é o caractere de escape usado nas buscas com LIKE (deve ser declarado com ESCAPE '!').
+// Usamos "!" em vez de "\" porque a barra é interpretada de formas diferentes pelo MySQL e pelo SQLite.
+const LikeEscape = "!"
+
+// OnlyDigits remove todos os caracteres que não são dígitos (ex: "123.456.789-09" -> "12345678909").
+func OnlyDigits(value string) string {
+	var builder strings.Builder
+	for _, char := range value {
+		if char >= '0' && char <= '9' {
+			builder.WriteRune(char)
+		}
+	}
+	return builder.String()
+}
+
+// EscapeLike escapa os caracteres curinga do LIKE (%, _ e o próprio escape) para que sejam buscados literalmente.
+func EscapeLike(value string) string {
+	replacer := strings.NewReplacer(LikeEscape, LikeEscape+LikeEscape, "%", LikeEscape+"%", "_", LikeEscape+"_")
+	return replacer.Replace(value)
+}
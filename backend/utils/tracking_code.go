@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"crypto/rand"
+	"strings"
+)
+
+// Prefixo e sufixo dos códigos de rastreio, no formato dos objetos postais: ENF85JJ3CX4PER8BR.
+const (
+	trackingCodePrefix = "EN"
+	trackingCodeSuffix = "BR"
+)
+
+// trackingCodeAlphabet é o alfabeto base 32 de Crockford, sem as letras I, L, O e U, que se confundem com
+// outros caracteres na leitura e na digitação.
+const trackingCodeAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// trackingCodeLength é a quantidade de caracteres aleatórios do código (60 bits), o suficiente para que os
+// códigos não possam ser descobertos por tentativa na consulta pública.
+const trackingCodeLength = 12
+
+// trackingCodeWeights são os pesos usados no cálculo do dígito verificador dos códigos antigos (padrão S10).
+var trackingCodeWeights = [8]int{8, 6, 4, 2, 3, 5, 9, 7}
+
+// GenerateTrackingCode gera um código de rastreio aleatório e não sequencial: 12 caracteres base 32 sorteados
+// com um gerador criptograficamente seguro, seguidos de um caractere verificador.
+func GenerateTrackingCode() (string, error) {
+	random := make([]byte, trackingCodeLength)
+	if _, err := rand.Read(random); err != nil {
+		return "", err // Retorna erro se não for possível gerar os bytes aleatórios
+	}
+
+	// Cada byte escolhe um caractere do alfabeto (256 é múltiplo de 32, então a escolha é uniforme)
+	var payload strings.Builder
+	for _, b := range random {
+		payload.WriteByte(trackingCodeAlphabet[int(b)%len(trackingCodeAlphabet)])
+	}
+
+	return trackingCodePrefix + payload.String() + string(trackingCodeCheckChar(payload.String())) + trackingCodeSuffix, nil
+}
+
+// ValidateTrackingCode valida o formato e o caractere verificador de um código de rastreio. Os códigos no
+// formato antigo, com 8 dígitos (padrão S10, ex: EN473124829BR), continuam aceitos para as entregas
+// cadastradas antes da mudança de formato.
+func ValidateTrackingCode(code string) bool {
+	// Verifica o prefixo e o sufixo do código
+	if !strings.HasPrefix(code, trackingCodePrefix) || !strings.HasSuffix(code, trackingCodeSuffix) {
+		return false
+	}
+	body := code[len(trackingCodePrefix) : len(code)-len(trackingCodeSuffix)]
+
+	switch len(body) {
+	case trackingCodeLength + 1:
+		// Verifica se todos os caracteres pertencem ao alfabeto e confere o caractere verificador
+		for i := 0; i < len(body); i++ {
+			if strings.IndexByte(trackingCodeAlphabet, body[i]) < 0 {
+				return false
+			}
+		}
+		return body[trackingCodeLength] == trackingCodeCheckChar(body[:trackingCodeLength])
+	case 9:
+		return validateLegacyTrackingCode(body)
+	}
+	return false
+}
+
+// trackingCodeCheckChar calcula o caractere verificador dos caracteres informados pelo algoritmo de Luhn
+// na base 32, que detecta qualquer caractere trocado e a maioria das inversões de caracteres vizinhos.
+func trackingCodeCheckChar(payload string) byte {
+	base := len(trackingCodeAlphabet)
+	sum, factor := 0, 2
+	for i := len(payload) - 1; i >= 0; i-- {
+		addend := factor * strings.IndexByte(trackingCodeAlphabet, payload[i])
+		sum += addend/base + addend%base
+		factor = 3 - factor // Alterna os pesos 2 e 1, da direita para a esquerda
+	}
+	return trackingCodeAlphabet[(base-sum%base)%base]
+}
+
+// validateLegacyTrackingCode valida a parte numérica de um código no formato antigo: 8 dígitos e o dígito
+// verificador do padrão S10.
+func validateLegacyTrackingCode(digits string) bool {
+	for _, digit := range digits {
+		if digit < '0' || digit > '9' {
+			return false
+		}
+	}
+	return int(digits[8]-'0') == legacyCheckDigit(digits[:8])
+}
+
+// legacyCheckDigit calcula o dígito verificador de um número de 8 dígitos (padrão S10).
+func legacyCheckDigit(number string) int {
+	sum := 0
+	for i := 0; i < 8; i++ {
+		sum += int(number[i]-'0') * trackingCodeWeights[i] // Multiplica o dígito pelo peso correspondente
+	}
+
+	digit := 11 - sum%11
+	switch digit {
+	case 10:
+		return 0
+	case 11:
+		return 5
+	}
+	return digit
+}
@@ -69,3 +69,23 @@ func validateCPFDigits(cpf string) bool {
 	// Verifica se os dígitos verificadores calculados correspondem aos dígitos do CPF
 	return int(cpf[9]-'0') == firstDigit && int(cpf[10]-'0') == secondDigit
 }
+
+// ValidateCNH valida o formato do número de registro de uma CNH: 11 dígitos, não todos iguais.
+func ValidateCNH(cnh string) bool {
+	// Verifica se a CNH tem exatamente 11 caracteres, todos dígitos
+	if len(cnh) != 11 || OnlyDigits(cnh) != cnh {
+		return false
+	}
+
+	// Verifica se todos os dígitos da CNH são iguais (CNH inválida)
+	return !allDigitsEqual(cnh)
+}
+
+// FormatCPF formata um CPF de 11 dígitos no padrão 123.456.789-09 (outros valores são retornados sem alteração).
+func FormatCPF(cpf string) string {
+	digits := OnlyDigits(cpf)
+	if len(digits) != 11 {
+		return cpf
+	}
+	return digits[0:3] + "." + digits[3:6] + "." + digits[6:9] + "-" + digits[9:11]
+}
@@ -0,0 +1,120 @@
+// Package validation reúne os erros de validação de todos os campos de uma requisição, para que a API
+// possa respondê-los de uma só vez (status 422) em vez de parar no primeiro campo inválido.
+package validation
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Códigos de erro de validação.
+const (
+	CodeRequired      = "required"       // Campo obrigatório ausente ou vazio
+	CodeInvalidFormat = "invalid_format" // Valor fora do formato esperado (ex: CPF, CEP, data)
+	CodeInvalidValue  = "invalid_value"  // Valor fora da lista de valores aceitos (ex: status, UF)
+	CodeOutOfRange    = "out_of_range"   // Número fora do intervalo permitido
+	CodeTooLong       = "too_long"       // Texto maior que o tamanho máximo
+)
+
+// FieldError descreve o erro de validação de um campo da requisição.
+type FieldError struct {
+	Field   string `json:"field"`   // Caminho do campo no corpo da requisição (ex: delivery.peso)
+	Code    string `json:"code"`    // Código do erro (ex: required, invalid_format)
+	Message string `json:"message"` // Mensagem legível do erro
+}
+
+// Errors é a lista de erros de validação de uma requisição. Implementa error, e pode ser extraída
+// de um erro encapsulado com errors.As.
+type Errors []FieldError
+
+// Error implementa error, juntando as mensagens dos campos.
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, fieldErr := range e {
+		messages[i] = fieldErr.Field + ": " + fieldErr.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Has verifica se a lista contém um erro do campo com o código informado.
+func (e Errors) Has(field, code string) bool {
+	for _, fieldErr := range e {
+		if fieldErr.Field == field && fieldErr.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// FromError extrai a lista de erros de validação de err, se houver.
+func FromError(err error) (Errors, bool) {
+	var errs Errors
+	if errors.As(err, &errs) {
+		return errs, true
+	}
+	return nil, false
+}
+
+// Validator acumula os erros de validação de uma requisição. Validadores criados com With compartilham
+// a mesma lista de erros e acrescentam um prefixo ao nome dos campos (ex: "delivery.").
+type Validator struct {
+	prefix string  // Prefixo acrescentado ao nome dos campos
+	errors *Errors // Lista de erros compartilhada
+}
+
+// New cria um Validator sem erros.
+func New() *Validator {
+	return &Validator{errors: &Errors{}}
+}
+
+// With retorna um Validator que registra os erros na mesma lista, com o prefixo informado no nome dos campos.
+func (v *Validator) With(prefix string) *Validator {
+	return &Validator{prefix: v.prefix + prefix, errors: v.errors}
+}
+
+// Add registra um erro no campo informado.
+func (v *Validator) Add(field, code, message string) {
+	*v.errors = append(*v.errors, FieldError{Field: v.prefix + field, Code: code, Message: message})
+}
+
+// Check registra o erro se a condição for falsa e retorna a condição.
+func (v *Validator) Check(ok bool, field, code, message string) bool {
+	if !ok {
+		v.Add(field, code, message)
+	}
+	return ok
+}
+
+// Required registra um erro se o texto estiver vazio (ignorando espaços) e retorna se ele foi informado.
+func (v *Validator) Required(field, value string) bool {
+	return v.Check(strings.TrimSpace(value) != "", field, CodeRequired, "O campo '"+field+"' é obrigatório")
+}
+
+// MaxLength registra um erro se o texto tiver mais que max caracteres.
+func (v *Validator) MaxLength(field, value string, max int) bool {
+	return v.Check(utf8.RuneCountInString(value) <= max, field, CodeTooLong, "O campo '"+field+"' deve ter no máximo "+strconv.Itoa(max)+" caracteres")
+}
+
+// Positive registra um erro se o número não for maior que zero (zero é tratado como campo ausente).
+func (v *Validator) Positive(field string, value float64) bool {
+	if value == 0 {
+		v.Add(field, CodeRequired, "O campo '"+field+"' é obrigatório e deve ser maior que zero")
+		return false
+	}
+	return v.Check(value > 0, field, CodeOutOfRange, "O campo '"+field+"' deve ser maior que zero")
+}
+
+// Valid verifica se nenhum erro foi registrado.
+func (v *Validator) Valid() bool {
+	return len(*v.errors) == 0
+}
+
+// Err retorna os erros registrados (do tipo Errors) ou nil se não houver nenhum.
+func (v *Validator) Err() error {
+	if v.Valid() {
+		return nil
+	}
+	return *v.errors
+}
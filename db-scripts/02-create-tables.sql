@@ -1,5 +1,8 @@
 USE deliveries;
 
+-- Esquema inicial. As alterações posteriores (status, rastreamento, índices etc.) são aplicadas
+-- pelas migrações do backend (backend/database/migrations) ao iniciar o servidor.
+
 CREATE TABLE IF NOT EXISTS Cliente (
     id INT AUTO_INCREMENT PRIMARY KEY,
     nome VARCHAR(100) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL,
@@ -0,0 +1,69 @@
+"use client"
+
+import { useState, type FormEvent } from "react"
+import Image from "next/image"
+import { useRouter } from "next/navigation"
+import { login } from "@/lib/auth"
+
+export default function LoginPage() {
+  const router = useRouter()
+  const [email, setEmail] = useState("")
+  const [senha, setSenha] = useState("")
+  const [error, setError] = useState("")
+  const [isSubmitting, setIsSubmitting] = useState(false)
+
+  // Envia as credenciais e, se aceitas, segue para o painel
+  const handleSubmit = async (e: FormEvent) => {
+    e.preventDefault()
+    setError("")
+    setIsSubmitting(true)
+    try {
+      await login(email, senha)
+      router.push("/dashboard")
+    } catch (err) {
+      setError(err instanceof Error ? err.message : "Não foi possível entrar")
+    } finally {
+      setIsSubmitting(false)
+    }
+  }
+
+  return (
+    <div className="flex h-screen items-center justify-center bg-gray-100">
+      <form onSubmit={handleSubmit} className="bg-white rounded-md shadow-sm w-full max-w-sm">
+        <div className="bg-teal-500 p-4 rounded-t-md flex justify-center">
+          <Image src={"/logo.png"} alt="Logo" width={127} height={127} />
+        </div>
+        <div className="p-4 space-y-4">
+          <div>
+            <label className="block text-sm font-medium text-gray-700 mb-1">E-mail</label>
+            <input
+              type="email"
+              value={email}
+              onChange={(e) => setEmail(e.target.value)}
+              className="w-full border border-gray-300 rounded-md px-3 py-2 text-sm"
+              required
+            />
+          </div>
+          <div>
+            <label className="block text-sm font-medium text-gray-700 mb-1">Senha</label>
+            <input
+              type="password"
+              value={senha}
+              onChange={(e) => setSenha(e.target.value)}
+              className="w-full border border-gray-300 rounded-md px-3 py-2 text-sm"
+              required
+            />
+          </div>
+          {error && <p className="text-sm text-red-600">{error}</p>}
+          <button
+            type="submit"
+            disabled={isSubmitting}
+            className="w-full bg-teal-500 hover:bg-teal-600 text-white rounded-md px-4 py-2 text-sm font-medium disabled:opacity-50"
+          >
+            {isSubmitting ? "Entrando..." : "Entrar"}
+          </button>
+        </div>
+      </form>
+    </div>
+  )
+}
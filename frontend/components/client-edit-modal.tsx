@@ -4,6 +4,7 @@ import type React from "react"
 
 import { useState } from "react"
 import { X, Mail, Phone, User, CreditCard, Save, Loader } from "lucide-react"
+import { apiFetch } from "@/lib/auth"
 
 // Interface para os dados do cliente da API
 interface ClienteAPI {
@@ -43,7 +44,7 @@ export default function ClientEditModal({ client, isOpen, onClose, onClientUpdat
 
     try {
       // Enviar requisição PUT para a API
-      const response = await fetch(`http://localhost:8080/clients/${client.id}`, {
+      const response = await apiFetch(`http://localhost:8080/clients/${client.id}`, {
         method: "PUT",
         headers: {
           "Content-Type": "application/json",
@@ -6,6 +6,8 @@ import { Card, CardContent } from "@/components/ui/card"
 import ClientDetailModal from "./client-detail-modal"
 // Adicionar o import do modal de edição
 import ClientEditModal from "./client-edit-modal"
+import { fetchAllPages } from "@/lib/api"
+import { apiFetch } from "@/lib/auth"
 
 // Interface para os dados do cliente da API
 interface ClienteAPI {
@@ -34,12 +36,9 @@ export default function ClientList() {
       setError(null)
 
       try {
-        const response = await fetch("http://localhost:8080/clients")
-        if (!response.ok) {
+        const data = await fetchAllPages<ClienteAPI>("http://localhost:8080/clients").catch(() => {
           throw new Error("Falha ao buscar dados de clientes")
-        }
-
-        const data: ClienteAPI[] = await response.json()
+        })
         setClients(data)
       } catch (err) {
         setError(err instanceof Error ? err.message : "Ocorreu um erro ao buscar os dados")
@@ -98,7 +97,7 @@ export default function ClientList() {
     if (window.confirm("Tem certeza que deseja excluir este cliente?")) {
       try {
         // Aqui você implementaria a chamada para excluir o cliente na API
-        await fetch(`http://localhost:8080/clients/${id}`, {
+        await apiFetch(`http://localhost:8080/clients/${id}`, {
           method: "DELETE",
           headers: {
             "Content-Type": "application/json",
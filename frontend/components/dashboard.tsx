@@ -8,6 +8,7 @@ import { Tabs, TabsContent, TabsList, TabsTrigger } from "@/components/ui/tabs"
 import { PieChart, BarChart, MapPin, Package, Loader } from "lucide-react"
 import StatusPieChart from "./charts/status-pie-chart"
 import CitiesBarChart from "./charts/cities-bar-chart"
+import { fetchAllPages } from "@/lib/api"
 
 // Interfaces para os dados da API
 interface ClienteAPI {
@@ -65,19 +66,15 @@ export default function Dashboard() {
 
       try {
         // Buscar clientes
-        const clientesResponse = await fetch("http://localhost:8080/clients")
-        if (!clientesResponse.ok) {
+        const clientesData = await fetchAllPages<ClienteAPI>("http://localhost:8080/clients").catch(() => {
           throw new Error("Falha ao buscar dados de clientes")
-        }
-        const clientesData: ClienteAPI[] = await clientesResponse.json()
+        })
         setClientes(clientesData)
 
         // Buscar entregas
-        const entregasResponse = await fetch("http://localhost:8080/deliveries")
-        if (!entregasResponse.ok) {
+        const entregasData = await fetchAllPages<EntregaAPI>("http://localhost:8080/deliveries").catch(() => {
           throw new Error("Falha ao buscar dados de entregas")
-        }
-        const entregasData: EntregaAPI[] = await entregasResponse.json()
+        })
 
         // Processar dados para os gráficos e estatísticas
         processarDados(entregasData)
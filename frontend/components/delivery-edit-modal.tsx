@@ -4,6 +4,7 @@ import type React from "react"
 
 import { useState } from "react"
 import { X, Package, Save, Loader, Search } from "lucide-react"
+import { apiFetch } from "@/lib/auth"
 
 // Interfaces para os dados da API
 interface ClienteAPI {
@@ -135,7 +136,7 @@ export default function EntregaEditModal({
     try {
       // Enviar requisição PUT para a API
       console.log(JSON.stringify(formData))
-      const response = await fetch(`http://localhost:8080/deliveries/${entrega.id}`, {
+      const response = await apiFetch(`http://localhost:8080/deliveries/${entrega.id}`, {
         method: "PUT",
         headers: {
           "Content-Type": "application/json",
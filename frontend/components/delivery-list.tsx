@@ -6,6 +6,8 @@ import { Card, CardContent } from "@/components/ui/card"
 import EntregaDetailModal from "./delivery-detail-modal"
 // Adicionar o import do modal de edição
 import EntregaEditModal from "./delivery-edit-modal"
+import { fetchAllPages } from "@/lib/api"
+import { apiFetch } from "@/lib/auth"
 
 // Interfaces para os dados da API
 interface ClienteAPI {
@@ -70,19 +72,15 @@ export default function EntregasList() {
 
       try {
         // Buscar clientes
-        const clientesResponse = await fetch("http://localhost:8080/clients")
-        if (!clientesResponse.ok) {
+        const clientesData = await fetchAllPages<ClienteAPI>("http://localhost:8080/clients").catch(() => {
           throw new Error("Falha ao buscar dados de clientes")
-        }
-        const clientesData: ClienteAPI[] = await clientesResponse.json()
+        })
         setClientes(clientesData)
 
         // Buscar entregas
-        const entregasResponse = await fetch("http://localhost:8080/deliveries")
-        if (!entregasResponse.ok) {
+        const entregasData = await fetchAllPages<EntregaAPI>("http://localhost:8080/deliveries").catch(() => {
           throw new Error("Falha ao buscar dados de entregas")
-        }
-        const entregasData: EntregaAPI[] = await entregasResponse.json()
+        })
 
         // Combinar dados
         const entregasCombinadas: EntregaCombinada[] = entregasData.map((entrega) => {
@@ -153,7 +151,7 @@ export default function EntregasList() {
     if (window.confirm("Tem certeza que deseja excluir esta entrega?")) {
       try {
         // Aqui você implementaria a chamada para excluir a entrega na API
-        await fetch(`http://localhost:8080/deliveries/${id}`, {
+        await apiFetch(`http://localhost:8080/deliveries/${id}`, {
           method: "DELETE",
           headers: {
             "Content-Type": "application/json",
@@ -6,6 +6,7 @@ import { useState } from "react"
 import { Search, MapPin } from "lucide-react"
 import AddressSuggestions from "./address-suggestions"
 import MapPreview from "./map-preview"
+import { apiFetch } from "@/lib/auth"
 
 interface DeliveryData {
   peso: number;
@@ -178,7 +179,7 @@ const handleClientChange = (e: React.ChangeEvent<HTMLInputElement>) => {
     try {
         // Substitua pela sua API de criação de entregas
         console.log(JSON.stringify(formData))
-        const response = await fetch("http://localhost:8080/deliveries", {
+        const response = await apiFetch("http://localhost:8080/deliveries", {
             method: "POST",
             headers: {
                 "Content-Type": "application/json",
@@ -214,7 +215,7 @@ const handleClientChange = (e: React.ChangeEvent<HTMLInputElement>) => {
             });
             setAddressFound(false);
         } else {
-            alert(`Erro: ${result.error}`);
+            alert(`Erro: ${result.error?.message}`);
         }
     } catch (error) {
         console.error("Erro ao enviar formulário:", error);
@@ -1,4 +1,7 @@
+"use client"
+
 import Image from "next/image";
+import { logout } from "@/lib/auth";
 
 export default function Header() {
   return (
@@ -42,6 +45,9 @@ export default function Header() {
             <circle cx="12" cy="7" r="4" />
           </svg>
         </div>
+        <button onClick={logout} className="text-sm text-white hover:text-teal-100">
+          Sair
+        </button>
       </div>
     </header>
   )
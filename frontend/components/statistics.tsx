@@ -6,6 +6,7 @@ import { useState, useEffect } from "react"
 import { Card, CardContent, CardDescription, CardHeader, CardTitle } from "@/components/ui/card"
 import { Tabs, TabsContent, TabsList, TabsTrigger } from "@/components/ui/tabs"
 import { MapPin, Loader } from "lucide-react"
+import { fetchAllPages } from "@/lib/api"
 
 // Interfaces para os dados da API
 interface ClienteAPI {
@@ -56,19 +57,15 @@ export default function Statistics() {
 
       try {
         // Buscar clientes
-        const clientesResponse = await fetch("http://localhost:8080/clients")
-        if (!clientesResponse.ok) {
+        const clientesData = await fetchAllPages<ClienteAPI>("http://localhost:8080/clients").catch(() => {
           throw new Error("Falha ao buscar dados de clientes")
-        }
-        const clientesData: ClienteAPI[] = await clientesResponse.json()
+        })
         setClientes(clientesData)
 
         // Buscar entregas
-        const entregasResponse = await fetch("http://localhost:8080/deliveries")
-        if (!entregasResponse.ok) {
+        const entregasData = await fetchAllPages<EntregaAPI>("http://localhost:8080/deliveries").catch(() => {
           throw new Error("Falha ao buscar dados de entregas")
-        }
-        const entregasData: EntregaAPI[] = await entregasResponse.json()
+        })
         setEntregas(entregasData)
 
         // Processar dados para os gráficos e estatísticas
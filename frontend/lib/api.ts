@@ -0,0 +1,31 @@
+import { apiFetch } from "@/lib/auth"
+
+// Envelope das respostas paginadas da API
+export interface Page<T> {
+  items: T[]
+  total: number
+  page: number
+  page_size: number
+  total_pages: number
+}
+
+// Busca todas as páginas de uma listagem paginada da API e retorna os itens concatenados
+export async function fetchAllPages<T>(url: string, pageSize = 100): Promise<T[]> {
+  const items: T[] = []
+  let page = 1
+  let totalPages = 1
+
+  do {
+    const separator = url.includes("?") ? "&" : "?"
+    const response = await apiFetch(`${url}${separator}page=${page}&page_size=${pageSize}`)
+    if (!response.ok) {
+      throw new Error(`Falha ao buscar ${url}`)
+    }
+    const data: Page<T> = await response.json()
+    items.push(...data.items)
+    totalPages = data.total_pages
+    page++
+  } while (page <= totalPages)
+
+  return items
+}
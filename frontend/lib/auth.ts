@@ -0,0 +1,82 @@
+// Endereço da API do backend
+export const API_URL = "http://localhost:8080"
+
+// Chaves dos tokens no localStorage
+const ACCESS_TOKEN_KEY = "access_token"
+const REFRESH_TOKEN_KEY = "refresh_token"
+
+// Par de tokens retornado por /auth/login e /auth/refresh
+interface TokenPair {
+  access_token: string
+  refresh_token: string
+  token_type: string
+  expires_in: number
+}
+
+// Guarda os tokens recebidos da API
+function saveTokens(tokens: TokenPair) {
+  localStorage.setItem(ACCESS_TOKEN_KEY, tokens.access_token)
+  localStorage.setItem(REFRESH_TOKEN_KEY, tokens.refresh_token)
+}
+
+// Remove os tokens e volta para a tela de login
+export function logout() {
+  localStorage.removeItem(ACCESS_TOKEN_KEY)
+  localStorage.removeItem(REFRESH_TOKEN_KEY)
+  window.location.href = "/login"
+}
+
+// Entra na API com e-mail e senha; lança um erro com a mensagem da API se as credenciais forem recusadas
+export async function login(email: string, senha: string): Promise<void> {
+  const response = await fetch(`${API_URL}/auth/login`, {
+    method: "POST",
+    headers: { "Content-Type": "application/json" },
+    body: JSON.stringify({ email, senha }),
+  })
+  const result = await response.json()
+  if (!response.ok) {
+    throw new Error(result.error?.message ?? "Não foi possível entrar")
+  }
+  saveTokens(result)
+}
+
+// Troca o token de renovação por um novo par de tokens, retornando false se ele não for mais válido
+async function refresh(): Promise<boolean> {
+  const refreshToken = localStorage.getItem(REFRESH_TOKEN_KEY)
+  if (!refreshToken) {
+    return false
+  }
+  const response = await fetch(`${API_URL}/auth/refresh`, {
+    method: "POST",
+    headers: { "Content-Type": "application/json" },
+    body: JSON.stringify({ refresh_token: refreshToken }),
+  })
+  if (!response.ok) {
+    return false
+  }
+  saveTokens(await response.json())
+  return true
+}
+
+// fetch autenticado: envia o token de acesso e, se ele tiver expirado, renova os tokens e repete a
+// requisição uma vez. Sem um token válido, volta para a tela de login.
+export async function apiFetch(input: string, init: RequestInit = {}): Promise<Response> {
+  const send = () => {
+    const headers = new Headers(init.headers)
+    const token = localStorage.getItem(ACCESS_TOKEN_KEY)
+    if (token) {
+      headers.set("Authorization", `Bearer ${token}`)
+    }
+    return fetch(input, { ...init, headers })
+  }
+
+  let response = await send()
+  if (response.status === 401) {
+    if (await refresh()) {
+      response = await send()
+    } else {
+      logout()
+    }
+  }
+  return response
+}
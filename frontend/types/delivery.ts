@@ -9,18 +9,24 @@ export interface Cliente {
   // Tipo para a entrega
   export interface Entrega {
     id: number;
+    codigo_rastreio: string;
     cliente_id: number;
+    motorista_id: number | null;
+    zona_id: number | null;
+    fora_de_zona: boolean;
     peso: number;
     endereco: string;
     logradouro: string;
     numero: string;
     bairro: string;
     complemento: string;
+    cep: string;
     cidade: string;
     estado: string;
     pais: string;
     latitude: number;
     longitude: number;
+    status: string;
   }
   
   // Tipo para a requisição POST
@@ -32,6 +38,7 @@ export interface Cliente {
       numero: string;
       bairro: string;
       complemento: string;
+      cep: string;
       cidade: string;
       estado: string;
       pais: string;